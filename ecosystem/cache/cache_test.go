@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"net"
 	"strconv"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -516,3 +518,181 @@ func TestCacheSetGetJsonRPCWithID(t *testing.T) {
 		})
 	}
 }
+
+func TestCacheStorageSurvivesRestart(t *testing.T) {
+	storagePath := t.TempDir()
+	startServer := func() (context.Context, *cache.RelayerCacheServer, *cache.BadgerStorage) {
+		storage, err := cache.NewBadgerStorage(storagePath, 0)
+		require.NoError(t, err)
+		ctx := context.Background()
+		cs := cache.CacheServer{CacheMaxCost: 2 * 1024 * 1024 * 1024, Storage: storage}
+		cs.InitCache(ctx, cache.DefaultExpirationTimeFinalized, cache.DefaultExpirationForNonFinalized, cache.DisabledFlagOption)
+		return ctx, &cache.RelayerCacheServer{CacheServer: &cs}, storage
+	}
+	const sharedStateId = "user"
+	finalizedRequest := getRequest(1230, []byte(StubSig), StubApiInterface)
+	nonFinalizedRequest := getRequest(1240, []byte(StubSig), StubApiInterface)
+
+	ctx, cacheServer, storage := startServer()
+	for _, request := range []*pairingtypes.RelayPrivateData{finalizedRequest, nonFinalizedRequest} {
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    HashRequest(t, request, StubChainID),
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{Data: []byte(StubData)},
+			Finalized:      request == finalizedRequest,
+			RequestedBlock: request.RequestBlock,
+			SeenBlock:      1250,
+			SharedStateId:  sharedStateId,
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, storage.Close())
+
+	// a new server with empty in memory caches reads the finalized entries and the shared state from the storage
+	ctx, cacheServer, storage = startServer()
+	defer storage.Close()
+	reply, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+		RequestHash:    HashRequest(t, finalizedRequest, StubChainID),
+		ChainId:        StubChainID,
+		Finalized:      true,
+		RequestedBlock: finalizedRequest.RequestBlock,
+		SharedStateId:  sharedStateId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, StubData, string(reply.Reply.Data))
+	require.Equal(t, int64(1250), reply.SeenBlock)
+
+	// non finalized entries are not persisted
+	_, err = cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+		RequestHash:    HashRequest(t, nonFinalizedRequest, StubChainID),
+		ChainId:        StubChainID,
+		Finalized:      false,
+		RequestedBlock: nonFinalizedRequest.RequestBlock,
+	})
+	require.Error(t, err)
+}
+
+func TestCacheCluster(t *testing.T) {
+	const nodesCount = 3
+	listeners := make([]net.Listener, nodesCount)
+	addresses := make([]string, nodesCount)
+	for i := range listeners {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		listeners[i] = listener
+		addresses[i] = listener.Addr().String()
+	}
+	cacheServers := make([]*cache.RelayerCacheServer, nodesCount)
+	for i, listener := range listeners {
+		cluster, err := cache.NewCacheCluster(addresses[i], addresses)
+		require.NoError(t, err)
+		ctx := context.Background()
+		cs := cache.CacheServer{CacheMaxCost: 2 * 1024 * 1024 * 1024, Cluster: cluster}
+		cs.InitCache(ctx, cache.DefaultExpirationTimeFinalized, cache.DefaultExpirationForNonFinalized, cache.DisabledFlagOption)
+		cacheServers[i] = &cache.RelayerCacheServer{CacheServer: &cs}
+		grpcServer := grpc.NewServer()
+		pairingtypes.RegisterRelayerCacheServer(grpcServer, cacheServers[i])
+		go grpcServer.Serve(listener)
+		defer grpcServer.Stop()
+	}
+
+	// all nodes agree on the owner of every key
+	owners := map[string]int{}
+	for i := 0; i < 100; i++ {
+		key := []byte(strconv.Itoa(i))
+		owner := cacheServers[0].CacheServer.Cluster.Owner(key)
+		for _, cacheServer := range cacheServers[1:] {
+			require.Equal(t, owner, cacheServer.CacheServer.Cluster.Owner(key))
+		}
+		owners[owner]++
+	}
+	require.Len(t, owners, nodesCount)
+
+	const sharedStateId = "user"
+	ctx := context.Background()
+	requests := []*pairingtypes.RelayPrivateData{}
+	for i := 0; i < 10; i++ {
+		request := getRequest(1230, []byte(StubSig+strconv.Itoa(i)), StubApiInterface)
+		requests = append(requests, request)
+		_, err := cacheServers[0].SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    HashRequest(t, request, StubChainID),
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{Data: []byte(StubData)},
+			Finalized:      true,
+			RequestedBlock: request.RequestBlock,
+			SeenBlock:      1250,
+			SharedStateId:  sharedStateId,
+		})
+		require.NoError(t, err)
+	}
+	time.Sleep(10 * time.Millisecond) // ristretto sets are async
+
+	// entries set through one node are found through any other node, with the same shared state
+	for _, request := range requests {
+		for _, cacheServer := range cacheServers {
+			reply, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+				RequestHash:    HashRequest(t, request, StubChainID),
+				ChainId:        StubChainID,
+				Finalized:      true,
+				RequestedBlock: request.RequestBlock,
+				SharedStateId:  sharedStateId,
+			})
+			require.NoError(t, err)
+			require.Equal(t, StubData, string(reply.Reply.Data))
+			require.Equal(t, int64(1250), reply.SeenBlock)
+		}
+	}
+}
+
+func TestCacheInvalidateOnFork(t *testing.T) {
+	ctx, cacheServer := initTest()
+	hash := []byte{1, 2, 3}
+	setEntry := func(requestedBlock int64, finalized bool) {
+		request := getRequest(requestedBlock, []byte(StubSig), StubApiInterface)
+		_, err := cacheServer.SetRelay(ctx, &pairingtypes.RelayCacheSet{
+			RequestHash:    HashRequest(t, request, StubChainID),
+			BlockHash:      hash,
+			ChainId:        StubChainID,
+			Response:       &pairingtypes.RelayReply{},
+			Finalized:      finalized,
+			RequestedBlock: requestedBlock,
+		})
+		require.NoError(t, err)
+	}
+	getEntry := func(requestedBlock int64, finalized bool) error {
+		request := getRequest(requestedBlock, []byte(StubSig), StubApiInterface)
+		_, err := cacheServer.GetRelay(ctx, &pairingtypes.RelayCacheGet{
+			RequestHash:    HashRequest(t, request, StubChainID),
+			BlockHash:      hash,
+			ChainId:        StubChainID,
+			Finalized:      finalized,
+			RequestedBlock: requestedBlock,
+		})
+		return err
+	}
+
+	setEntry(90, true)
+	setEntry(95, false)
+	setEntry(100, false)
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, getEntry(100, false))
+
+	_, err := cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainId: StubChainID, FromBlock: 95})
+	require.NoError(t, err)
+	// non finalized entries from the fork onwards are dropped
+	require.ErrorIs(t, getEntry(95, false), cache.InvalidatedError)
+	require.ErrorIs(t, getEntry(100, false), cache.InvalidatedError)
+	// finalized entries can't be affected by a fork
+	require.NoError(t, getEntry(90, true))
+
+	// an entry set after the fork was detected is valid
+	time.Sleep(time.Millisecond)
+	setEntry(100, false)
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, getEntry(100, false))
+
+	// other chains are not affected
+	_, err = cacheServer.InvalidateRelays(ctx, &pairingtypes.RelayCacheInvalidate{ChainId: "other-chain", FromBlock: 0})
+	require.NoError(t, err)
+	require.NoError(t, getEntry(100, false))
+}
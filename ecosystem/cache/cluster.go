@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ClusterNodesFlagName  = "cluster-nodes"
+	ClusterSelfFlagName   = "cluster-self"
+	ClusterVirtualNodes   = 100 // points per node on the hash ring, spreads the keys evenly
+	ClusterForwardTimeout = time.Second
+	// set on requests between cluster nodes
+	clusterForwardedHeader = "lava-cache-forwarded"
+	clusterSeenBlockHeader = "lava-cache-seen-block" // the request only reads or writes the shared state seen block
+)
+
+// spreads the cache entries across several cache nodes, every key is owned by a single node found by consistent hashing
+type CacheCluster struct {
+	self    string
+	points  []uint64
+	owners  map[uint64]string
+	clients map[string]pairingtypes.RelayerCacheClient
+}
+
+// creates the hash ring of all nodes, all nodes of the cluster need to be configured with the same node list
+func NewCacheCluster(self string, nodes []string) (*CacheCluster, error) {
+	cc := &CacheCluster{
+		self:    self,
+		owners:  map[uint64]string{},
+		clients: map[string]pairingtypes.RelayerCacheClient{},
+	}
+	allNodes := map[string]struct{}{self: {}}
+	for _, node := range nodes {
+		if node != "" {
+			allNodes[node] = struct{}{}
+		}
+	}
+	for node := range allNodes {
+		for i := 0; i < ClusterVirtualNodes; i++ {
+			point := clusterHash([]byte(node + "#" + strconv.Itoa(i)))
+			cc.owners[point] = node
+			cc.points = append(cc.points, point)
+		}
+		if node == self {
+			continue
+		}
+		conn, err := grpc.Dial(node, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, utils.LavaFormatError("failed connecting to cache cluster node", err, utils.Attribute{Key: "node", Value: node})
+		}
+		cc.clients[node] = pairingtypes.NewRelayerCacheClient(conn)
+	}
+	sort.Slice(cc.points, func(i, j int) bool { return cc.points[i] < cc.points[j] })
+	return cc, nil
+}
+
+func clusterHash(key []byte) uint64 {
+	sum := sha256.Sum256(key)
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// returns the node owning the key
+func (cc *CacheCluster) Owner(key []byte) string {
+	point := clusterHash(key)
+	idx := sort.Search(len(cc.points), func(i int) bool { return cc.points[i] >= point })
+	if idx == len(cc.points) {
+		idx = 0
+	}
+	return cc.owners[cc.points[idx]]
+}
+
+// returns a client to the owner of the key, or false if the key should be handled locally
+func (cc *CacheCluster) remoteOwner(key []byte) (pairingtypes.RelayerCacheClient, string, bool) {
+	if cc == nil {
+		return nil, "", false
+	}
+	owner := cc.Owner(key)
+	if owner == cc.self {
+		return nil, "", false
+	}
+	client, ok := cc.clients[owner]
+	return client, owner, ok
+}
+
+func isForwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(clusterForwardedHeader)) > 0
+}
+
+func isSeenBlockRequest(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(clusterSeenBlockHeader)) > 0
+}
+
+func forwardContext(ctx context.Context, seenBlockOnly bool) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(ctx, ClusterForwardTimeout)
+	ctx = metadata.AppendToOutgoingContext(ctx, clusterForwardedHeader, "true")
+	if seenBlockOnly {
+		ctx = metadata.AppendToOutgoingContext(ctx, clusterSeenBlockHeader, "true")
+	}
+	return ctx, cancel
+}
+
+// the owner couldn't be reached, the request is handled locally instead
+func isClusterConnectionError(err error) bool {
+	code := status.Code(err)
+	return code == codes.Unavailable || code == codes.DeadlineExceeded || code == codes.Canceled
+}
@@ -44,5 +44,9 @@ longer DefaultExpirationForNonFinalized will reduce sync QoS for "latest" reques
 	cacheCmd.Flags().Duration(ExpirationNonFinalizedFlagName, DefaultExpirationForNonFinalized, "how long does a cache entry lasts in the cache for a non finalized entry")
 	cacheCmd.Flags().String(FlagMetricsAddress, DisabledFlagOption, "address to listen to prometheus metrics 127.0.0.1:5555, later you can curl http://127.0.0.1:5555/metrics")
 	cacheCmd.Flags().Int64(FlagCacheSizeName, 2*1024*1024*1024, "the maximal amount of entries to save")
+	cacheCmd.Flags().StringSlice(ClusterNodesFlagName, []string{}, "addresses of all the cache nodes in the cluster, entries are spread between them by request hash. must be the same on all nodes, disabled when empty")
+	cacheCmd.Flags().String(ClusterSelfFlagName, "", "the address of this node as listed in --"+ClusterNodesFlagName+", defaults to the listen address")
+	cacheCmd.Flags().String(StoragePathFlagName, "", "directory for a persistent storage of finalized entries and shared state, keeping them across restarts. disabled when empty")
+	cacheCmd.Flags().Int64(StorageMaxSizeFlagName, DefaultStorageMaxSize, "the max size in bytes of the persistent storage, new entries are not persisted once it's reached. 0 disables the limit")
 	return cacheCmd
 }
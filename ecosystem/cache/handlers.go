@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"strconv"
@@ -26,6 +27,7 @@ var (
 	NotFoundError     = sdkerrors.New("Cache miss", 1, "cache entry for specific block and request wasn't found")                                                   // client could'nt connect to any provider.
 	HashMismatchError = sdkerrors.New("Cache hit but hash mismatch", 2, "cache entry for specific block and request had a mismatching hash stored")                 // client could'nt connect to any provider.
 	EntryTypeError    = sdkerrors.New("Cache hit but entry is a different object", 3, "cache entry for specific block and request had a mismatching object stored") // client could'nt connect to any provider.
+	InvalidatedError  = sdkerrors.New("Cache hit but entry was invalidated", 4, "cache entry for specific block was set before a fork was detected on the chain")
 )
 
 const (
@@ -35,9 +37,17 @@ const (
 
 type RelayerCacheServer struct {
 	pairingtypes.UnimplementedRelayerCacheServer
-	CacheServer *CacheServer
-	cacheHits   uint64
-	cacheMisses uint64
+	CacheServer       *CacheServer
+	cacheHits         uint64
+	cacheMisses       uint64
+	invalidationsLock sync.RWMutex
+	invalidations     map[string][]forkInvalidation // per chain
+}
+
+// non finalized entries of fromBlock and later blocks that were set before invalidationTime are stale
+type forkInvalidation struct {
+	fromBlock        int64
+	invalidationTime time.Time
 }
 
 type CacheValue struct {
@@ -45,6 +55,8 @@ type CacheValue struct {
 	Hash             []byte
 	OptionalMetadata []pairingtypes.Metadata
 	SeenBlock        int64
+	Finalized        bool
+	SetTime          time.Time
 }
 
 func (cv *CacheValue) ToCacheReply() *pairingtypes.CacheRelayReply {
@@ -72,6 +84,9 @@ func (s *RelayerCacheServer) getSeenBlockForSharedStateMode(chainId string, shar
 	if sharedStateId != "" {
 		id := latestBlockKey(chainId, sharedStateId)
 		value, found := getNonExpiredFromCache(s.CacheServer.finalizedCache, id)
+		if !found {
+			value, found = s.getSeenBlockFromStorage(id)
+		}
 		if !found {
 			utils.LavaFormatInfo("Failed fetching state from cache for this user id", utils.LogAttr("id", id))
 			return 0 // we cant set the seen block in this case it will be returned 0 and wont be used in the consumer side.
@@ -85,7 +100,52 @@ func (s *RelayerCacheServer) getSeenBlockForSharedStateMode(chainId string, shar
 	return 0
 }
 
+// in cluster mode the seen block of a shared state is kept by the node owning its key, so all consumers sharing it see the same value
+func (s *RelayerCacheServer) getSeenBlock(ctx context.Context, chainId string, sharedStateId string) int64 {
+	if sharedStateId != "" {
+		if client, owner, ok := s.CacheServer.Cluster.remoteOwner([]byte(latestBlockKey(chainId, sharedStateId))); ok {
+			forwardCtx, cancel := forwardContext(ctx, true)
+			defer cancel()
+			reply, err := client.GetRelay(forwardCtx, &pairingtypes.RelayCacheGet{ChainId: chainId, SharedStateId: sharedStateId})
+			if err == nil {
+				return reply.SeenBlock
+			}
+			utils.LavaFormatWarning("failed fetching seen block from the owning cache node, using local state", err, utils.LogAttr("node", owner))
+		}
+	}
+	return s.getSeenBlockForSharedStateMode(chainId, sharedStateId)
+}
+
+func (s *RelayerCacheServer) setSeenBlock(ctx context.Context, chainId, sharedStateId string, seenBlock int64) {
+	if sharedStateId != "" {
+		if client, owner, ok := s.CacheServer.Cluster.remoteOwner([]byte(latestBlockKey(chainId, sharedStateId))); ok {
+			forwardCtx, cancel := forwardContext(ctx, true)
+			defer cancel()
+			_, err := client.SetRelay(forwardCtx, &pairingtypes.RelayCacheSet{ChainId: chainId, SharedStateId: sharedStateId, SeenBlock: seenBlock})
+			if err == nil {
+				return
+			}
+			utils.LavaFormatWarning("failed setting seen block on the owning cache node, using local state", err, utils.LogAttr("node", owner))
+		}
+	}
+	s.setSeenBlockOnSharedStateMode(chainId, sharedStateId, seenBlock)
+}
+
 func (s *RelayerCacheServer) GetRelay(ctx context.Context, relayCacheGet *pairingtypes.RelayCacheGet) (*pairingtypes.CacheRelayReply, error) {
+	if isSeenBlockRequest(ctx) {
+		return &pairingtypes.CacheRelayReply{SeenBlock: s.getSeenBlockForSharedStateMode(relayCacheGet.ChainId, relayCacheGet.SharedStateId)}, nil
+	}
+	if !isForwarded(ctx) {
+		if client, owner, ok := s.CacheServer.Cluster.remoteOwner(relayCacheGet.RequestHash); ok {
+			forwardCtx, cancel := forwardContext(ctx, false)
+			defer cancel()
+			reply, err := client.GetRelay(forwardCtx, relayCacheGet)
+			if !isClusterConnectionError(err) {
+				return reply, err
+			}
+			utils.LavaFormatWarning("failed forwarding cache get to the owning node, handling locally", err, utils.LogAttr("node", owner))
+		}
+	}
 	cacheReply := &pairingtypes.CacheRelayReply{}
 	var cacheReplyTmp *pairingtypes.CacheRelayReply
 	var err error
@@ -119,7 +179,7 @@ func (s *RelayerCacheServer) GetRelay(ctx context.Context, relayCacheGet *pairin
 		go func() {
 			defer waitGroup.Done()
 			// set seen block if required
-			seenBlock = s.getSeenBlockForSharedStateMode(relayCacheGet.ChainId, relayCacheGet.SharedStateId)
+			seenBlock = s.getSeenBlock(ctx, relayCacheGet.ChainId, relayCacheGet.SharedStateId)
 			if seenBlock > relayCacheGet.SeenBlock {
 				relayCacheGet.SeenBlock = seenBlock // update state.
 			}
@@ -184,6 +244,10 @@ func (s *RelayerCacheServer) getRelayInner(relayCacheGet *pairingtypes.RelayCach
 	if !found {
 		return nil, NotFoundError
 	}
+	if !cacheVal.Finalized && s.isInvalidated(relayCacheGet.ChainId, relayCacheGet.RequestedBlock, cacheVal.SetTime) {
+		// the entry might hold the response of an orphaned block
+		return nil, InvalidatedError
+	}
 	if cacheVal.Hash == nil {
 		// if we didn't store a hash its also always a match
 		utils.LavaFormatDebug("returning response", utils.Attribute{Key: "cache_source", Value: cache_source},
@@ -242,6 +306,7 @@ func (s *RelayerCacheServer) setSeenBlockOnSharedStateMode(chainId, sharedStateI
 	key := latestBlockKey(chainId, sharedStateId)
 	set := func() {
 		s.CacheServer.finalizedCache.SetWithTTL(key, seenBlock, 0, s.CacheServer.ExpirationFinalized)
+		s.setInStorage(seenBlockStorageKey(key), encodeInt64(seenBlock))
 	}
 	get := func() int64 {
 		return s.getSeenBlockForSharedStateMode(chainId, sharedStateId)
@@ -250,6 +315,21 @@ func (s *RelayerCacheServer) setSeenBlockOnSharedStateMode(chainId, sharedStateI
 }
 
 func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairingtypes.RelayCacheSet) (*emptypb.Empty, error) {
+	if isSeenBlockRequest(ctx) {
+		s.setSeenBlockOnSharedStateMode(relayCacheSet.ChainId, relayCacheSet.SharedStateId, relayCacheSet.SeenBlock)
+		return &emptypb.Empty{}, nil
+	}
+	if !isForwarded(ctx) {
+		if client, owner, ok := s.CacheServer.Cluster.remoteOwner(relayCacheSet.RequestHash); ok {
+			forwardCtx, cancel := forwardContext(ctx, false)
+			defer cancel()
+			reply, err := client.SetRelay(forwardCtx, relayCacheSet)
+			if !isClusterConnectionError(err) {
+				return reply, err
+			}
+			utils.LavaFormatWarning("failed forwarding cache set to the owning node, handling locally", err, utils.LogAttr("node", owner))
+		}
+	}
 	if relayCacheSet.RequestedBlock < 0 {
 		return nil, utils.LavaFormatError("invalid relay cache set data, request block is negative", nil, utils.Attribute{Key: "requestBlock", Value: relayCacheSet.RequestedBlock})
 	}
@@ -268,16 +348,58 @@ func (s *RelayerCacheServer) SetRelay(ctx context.Context, relayCacheSet *pairin
 	if relayCacheSet.Finalized {
 		cache := s.CacheServer.finalizedCache
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.CacheServer.ExpirationFinalized)
+		s.setRelayInStorage(cacheKey, cacheValue)
 	} else {
 		cache := s.CacheServer.tempCache
 		cache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.getExpirationForChain(time.Duration(relayCacheSet.AverageBlockTime), relayCacheSet.BlockHash))
 	}
 	// Setting the seen block for shared state.
-	s.setSeenBlockOnSharedStateMode(relayCacheSet.ChainId, relayCacheSet.SharedStateId, latestKnownBlock)
+	s.setSeenBlock(ctx, relayCacheSet.ChainId, relayCacheSet.SharedStateId, latestKnownBlock)
 	s.setLatestBlock(latestBlockKey(relayCacheSet.ChainId, ""), latestKnownBlock)
 	return &emptypb.Empty{}, nil
 }
 
+// invalidates the non finalized entries of the chain from the fork onwards, in cluster mode all nodes are notified
+func (s *RelayerCacheServer) InvalidateRelays(ctx context.Context, relayCacheInvalidate *pairingtypes.RelayCacheInvalidate) (*emptypb.Empty, error) {
+	if !isForwarded(ctx) && s.CacheServer.Cluster != nil {
+		for node, client := range s.CacheServer.Cluster.clients {
+			forwardCtx, cancel := forwardContext(ctx, false)
+			_, err := client.InvalidateRelays(forwardCtx, relayCacheInvalidate)
+			cancel()
+			if err != nil {
+				utils.LavaFormatWarning("failed forwarding cache invalidation to cluster node", err, utils.LogAttr("node", node), utils.LogAttr("chainID", relayCacheInvalidate.ChainId))
+			}
+		}
+	}
+	utils.LavaFormatDebug("Got Cache Invalidate", utils.LogAttr("chainID", relayCacheInvalidate.ChainId), utils.LogAttr("fromBlock", relayCacheInvalidate.FromBlock))
+	now := time.Now()
+	s.invalidationsLock.Lock()
+	defer s.invalidationsLock.Unlock()
+	if s.invalidations == nil {
+		s.invalidations = map[string][]forkInvalidation{}
+	}
+	// non finalized entries with a hash live at most ExpirationFinalized, older invalidations can't match any entry
+	invalidations := []forkInvalidation{}
+	for _, invalidation := range s.invalidations[relayCacheInvalidate.ChainId] {
+		if now.Sub(invalidation.invalidationTime) < s.CacheServer.ExpirationFinalized {
+			invalidations = append(invalidations, invalidation)
+		}
+	}
+	s.invalidations[relayCacheInvalidate.ChainId] = append(invalidations, forkInvalidation{fromBlock: relayCacheInvalidate.FromBlock, invalidationTime: now})
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RelayerCacheServer) isInvalidated(chainId string, requestedBlock int64, setTime time.Time) bool {
+	s.invalidationsLock.RLock()
+	defer s.invalidationsLock.RUnlock()
+	for _, invalidation := range s.invalidations[chainId] {
+		if requestedBlock >= invalidation.fromBlock && !setTime.After(invalidation.invalidationTime) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *RelayerCacheServer) Health(ctx context.Context, req *emptypb.Empty) (*pairingtypes.CacheUsage, error) {
 	cacheHits := atomic.LoadUint64(&s.cacheHits)
 	cacheMisses := atomic.LoadUint64(&s.cacheMisses)
@@ -384,7 +506,11 @@ func (s *RelayerCacheServer) findInAllCaches(finalized bool, cacheKey []byte) (r
 			}
 		}
 
-		return nil, "", false
+		// the in memory caches might have been emptied by a restart, only finalized entries are persisted
+		if !finalized {
+			return nil, "", false
+		}
+		return s.getRelayFromStorage(cacheKey)
 	}
 
 	value, cacheSource, found := inner(finalized, cacheKey)
@@ -398,6 +524,74 @@ func (s *RelayerCacheServer) findInAllCaches(finalized bool, cacheKey []byte) (r
 	return CacheValue{}, "", false
 }
 
+func (s *RelayerCacheServer) setInStorage(key []byte, value []byte) {
+	storage := s.CacheServer.Storage
+	if storage == nil {
+		return
+	}
+	err := storage.Set(key, value, s.CacheServer.ExpirationFinalized)
+	if errors.Is(err, StorageFullError) {
+		utils.LavaFormatDebug("cache storage is full, entry is kept in memory only", utils.Attribute{Key: "key", Value: string(key)})
+		return
+	}
+	if err != nil {
+		utils.LavaFormatError("failed setting entry in cache storage", err, utils.Attribute{Key: "key", Value: string(key)})
+	}
+}
+
+func (s *RelayerCacheServer) getFromStorage(key []byte) ([]byte, bool) {
+	storage := s.CacheServer.Storage
+	if storage == nil {
+		return nil, false
+	}
+	value, found, err := storage.Get(key)
+	if err != nil {
+		utils.LavaFormatError("failed getting entry from cache storage", err, utils.Attribute{Key: "key", Value: string(key)})
+		return nil, false
+	}
+	return value, found
+}
+
+func (s *RelayerCacheServer) setRelayInStorage(cacheKey []byte, cacheValue CacheValue) {
+	if s.CacheServer.Storage == nil {
+		return
+	}
+	data, err := encodeCacheValue(cacheValue)
+	if err != nil {
+		utils.LavaFormatError("failed encoding cache value for storage", err)
+		return
+	}
+	s.setInStorage(relayStorageKey(cacheKey), data)
+}
+
+// reads a finalized entry from the storage and warms the finalized cache with it
+func (s *RelayerCacheServer) getRelayFromStorage(cacheKey []byte) (interface{}, string, bool) {
+	data, found := s.getFromStorage(relayStorageKey(cacheKey))
+	if !found {
+		return nil, "", false
+	}
+	cacheValue, err := decodeCacheValue(data)
+	if err != nil {
+		utils.LavaFormatError("failed decoding cache value from storage", err)
+		return nil, "", false
+	}
+	s.CacheServer.finalizedCache.SetWithTTL(cacheKey, cacheValue, cacheValue.Cost(), s.CacheServer.ExpirationFinalized)
+	return cacheValue, "storage", true
+}
+
+func (s *RelayerCacheServer) getSeenBlockFromStorage(key string) (interface{}, bool) {
+	data, found := s.getFromStorage(seenBlockStorageKey(key))
+	if !found {
+		return nil, false
+	}
+	seenBlock, ok := decodeInt64(data)
+	if !ok {
+		return nil, false
+	}
+	s.CacheServer.finalizedCache.SetWithTTL(key, seenBlock, 0, s.CacheServer.ExpirationFinalized)
+	return seenBlock, true
+}
+
 func formatCacheValue(response *pairingtypes.RelayReply, hash []byte, finalized bool, optionalMetadata []pairingtypes.Metadata, seenBlock int64) CacheValue {
 	response.Sig = []byte{} // make sure we return a signed value, as the output was modified by our outputParser
 	if !finalized {
@@ -407,6 +601,7 @@ func formatCacheValue(response *pairingtypes.RelayReply, hash []byte, finalized
 			Hash:             hash,
 			OptionalMetadata: optionalMetadata,
 			SeenBlock:        seenBlock,
+			SetTime:          time.Now(),
 		}
 	}
 	// no need to store the hash value for finalized entries
@@ -415,6 +610,8 @@ func formatCacheValue(response *pairingtypes.RelayReply, hash []byte, finalized
 		Hash:             nil,
 		OptionalMetadata: optionalMetadata,
 		SeenBlock:        seenBlock,
+		Finalized:        true,
+		SetTime:          time.Now(),
 	}
 }
 
@@ -37,6 +37,8 @@ type CacheServer struct {
 	ExpirationNonFinalized time.Duration
 	CacheMetrics           *CacheMetrics
 	CacheMaxCost           int64
+	Storage                CacheStorage  // optional persistent tier behind the finalized cache
+	Cluster                *CacheCluster // optional, spreads the entries between several cache nodes
 }
 
 func (cs *CacheServer) InitCache(ctx context.Context, expiration time.Duration, expirationNonFinalized time.Duration, metricsAddr string) {
@@ -140,6 +142,48 @@ func Server(
 	}
 	cs := CacheServer{CacheMaxCost: cacheMaxCost}
 
+	storagePath, err := flags.GetString(StoragePathFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: StoragePathFlagName})
+	}
+	storageMaxSize, err := flags.GetInt64(StorageMaxSizeFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: StorageMaxSizeFlagName})
+	}
+	if storagePath != "" {
+		storage, err := NewBadgerStorage(storagePath, storageMaxSize)
+		if err != nil {
+			utils.LavaFormatFatal("failed to open cache storage", err, utils.Attribute{Key: "path", Value: storagePath})
+		}
+		defer func() {
+			if err := storage.Close(); err != nil {
+				utils.LavaFormatError("failed closing cache storage", err)
+			}
+		}()
+		cs.Storage = storage
+		utils.LavaFormatInfo("cache storage opened, finalized entries are persisted", utils.Attribute{Key: "path", Value: storagePath})
+	}
+
+	clusterNodes, err := flags.GetStringSlice(ClusterNodesFlagName)
+	if err != nil {
+		utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: ClusterNodesFlagName})
+	}
+	if len(clusterNodes) > 0 {
+		clusterSelf, err := flags.GetString(ClusterSelfFlagName)
+		if err != nil {
+			utils.LavaFormatFatal("failed to read flag", err, utils.Attribute{Key: "flag", Value: ClusterSelfFlagName})
+		}
+		if clusterSelf == "" {
+			clusterSelf = listenAddr
+		}
+		cluster, err := NewCacheCluster(clusterSelf, clusterNodes)
+		if err != nil {
+			utils.LavaFormatFatal("failed to create cache cluster", err, utils.Attribute{Key: "nodes", Value: clusterNodes})
+		}
+		cs.Cluster = cluster
+		utils.LavaFormatInfo("cache cluster mode enabled", utils.Attribute{Key: "self", Value: clusterSelf}, utils.Attribute{Key: "nodes", Value: clusterNodes})
+	}
+
 	cs.InitCache(ctx, expiration, expirationNonFinalized, metricsAddr)
 	// TODO: have a state tracker
 	cs.Serve(ctx, listenAddr)
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+)
+
+const (
+	StoragePathFlagName    = "storage-path"
+	StorageMaxSizeFlagName = "storage-max-size"
+	DefaultStorageMaxSize  = 100 * 1024 * 1024 * 1024
+	StorageGCInterval      = 5 * time.Minute
+	storageGCDiscardRatio  = 0.5
+	relayStoragePrefix     = "relay:"
+	seenBlockPrefix        = "seen:"
+)
+
+var StorageFullError = errors.New("cache storage reached its max size")
+
+// a persistent tier for finalized entries and the shared state, kept behind the in memory caches so they survive restarts
+type CacheStorage interface {
+	Get(key []byte) (value []byte, found bool, err error)
+	Set(key []byte, value []byte, ttl time.Duration) error
+	Close() error
+}
+
+// BadgerStorage keeps entries in a badger db, the value log of expired entries is garbage collected periodically
+// and new entries are rejected while the db is bigger than its max size
+type BadgerStorage struct {
+	db      *badger.DB
+	maxSize int64
+	stop    chan struct{}
+	wg      sync.WaitGroup
+}
+
+var _ CacheStorage = (*BadgerStorage)(nil)
+
+func (bs *BadgerStorage) Get(key []byte) (value []byte, found bool, err error) {
+	err = bs.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (bs *BadgerStorage) Set(key []byte, value []byte, ttl time.Duration) error {
+	if bs.maxSize > 0 && bs.size() >= bs.maxSize {
+		return StorageFullError
+	}
+	return bs.db.Update(func(txn *badger.Txn) error {
+		entry := badger.NewEntry(key, value)
+		if ttl > 0 {
+			entry = entry.WithTTL(ttl)
+		}
+		return txn.SetEntry(entry)
+	})
+}
+
+func (bs *BadgerStorage) Close() error {
+	close(bs.stop)
+	bs.wg.Wait()
+	return bs.db.Close()
+}
+
+// badger refreshes its size about once a minute, so the max size can be exceeded by the entries written in between
+func (bs *BadgerStorage) size() int64 {
+	lsm, vlog := bs.db.Size()
+	return lsm + vlog
+}
+
+// badger doesn't reclaim the value log space of expired entries on its own
+func (bs *BadgerStorage) runGC(interval time.Duration) {
+	defer bs.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bs.stop:
+			return
+		case <-ticker.C:
+			bs.collectGarbage()
+		}
+	}
+}
+
+func (bs *BadgerStorage) collectGarbage() {
+	// every successful run rewrites a single value log file, run until there's nothing left to rewrite
+	for {
+		err := bs.db.RunValueLogGC(storageGCDiscardRatio)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, badger.ErrNoRewrite) && !errors.Is(err, badger.ErrRejected) {
+			utils.LavaFormatWarning("cache storage value log gc failed", err)
+		}
+		return
+	}
+}
+
+// opens a badger storage in path, an empty path keeps it in memory. maxSize is in bytes, 0 disables the limit
+func NewBadgerStorage(path string, maxSize int64) (*BadgerStorage, error) {
+	options := badger.DefaultOptions(path)
+	if path == "" {
+		options = options.WithInMemory(true)
+	}
+	options.Logger = nil
+	db, err := badger.Open(options)
+	if err != nil {
+		return nil, err
+	}
+	bs := &BadgerStorage{db: db, maxSize: maxSize, stop: make(chan struct{})}
+	if path != "" {
+		bs.wg.Add(1)
+		go bs.runGC(StorageGCInterval)
+	}
+	return bs, nil
+}
+
+func relayStorageKey(cacheKey []byte) []byte {
+	return append([]byte(relayStoragePrefix), cacheKey...)
+}
+
+func seenBlockStorageKey(key string) []byte {
+	return []byte(seenBlockPrefix + key)
+}
+
+// finalized entries don't store a hash, so the cache reply holds everything needed to restore them
+func encodeCacheValue(cacheValue CacheValue) ([]byte, error) {
+	return cacheValue.ToCacheReply().Marshal()
+}
+
+func decodeCacheValue(data []byte) (CacheValue, error) {
+	cacheReply := pairingtypes.CacheRelayReply{}
+	err := cacheReply.Unmarshal(data)
+	if err != nil {
+		return CacheValue{}, err
+	}
+	// only finalized entries are stored
+	cacheValue := CacheValue{OptionalMetadata: cacheReply.OptionalMetadata, SeenBlock: cacheReply.SeenBlock, Finalized: true}
+	if cacheReply.Reply != nil {
+		cacheValue.Response = *cacheReply.Reply
+	}
+	return cacheValue, nil
+}
+
+func encodeInt64(value int64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(value))
+}
+
+func decodeInt64(data []byte) (int64, bool) {
+	if len(data) != 8 {
+		return 0, false
+	}
+	return int64(binary.LittleEndian.Uint64(data)), true
+}
@@ -1,6 +1,12 @@
 package chainlib
 
-import "github.com/lavanet/lava/protocol/common"
+import (
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils/lavaslices"
+)
+
+// the apis that close a subscription, they are handled by the consumer that holds the subscriptions
+var UnsubscribeApis = []string{"eth_unsubscribe", "unsubscribe", "unsubscribe_all"}
 
 func ShouldSendToAllProviders(chainMessage ChainMessage) bool {
 	return chainMessage.GetApi().Category.Stateful == common.CONSISTENCY_SELECT_ALL_PROVIDERS
@@ -14,6 +20,10 @@ func IsSubscription(chainMessage ChainMessageForSend) bool {
 	return chainMessage.GetApi().Category.Subscription
 }
 
+func IsUnsubscribe(chainMessage ChainMessageForSend) bool {
+	return lavaslices.Contains(UnsubscribeApis, chainMessage.GetApi().Name)
+}
+
 func IsHangingApi(chainMessage ChainMessageForSend) bool {
 	return chainMessage.GetApi().Category.HangingApi
 }
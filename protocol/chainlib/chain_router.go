@@ -12,7 +12,7 @@ import (
 )
 
 type chainRouterEntry struct {
-	ChainProxy
+	*chainRouterNodes
 	addonsSupported map[string]struct{}
 }
 
@@ -31,14 +31,14 @@ type chainRouterImpl struct {
 	chainProxyRouter map[lavasession.RouterKey][]chainRouterEntry
 }
 
-func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []string) (ChainProxy, error) {
+func (cri *chainRouterImpl) getChainProxySupporting(addon string, extensions []string) (*chainRouterNodes, error) {
 	cri.lock.RLock()
 	defer cri.lock.RUnlock()
 	wantedRouterKey := lavasession.NewRouterKey(extensions)
 	if chainProxyEntries, ok := cri.chainProxyRouter[wantedRouterKey]; ok {
 		for _, chainRouterEntry := range chainProxyEntries {
 			if chainRouterEntry.isSupporting(addon) {
-				return chainRouterEntry.ChainProxy, nil
+				return chainRouterEntry.chainRouterNodes, nil
 			}
 			if debug {
 				utils.LavaFormatDebug("chainProxy supporting extensions but not supporting addon", utils.Attribute{Key: "addon", Value: addon}, utils.Attribute{Key: "wantedRouterKey", Value: wantedRouterKey})
@@ -64,9 +64,18 @@ func (cri chainRouterImpl) SendNodeMsg(ctx context.Context, ch chan interface{},
 	if err != nil {
 		return nil, "", nil, common.NodeUrl{}, "", err
 	}
-	relayReply, subscriptionID, relayReplyServer, err = selectedChainProxy.SendNodeMsg(ctx, ch, chainMessage)
-	proxyUrl, chainId = selectedChainProxy.GetChainProxyInformation()
-	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+	return selectedChainProxy.sendNodeMsg(ctx, ch, chainMessage)
+}
+
+// sets the tracker the nodes latest block is compared to, nodes lagging behind it stop receiving relays
+func (cri chainRouterImpl) SetLatestBlockTracker(latestBlockTracker LatestBlockTracker) {
+	cri.lock.RLock()
+	defer cri.lock.RUnlock()
+	for _, chainRouterEntries := range cri.chainProxyRouter {
+		for _, chainRouterEntry := range chainRouterEntries {
+			chainRouterEntry.setLatestBlockTracker(latestBlockTracker)
+		}
+	}
 }
 
 // batch nodeUrls with the same addons together in a copy
@@ -117,14 +126,27 @@ func newChainRouter(ctx context.Context, nConns uint, rpcProviderEndpoint lavase
 			return allExtensionsRouterKey
 		}
 		routerKey := updateRouteCombinations(extensions, addons)
-		chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointEntry, chainParser)
-		if err != nil {
-			// TODO: allow some urls to be down
-			return nil, err
+		// node urls serving the same services are split to copies that are load balanced, a copy that fails to start is skipped
+		routerNodes := &chainRouterNodes{}
+		var constructionErr error
+		for _, rpcProviderEndpointCopy := range splitNodeUrlsToCopies(rpcProviderEndpointEntry) {
+			chainProxy, err := proxyConstructor(ctx, nConns, rpcProviderEndpointCopy, chainParser)
+			if err != nil {
+				constructionErr = err
+				utils.LavaFormatWarning("failed creating chain proxy for node urls, continuing with the other urls", err, utils.LogAttr("nodeUrls", rpcProviderEndpointCopy.NodeUrls))
+				continue
+			}
+			routerNodes.nodes = append(routerNodes.nodes, &routerNode{ChainProxy: chainProxy, transport: endpointTransport(rpcProviderEndpointCopy)})
+		}
+		if len(routerNodes.nodes) == 0 {
+			return nil, constructionErr
+		}
+		if len(routerNodes.nodes) > 1 {
+			go routerNodes.runHealthChecks(ctx, chainParser, rpcProviderEndpointEntry)
 		}
 		chainRouterEntryInst := chainRouterEntry{
-			ChainProxy:      chainProxy,
-			addonsSupported: addonsSupportedMap,
+			chainRouterNodes: routerNodes,
+			addonsSupported:  addonsSupportedMap,
 		}
 		if chainRouterEntries, ok := chainProxyRouter[routerKey]; !ok {
 			chainProxyRouter[routerKey] = []chainRouterEntry{chainRouterEntryInst}
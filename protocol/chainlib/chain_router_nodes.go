@@ -0,0 +1,405 @@
+package chainlib
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+)
+
+const (
+	NodeFailuresBeforeUnhealthy = 3 // consecutive relay or health check failures before a node stops receiving relays
+	MaxNodeBlockLag             = 5 // blocks a node can be behind the latest block before it stops receiving relays
+	MinNodeHealthCheckInterval  = 2 * time.Second
+	MaxNodeHealthCheckInterval  = 30 * time.Second
+
+	nodeTransportAny  = "" // the copy serves both, e.g. tendermint copies with a websocket and an http url
+	nodeTransportWs   = "ws"
+	nodeTransportHttp = "http"
+)
+
+// used to compare the nodes latest block with the provider's chain tracker
+type LatestBlockTracker interface {
+	GetLatestBlockNum() (int64, time.Time)
+}
+
+// a chain proxy serving one copy of the node urls, with its health state
+type routerNode struct {
+	ChainProxy
+	transport           string
+	outstanding         atomic.Int64
+	lock                sync.RWMutex
+	consecutiveFailures int
+	unhealthy           bool
+	lagging             bool
+	latestBlock         int64
+}
+
+// subscriptions need a websocket, other relays are preferably sent over http
+func (rn *routerNode) servesTransport(subscription bool) bool {
+	if subscription {
+		return rn.transport != nodeTransportHttp
+	}
+	return rn.transport != nodeTransportWs
+}
+
+func (rn *routerNode) available() bool {
+	rn.lock.RLock()
+	defer rn.lock.RUnlock()
+	return !rn.unhealthy && !rn.lagging
+}
+
+func (rn *routerNode) onFailure(err error) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	rn.consecutiveFailures++
+	if rn.consecutiveFailures >= NodeFailuresBeforeUnhealthy && !rn.unhealthy {
+		rn.unhealthy = true
+		nodeUrl, chainID := rn.GetChainProxyInformation()
+		utils.LavaFormatWarning("node marked unhealthy, routing relays to other nodes", err, utils.LogAttr("nodeUrl", nodeUrl.UrlStr()), utils.LogAttr("chainID", chainID), utils.LogAttr("failures", rn.consecutiveFailures))
+	}
+}
+
+func (rn *routerNode) onSuccess() {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	rn.consecutiveFailures = 0
+}
+
+func (rn *routerNode) onHealthCheck(latestBlock int64, err error) {
+	if err != nil {
+		rn.onFailure(err)
+		return
+	}
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	rn.consecutiveFailures = 0
+	rn.latestBlock = latestBlock
+	if rn.unhealthy {
+		rn.unhealthy = false
+		nodeUrl, chainID := rn.GetChainProxyInformation()
+		utils.LavaFormatInfo("node recovered, routing relays to it again", utils.LogAttr("nodeUrl", nodeUrl.UrlStr()), utils.LogAttr("chainID", chainID), utils.LogAttr("latestBlock", latestBlock))
+	}
+}
+
+// returns the latest block of the node, 0 if it is unknown or the node is unhealthy
+func (rn *routerNode) healthyLatestBlock() int64 {
+	rn.lock.RLock()
+	defer rn.lock.RUnlock()
+	if rn.unhealthy {
+		return 0
+	}
+	return rn.latestBlock
+}
+
+func (rn *routerNode) setLagging(reference int64, allowedLag int64) {
+	rn.lock.Lock()
+	defer rn.lock.Unlock()
+	lagging := rn.latestBlock > 0 && reference-rn.latestBlock > allowedLag
+	if lagging != rn.lagging {
+		nodeUrl, chainID := rn.GetChainProxyInformation()
+		utils.LavaFormatInfo("node lagging state changed", utils.LogAttr("nodeUrl", nodeUrl.UrlStr()), utils.LogAttr("chainID", chainID), utils.LogAttr("lagging", lagging), utils.LogAttr("latestBlock", rn.latestBlock), utils.LogAttr("reference", reference))
+	}
+	rn.lagging = lagging
+}
+
+// routes relays between nodes serving the same services, by least outstanding requests and skipping unhealthy or lagging nodes
+type chainRouterNodes struct {
+	nodes              []*routerNode
+	next               atomic.Uint64
+	latestBlockTracker atomic.Pointer[LatestBlockTracker]
+}
+
+// returns the nodes ordered by preference, relays are balanced between the available nodes of the preferred transport
+// with the least outstanding requests first. subscriptions are only sent to nodes with a websocket, other relays fall
+// back to websocket nodes when no http node is available
+func (crn *chainRouterNodes) orderedNodes(subscription bool) []*routerNode {
+	count := len(crn.nodes)
+	start := int(crn.next.Add(1) % uint64(count)) // round robin between nodes with the same load
+	var available, unavailable, otherAvailable, otherUnavailable []*routerNode
+	for i := 0; i < count; i++ {
+		node := crn.nodes[(start+i)%count]
+		preferred := node.servesTransport(subscription)
+		switch {
+		case preferred && node.available():
+			available = append(available, node)
+		case preferred:
+			unavailable = append(unavailable, node)
+		case subscription:
+			// a subscription can't be served over http
+		case node.available():
+			otherAvailable = append(otherAvailable, node)
+		default:
+			otherUnavailable = append(otherUnavailable, node)
+		}
+	}
+	sortByOutstanding := func(nodes []*routerNode) {
+		// insertion sort keeps the round robin order between nodes with the same load
+		for i := 1; i < len(nodes); i++ {
+			for j := i; j > 0 && nodes[j].outstanding.Load() < nodes[j-1].outstanding.Load(); j-- {
+				nodes[j], nodes[j-1] = nodes[j-1], nodes[j]
+			}
+		}
+	}
+	ordered := make([]*routerNode, 0, count)
+	// when no node is available we still try the rest instead of failing the relay
+	for _, nodes := range [][]*routerNode{available, otherAvailable, unavailable, otherUnavailable} {
+		sortByOutstanding(nodes)
+		ordered = append(ordered, nodes...)
+	}
+	if len(ordered) == 0 {
+		// no node has a websocket, the node returns the subscription error
+		return crn.nodes
+	}
+	return ordered
+}
+
+// sends the message to the preferred node, on a failure the next node is tried only when the node can't have acted
+// on the relay: it wasn't sent (the connection to the node failed) or the relay is idempotent.
+// subscriptions and timed out relays are not retried as the node might have received them
+func (crn *chainRouterNodes) sendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	for _, node := range crn.orderedNodes(ch != nil) {
+		node.outstanding.Add(1)
+		relayReply, subscriptionID, relayReplyServer, err = node.SendNodeMsg(ctx, ch, chainMessage)
+		node.outstanding.Add(-1)
+		proxyUrl, chainId = node.GetChainProxyInformation()
+		if err == nil {
+			node.onSuccess()
+			return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, nil
+		}
+		if ctx.Err() != nil {
+			// the relay was cancelled or timed out, this isn't the node's fault
+			return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+		}
+		node.onFailure(err)
+		if len(crn.nodes) == 1 || !canFailover(ch, chainMessage, err) {
+			return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+		}
+		utils.LavaFormatDebug("failed sending relay to node, trying the next one", utils.LogAttr("nodeUrl", proxyUrl.UrlStr()), utils.LogAttr("error", err))
+	}
+	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+}
+
+func canFailover(ch chan interface{}, chainMessage ChainMessageForSend, err error) bool {
+	if isConnectionError(err) {
+		return true
+	}
+	if ch != nil || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	// stateful relays (e.g. sending a transaction) can't be repeated
+	return chainMessage != nil && chainMessage.GetApi() != nil && chainMessage.GetApi().Category.Stateful != common.CONSISTENCY_SELECT_ALL_PROVIDERS
+}
+
+// returns whether the node couldn't be reached, so the relay wasn't sent to it
+func isConnectionError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
+
+func (crn *chainRouterNodes) setLatestBlockTracker(latestBlockTracker LatestBlockTracker) {
+	crn.latestBlockTracker.Store(&latestBlockTracker)
+}
+
+// periodically fetches the latest block of every node, marks failing nodes as unhealthy and nodes behind the latest block as lagging
+// nodes are reintroduced once they respond and catch up
+func (crn *chainRouterNodes) runHealthChecks(ctx context.Context, chainParser ChainParser, endpoint lavasession.RPCProviderEndpoint) {
+	_, averageBlockTime, _, _ := chainParser.ChainBlockStats()
+	interval := averageBlockTime
+	if interval < MinNodeHealthCheckInterval {
+		interval = MinNodeHealthCheckInterval
+	} else if interval > MaxNodeHealthCheckInterval {
+		interval = MaxNodeHealthCheckInterval
+	}
+	// blocks advance between reading the chain tracker and fetching from the nodes
+	allowedLag := int64(MaxNodeBlockLag)
+	if averageBlockTime > 0 {
+		allowedLag += int64(interval / averageBlockTime)
+	}
+	fetchers := make([]*ChainFetcher, len(crn.nodes))
+	for idx, node := range crn.nodes {
+		nodeEndpoint := endpoint
+		fetchers[idx] = NewChainFetcher(ctx, &ChainFetcherOptions{ChainRouter: singleNodeRouter{node: node}, ChainParser: chainParser, Endpoint: &nodeEndpoint})
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, _, ok := chainParser.GetParsingByTag(spectypes.FUNCTION_TAG_GET_BLOCKNUM); !ok {
+				// without a latest block api only relay errors affect the nodes health
+				continue
+			}
+			crn.checkNodes(ctx, fetchers, interval, allowedLag)
+		}
+	}
+}
+
+func (crn *chainRouterNodes) checkNodes(ctx context.Context, fetchers []*ChainFetcher, timeout time.Duration, allowedLag int64) {
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var wg sync.WaitGroup
+	for idx := range crn.nodes {
+		wg.Add(1)
+		go func(node *routerNode, fetcher *ChainFetcher) {
+			defer wg.Done()
+			latestBlock, err := fetcher.FetchLatestBlockNum(checkCtx)
+			if ctx.Err() != nil {
+				return
+			}
+			node.onHealthCheck(latestBlock, err)
+		}(crn.nodes[idx], fetchers[idx])
+	}
+	wg.Wait()
+	reference := int64(0)
+	if latestBlockTracker := crn.latestBlockTracker.Load(); latestBlockTracker != nil && *latestBlockTracker != nil {
+		reference, _ = (*latestBlockTracker).GetLatestBlockNum()
+	}
+	for _, node := range crn.nodes {
+		if latestBlock := node.healthyLatestBlock(); latestBlock > reference {
+			reference = latestBlock
+		}
+	}
+	for _, node := range crn.nodes {
+		node.setLagging(reference, allowedLag)
+	}
+}
+
+// routes all messages to a single node, used to check the health of each node separately
+type singleNodeRouter struct {
+	node *routerNode
+}
+
+func (snr singleNodeRouter) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend, extensions []string) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, proxyUrl common.NodeUrl, chainId string, err error) {
+	relayReply, subscriptionID, relayReplyServer, err = snr.node.SendNodeMsg(ctx, ch, chainMessage)
+	proxyUrl, chainId = snr.node.GetChainProxyInformation()
+	return relayReply, subscriptionID, relayReplyServer, proxyUrl, chainId, err
+}
+
+func (snr singleNodeRouter) ExtensionsSupported(extensions []string) bool {
+	return true
+}
+
+func (snr singleNodeRouter) SetLatestBlockTracker(LatestBlockTracker) {}
+
+// splits node urls serving the same services into copies that can each serve all of the services on their own
+// urls are grouped by their role (websocket or http for tendermint, internal path for jsonrpc), the i-th copy takes the i-th url of every role.
+// jsonrpc urls of different transports are split separately when each transport serves all of the roles, so every copy has a single transport
+func splitNodeUrlsToCopies(rpcProviderEndpoint lavasession.RPCProviderEndpoint) []lavasession.RPCProviderEndpoint {
+	if rpcProviderEndpoint.ApiInterface == spectypes.APIInterfaceJsonRPC {
+		transports := []string{}
+		urlsByTransport := map[string][]common.NodeUrl{}
+		for _, nodeUrl := range rpcProviderEndpoint.NodeUrls {
+			transport := nodeUrlTransport(nodeUrl)
+			if _, ok := urlsByTransport[transport]; !ok {
+				transports = append(transports, transport)
+			}
+			urlsByTransport[transport] = append(urlsByTransport[transport], nodeUrl)
+		}
+		allRoles := len(nodeUrlsRoles(rpcProviderEndpoint.ApiInterface, rpcProviderEndpoint.NodeUrls))
+		splitByTransport := len(transports) > 1
+		for _, urls := range urlsByTransport {
+			if len(nodeUrlsRoles(rpcProviderEndpoint.ApiInterface, urls)) != allRoles {
+				splitByTransport = false
+			}
+		}
+		if splitByTransport {
+			copies := []lavasession.RPCProviderEndpoint{}
+			for _, transport := range transports {
+				copies = append(copies, splitNodeUrlsByRoles(rpcProviderEndpoint, urlsByTransport[transport])...)
+			}
+			return copies
+		}
+	}
+	return splitNodeUrlsByRoles(rpcProviderEndpoint, rpcProviderEndpoint.NodeUrls)
+}
+
+func splitNodeUrlsByRoles(rpcProviderEndpoint lavasession.RPCProviderEndpoint, nodeUrls []common.NodeUrl) []lavasession.RPCProviderEndpoint {
+	roles := nodeUrlsRoles(rpcProviderEndpoint.ApiInterface, nodeUrls)
+	urlsByRole := map[string][]common.NodeUrl{}
+	for _, nodeUrl := range nodeUrls {
+		role := nodeUrlRole(rpcProviderEndpoint.ApiInterface, nodeUrl)
+		urlsByRole[role] = append(urlsByRole[role], nodeUrl)
+	}
+	copiesCount := 0
+	for _, urls := range urlsByRole {
+		if len(urls) > copiesCount {
+			copiesCount = len(urls)
+		}
+	}
+	copies := make([]lavasession.RPCProviderEndpoint, 0, copiesCount)
+	for i := 0; i < copiesCount; i++ {
+		copyUrls := make([]common.NodeUrl, 0, len(roles))
+		for _, role := range roles {
+			urls := urlsByRole[role]
+			copyUrls = append(copyUrls, urls[i%len(urls)]) // roles with less urls are shared between copies
+		}
+		endpointCopy := rpcProviderEndpoint
+		endpointCopy.NodeUrls = copyUrls
+		copies = append(copies, endpointCopy)
+	}
+	return copies
+}
+
+// returns the roles of the urls in the order they first appear
+func nodeUrlsRoles(apiInterface string, nodeUrls []common.NodeUrl) []string {
+	roles := []string{}
+	seen := map[string]struct{}{}
+	for _, nodeUrl := range nodeUrls {
+		role := nodeUrlRole(apiInterface, nodeUrl)
+		if _, ok := seen[role]; !ok {
+			seen[role] = struct{}{}
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func nodeUrlTransport(nodeUrl common.NodeUrl) string {
+	parsedUrl, err := url.Parse(nodeUrl.Url)
+	if err == nil && (parsedUrl.Scheme == "ws" || parsedUrl.Scheme == "wss") {
+		return nodeTransportWs
+	}
+	return nodeTransportHttp
+}
+
+// returns the transport of a copy of the node urls, jsonrpc copies use a single transport when all of their urls share it
+func endpointTransport(rpcProviderEndpoint lavasession.RPCProviderEndpoint) string {
+	if rpcProviderEndpoint.ApiInterface != spectypes.APIInterfaceJsonRPC || len(rpcProviderEndpoint.NodeUrls) == 0 {
+		return nodeTransportAny
+	}
+	transport := nodeUrlTransport(rpcProviderEndpoint.NodeUrls[0])
+	for _, nodeUrl := range rpcProviderEndpoint.NodeUrls[1:] {
+		if nodeUrlTransport(nodeUrl) != transport {
+			return nodeTransportAny
+		}
+	}
+	return transport
+}
+
+func nodeUrlRole(apiInterface string, nodeUrl common.NodeUrl) string {
+	switch apiInterface {
+	case spectypes.APIInterfaceTendermintRPC:
+		return nodeUrlTransport(nodeUrl)
+	case spectypes.APIInterfaceJsonRPC:
+		return nodeUrl.InternalPath
+	default:
+		return ""
+	}
+}
@@ -2,11 +2,16 @@ package chainlib
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"sync"
 	"testing"
 
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/protocol/lavasession"
 	testcommon "github.com/lavanet/lava/testutil/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 )
@@ -341,3 +346,226 @@ func TestChainRouter(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitNodeUrlsToCopies(t *testing.T) {
+	playbook := []struct {
+		name         string
+		apiInterface string
+		urls         []common.NodeUrl
+		expected     [][]string
+	}{
+		{
+			name:         "single url",
+			apiInterface: spectypes.APIInterfaceRest,
+			urls:         []common.NodeUrl{{Url: "http://node1"}},
+			expected:     [][]string{{"http://node1"}},
+		},
+		{
+			name:         "rest copies",
+			apiInterface: spectypes.APIInterfaceRest,
+			urls:         []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node2"}},
+			expected:     [][]string{{"http://node1"}, {"http://node2"}},
+		},
+		{
+			name:         "tendermint pairs",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			urls:         []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}, {Url: "ws://node2"}, {Url: "http://node2"}},
+			expected:     [][]string{{"ws://node1", "http://node1"}, {"ws://node2", "http://node2"}},
+		},
+		{
+			name:         "tendermint shared websocket",
+			apiInterface: spectypes.APIInterfaceTendermintRPC,
+			urls:         []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}, {Url: "http://node2"}},
+			expected:     [][]string{{"ws://node1", "http://node1"}, {"ws://node1", "http://node2"}},
+		},
+		{
+			name:         "jsonrpc transports",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			urls:         []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1"}, {Url: "http://node2"}},
+			expected:     [][]string{{"ws://node1"}, {"http://node1"}, {"http://node2"}},
+		},
+		{
+			name:         "jsonrpc transports missing an internal path",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			urls:         []common.NodeUrl{{Url: "ws://node1"}, {Url: "http://node1/c", InternalPath: "/c"}},
+			expected:     [][]string{{"ws://node1", "http://node1/c"}},
+		},
+		{
+			name:         "jsonrpc internal paths",
+			apiInterface: spectypes.APIInterfaceJsonRPC,
+			urls:         []common.NodeUrl{{Url: "http://node1"}, {Url: "http://node1/c", InternalPath: "/c"}, {Url: "http://node2"}, {Url: "http://node2/c", InternalPath: "/c"}},
+			expected:     [][]string{{"http://node1", "http://node1/c"}, {"http://node2", "http://node2/c"}},
+		},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			copies := splitNodeUrlsToCopies(lavasession.RPCProviderEndpoint{ApiInterface: play.apiInterface, NodeUrls: play.urls})
+			require.Len(t, copies, len(play.expected))
+			for idx, endpointCopy := range copies {
+				urls := []string{}
+				for _, nodeUrl := range endpointCopy.NodeUrls {
+					urls = append(urls, nodeUrl.Url)
+				}
+				require.Equal(t, play.expected[idx], urls)
+			}
+		})
+	}
+}
+
+type mockNodeChainProxy struct {
+	url         string
+	fail        bool
+	failNotSent bool // the node couldn't be reached
+	calls       int
+	lock        sync.Mutex
+}
+
+func (m *mockNodeChainProxy) GetChainProxyInformation() (common.NodeUrl, string) {
+	return common.NodeUrl{Url: m.url}, "test"
+}
+
+func (m *mockNodeChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (*pairingtypes.RelayReply, string, *rpcclient.ClientSubscription, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.calls++
+	if m.failNotSent {
+		return nil, "", nil, &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")}
+	}
+	if m.fail {
+		return nil, "", nil, fmt.Errorf("internal error")
+	}
+	return &pairingtypes.RelayReply{Data: []byte(m.url)}, "", nil, nil
+}
+
+type mockChainMessageForSend struct {
+	ChainMessageForSend
+	api *spectypes.Api
+}
+
+func (m mockChainMessageForSend) GetApi() *spectypes.Api {
+	return m.api
+}
+
+func TestChainRouterNodesFailover(t *testing.T) {
+	ctx := context.Background()
+	failing := &mockNodeChainProxy{url: "failing", failNotSent: true}
+	working := &mockNodeChainProxy{url: "working"}
+	routerNodes := &chainRouterNodes{nodes: []*routerNode{{ChainProxy: failing}, {ChainProxy: working}}}
+	for i := 0; i < NodeFailuresBeforeUnhealthy*2; i++ {
+		reply, _, _, proxyUrl, _, err := routerNodes.sendNodeMsg(ctx, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, "working", string(reply.Data))
+		require.Equal(t, "working", proxyUrl.Url)
+	}
+	// the failing node is skipped once it's unhealthy
+	require.Equal(t, NodeFailuresBeforeUnhealthy, failing.calls)
+	require.False(t, routerNodes.nodes[0].available())
+
+	// a successful health check reintroduces it
+	failing.failNotSent = false
+	routerNodes.nodes[0].onHealthCheck(100, nil)
+	require.True(t, routerNodes.nodes[0].available())
+	for i := 0; i < 4; i++ {
+		_, _, _, _, _, err := routerNodes.sendNodeMsg(ctx, nil, nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, NodeFailuresBeforeUnhealthy+2, failing.calls)
+
+	// subscriptions are not retried on another node
+	working.fail = true
+	failing.fail = true
+	_, _, _, _, _, err := routerNodes.sendNodeMsg(ctx, make(chan interface{}), nil)
+	require.Error(t, err)
+}
+
+func TestChainRouterNodesFailoverIdempotent(t *testing.T) {
+	ctx := context.Background()
+	failing := &mockNodeChainProxy{url: "failing", fail: true}
+	working := &mockNodeChainProxy{url: "working"}
+	routerNodes := &chainRouterNodes{nodes: []*routerNode{{ChainProxy: failing}, {ChainProxy: working}}}
+	query := mockChainMessageForSend{api: &spectypes.Api{Name: "query"}}
+	transaction := mockChainMessageForSend{api: &spectypes.Api{Name: "send", Category: spectypes.SpecCategory{Stateful: common.CONSISTENCY_SELECT_ALL_PROVIDERS}}}
+
+	failedTransactions := 0
+	for i := 0; i < 4; i++ {
+		reply, _, _, _, _, err := routerNodes.sendNodeMsg(ctx, nil, query)
+		require.NoError(t, err)
+		require.Equal(t, "working", string(reply.Data))
+		failing.calls = 0
+		_, _, _, _, _, err = routerNodes.sendNodeMsg(ctx, nil, transaction)
+		if failing.calls > 0 {
+			// the node might have received the transaction, it isn't sent again
+			require.Error(t, err)
+			failedTransactions++
+		}
+		routerNodes.nodes[0].onHealthCheck(100, nil)
+	}
+	require.Positive(t, failedTransactions)
+
+	// a transaction that wasn't sent is retried on another node
+	failing.fail = false
+	failing.failNotSent = true
+	for i := 0; i < 4; i++ {
+		reply, _, _, _, _, err := routerNodes.sendNodeMsg(ctx, nil, transaction)
+		require.NoError(t, err)
+		require.Equal(t, "working", string(reply.Data))
+		routerNodes.nodes[0].onHealthCheck(100, nil)
+	}
+}
+
+func TestChainRouterNodesTransports(t *testing.T) {
+	ws := &routerNode{ChainProxy: &mockNodeChainProxy{url: "ws"}, transport: nodeTransportWs}
+	http1 := &routerNode{ChainProxy: &mockNodeChainProxy{url: "http1"}, transport: nodeTransportHttp}
+	http2 := &routerNode{ChainProxy: &mockNodeChainProxy{url: "http2"}, transport: nodeTransportHttp}
+	routerNodes := &chainRouterNodes{nodes: []*routerNode{ws, http1, http2}}
+
+	// subscriptions only go to the websocket node
+	for i := 0; i < 3; i++ {
+		require.Equal(t, []*routerNode{ws}, routerNodes.orderedNodes(true))
+	}
+	// relays are balanced between the http nodes, the websocket node is the last fallback
+	for i := 0; i < 3; i++ {
+		ordered := routerNodes.orderedNodes(false)
+		require.Len(t, ordered, 3)
+		require.NotEqual(t, ws, ordered[0])
+		require.NotEqual(t, ws, ordered[1])
+		require.Equal(t, ws, ordered[2])
+	}
+	http1.outstanding.Add(5)
+	require.Equal(t, []*routerNode{http2, http1, ws}, routerNodes.orderedNodes(false))
+	// unavailable http nodes are tried after the available websocket node
+	for i := 0; i < NodeFailuresBeforeUnhealthy; i++ {
+		http2.onFailure(fmt.Errorf("failure"))
+	}
+	require.Equal(t, []*routerNode{http1, ws, http2}, routerNodes.orderedNodes(false))
+}
+
+func TestChainRouterNodesBalancing(t *testing.T) {
+	nodes := []*routerNode{{ChainProxy: &mockNodeChainProxy{url: "node1"}}, {ChainProxy: &mockNodeChainProxy{url: "node2"}}, {ChainProxy: &mockNodeChainProxy{url: "node3"}}}
+	routerNodes := &chainRouterNodes{nodes: nodes}
+	// round robin when the load is equal
+	seen := map[string]struct{}{}
+	for i := 0; i < 3; i++ {
+		nodeUrl, _ := routerNodes.orderedNodes(false)[0].GetChainProxyInformation()
+		seen[nodeUrl.Url] = struct{}{}
+	}
+	require.Len(t, seen, 3)
+	// least outstanding requests first
+	nodes[0].outstanding.Add(2)
+	nodes[1].outstanding.Add(1)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, nodes[2], routerNodes.orderedNodes(false)[0])
+	}
+
+	// lagging nodes are skipped until they catch up
+	nodes[2].onHealthCheck(90, nil)
+	nodes[1].onHealthCheck(100, nil)
+	for _, node := range nodes {
+		node.setLagging(100, MaxNodeBlockLag)
+	}
+	require.False(t, nodes[2].available())
+	require.Equal(t, nodes[1], routerNodes.orderedNodes(false)[0])
+	nodes[2].onHealthCheck(100, nil)
+	nodes[2].setLagging(100, MaxNodeBlockLag)
+	require.Equal(t, nodes[2], routerNodes.orderedNodes(false)[0])
+}
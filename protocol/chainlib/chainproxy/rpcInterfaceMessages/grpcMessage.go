@@ -1,6 +1,7 @@
 package rpcInterfaceMessages
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -179,3 +180,36 @@ func ParseSymbol(svcAndMethod string) (string, string) {
 	}
 	return svcAndMethod[:pos], svcAndMethod[pos+1:]
 }
+
+// the messages of a client streaming request are sent in a single relay, each message is prefixed by its length
+const grpcStreamMessageLengthPrefix = 4
+
+func EncodeGrpcStreamMessages(msgs [][]byte) []byte {
+	size := 0
+	for _, msg := range msgs {
+		size += grpcStreamMessageLengthPrefix + len(msg)
+	}
+	data := make([]byte, 0, size)
+	for _, msg := range msgs {
+		data = binary.BigEndian.AppendUint32(data, uint32(len(msg)))
+		data = append(data, msg...)
+	}
+	return data
+}
+
+func DecodeGrpcStreamMessages(data []byte) ([][]byte, error) {
+	msgs := [][]byte{}
+	for len(data) > 0 {
+		if len(data) < grpcStreamMessageLengthPrefix {
+			return nil, utils.LavaFormatError("invalid grpc stream messages, truncated length prefix", nil, utils.Attribute{Key: "remaining", Value: len(data)})
+		}
+		msgLength := binary.BigEndian.Uint32(data)
+		data = data[grpcStreamMessageLengthPrefix:]
+		if uint64(len(data)) < uint64(msgLength) {
+			return nil, utils.LavaFormatError("invalid grpc stream messages, truncated message", nil, utils.Attribute{Key: "length", Value: msgLength}, utils.Attribute{Key: "remaining", Value: len(data)})
+		}
+		msgs = append(msgs, data[:msgLength])
+		data = data[msgLength:]
+	}
+	return msgs, nil
+}
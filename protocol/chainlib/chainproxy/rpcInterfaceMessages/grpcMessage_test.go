@@ -145,3 +145,23 @@ func TestParseSymbol(t *testing.T) {
 		})
 	}
 }
+
+func TestGrpcStreamMessagesEncoding(t *testing.T) {
+	msgs := [][]byte{[]byte("first"), {}, []byte(`{"height":"5"}`)}
+	decoded, err := DecodeGrpcStreamMessages(EncodeGrpcStreamMessages(msgs))
+	assert.NoError(t, err)
+	assert.Len(t, decoded, len(msgs))
+	for i := range msgs {
+		assert.Equal(t, string(msgs[i]), string(decoded[i]))
+	}
+
+	decoded, err = DecodeGrpcStreamMessages(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	encoded := EncodeGrpcStreamMessages(msgs)
+	_, err = DecodeGrpcStreamMessages(encoded[:len(encoded)-1])
+	assert.Error(t, err)
+	_, err = DecodeGrpcStreamMessages([]byte{0, 0})
+	assert.Error(t, err)
+}
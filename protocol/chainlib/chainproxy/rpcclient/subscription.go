@@ -246,6 +246,28 @@ func newClientSubscription(c *Client, namespace string, channel reflect.Value) *
 	return sub
 }
 
+// NewStreamSubscription returns a subscription that isn't driven by a Client, for streams that forward their
+// messages by themselves. The stream calls end when it's done and Unsubscribe calls onUnsubscribe.
+func NewStreamSubscription(onUnsubscribe func()) (sub *ClientSubscription, end func(err error)) {
+	sub = &ClientSubscription{
+		quit:        make(chan error),
+		forwardDone: make(chan struct{}),
+		unsubDone:   make(chan struct{}),
+		err:         make(chan error, 1),
+	}
+	go func() {
+		defer close(sub.unsubDone)
+		err := <-sub.quit
+		close(sub.forwardDone)
+		if err == errUnsubscribed {
+			onUnsubscribe()
+			return
+		}
+		sub.err <- err
+	}()
+	return sub, sub.close
+}
+
 // Err returns the subscription error channel. The intended use of Err is to schedule
 // resubscription when the client connection is closed unexpectedly.
 //
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -126,6 +127,7 @@ func constructFiberCallbackWithHeaderAndParameterExtraction(callbackToBeCalled f
 
 		// Store dappID in the local context
 		c.Locals("dapp-id", dappID)
+		c.Locals(apiKeyMatchString, c.Get(ApiKeyHeaderName))
 
 		if isMetricEnabled {
 			c.Locals(metrics.RefererHeaderKey, c.Get(metrics.RefererHeaderKey, ""))
@@ -145,6 +147,7 @@ func constructFiberCallbackWithHeaderAndParameterExtractionAndReferer(callbackTo
 
 		// Store dappID in the local context
 		c.Locals("dapp-id", dappID)
+		c.Locals(apiKeyMatchString, c.Get(ApiKeyHeaderName))
 
 		if isMetricEnabled {
 			c.Locals(metrics.RefererHeaderKey, c.Get(metrics.RefererHeaderKey, ""))
@@ -432,3 +435,70 @@ func GetTimeoutInfo(chainMessage ChainMessageForSend) common.TimeoutInfo {
 		Stateful: GetStateful(chainMessage),
 	}
 }
+
+// websocket connections don't support concurrent writers, subscriptions forward replies in the background
+// while the read loop keeps serving the client's requests, so every write goes through this lock
+type websocketWriter struct {
+	lock      sync.Mutex
+	conn      *websocket.Conn
+	closed    bool // the connection is released once the handler returns, background writes must not touch it
+	logger    *metrics.RPCConsumerLogs
+	rpcType   string
+	logModule string
+}
+
+func newWebsocketWriter(conn *websocket.Conn, logger *metrics.RPCConsumerLogs, rpcType string, logModule string) *websocketWriter {
+	return &websocketWriter{conn: conn, logger: logger, rpcType: rpcType, logModule: logModule}
+}
+
+func (ww *websocketWriter) WriteMessage(messageType int, data []byte) error {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+	if ww.closed {
+		return websocket.ErrCloseSent
+	}
+	return ww.conn.WriteMessage(messageType, data)
+}
+
+func (ww *websocketWriter) AnalyzeErrorAndWriteMessage(messageType int, err error, msgSeed string, msg []byte, timeTaken time.Duration) {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+	if ww.closed {
+		return
+	}
+	ww.logger.AnalyzeWebSocketErrorAndWriteMessage(ww.conn, messageType, err, msgSeed, msg, ww.rpcType, timeTaken)
+}
+
+// called when the read loop ends, before the subscriptions are cancelled
+func (ww *websocketWriter) Close() {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+	ww.closed = true
+}
+
+func (ww *websocketWriter) logReply(msgSeed string, msg []byte, reply []byte, timeTaken time.Duration) {
+	ww.lock.Lock()
+	defer ww.lock.Unlock()
+	if ww.closed {
+		return
+	}
+	ww.logger.LogRequestAndResponse(ww.logModule, false, "ws", ww.conn.LocalAddr().String(), string(msg), string(reply), msgSeed, timeTaken, nil)
+}
+
+// forwards every reply of the subscription to the client until the stream ends or the client can't be written to
+func (ww *websocketWriter) ForwardSubscription(cancel context.CancelFunc, replyServer *pairingtypes.Relayer_RelaySubscribeClient, messageType int, msgSeed string, msg []byte, startTime time.Time) {
+	defer cancel()
+	for {
+		reply, err := (*replyServer).Recv()
+		if err != nil {
+			ww.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
+			return
+		}
+		if err = ww.WriteMessage(messageType, reply.Data); err != nil {
+			// the client is gone, cancelling closes the provider's stream
+			ww.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
+			return
+		}
+		ww.logReply(msgSeed, msg, reply.Data, time.Since(startTime))
+	}
+}
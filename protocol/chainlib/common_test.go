@@ -299,6 +299,16 @@ func TestParsedMessage_RequestedBlock(t *testing.T) {
 	assert.Equal(t, int64(123), requestedBlock)
 }
 
+func TestParsedMessage_IsUnsubscribe(t *testing.T) {
+	for _, name := range []string{"eth_unsubscribe", "unsubscribe", "unsubscribe_all"} {
+		assert.True(t, IsUnsubscribe(&baseChainMessageContainer{api: &spectypes.Api{Name: name}}), name)
+	}
+	// other apis that mention unsubscribe are relayed to the providers
+	for _, name := range []string{"eth_subscribe", "logsUnsubscribe", "my_unsubscribe_notifications"} {
+		assert.False(t, IsUnsubscribe(&baseChainMessageContainer{api: &spectypes.Api{Name: name}}), name)
+	}
+}
+
 func TestParsedMessage_GetRPCMessage(t *testing.T) {
 	rpcInput := &mockRPCInput{}
 
@@ -0,0 +1,456 @@
+package chainlib
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	common "github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/metrics"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	ApiKeyHeaderName  = "lava-api-key"
+	ApiKeyPathMarker  = "key" // requests to /key/<api key>/... are authenticated by the path segment
+	apiKeyMatchString = "apiKeyMatch"
+
+	AccessBucketIdleTimeout = time.Minute
+	AccessCleanupInterval   = time.Minute
+	MaxIpBuckets            = 100000
+	overflowIpBucketsKey    = "overflow" // clients beyond MaxIpBuckets share a bucket
+
+	apiKeyResultSuccess      = "success"
+	apiKeyResultError        = "error"
+	apiKeyResultUnauthorized = "unauthorized"
+	apiKeyResultRateLimited  = "rate_limited"
+	apiKeyUnknownName        = "unknown"
+)
+
+type (
+	apiKeyContextKey struct{}
+	peerIpContextKey struct{}
+)
+
+// per second limits, 0 disables a limit
+type AccessLimits struct {
+	RequestsPerSecond float64 `yaml:"requests-per-second,omitempty" json:"requests-per-second,omitempty" mapstructure:"requests-per-second,omitempty"`
+	CuPerSecond       float64 `yaml:"cu-per-second,omitempty" json:"cu-per-second,omitempty" mapstructure:"cu-per-second,omitempty"`
+}
+
+func (al AccessLimits) enabled() bool {
+	return al.RequestsPerSecond > 0 || al.CuPerSecond > 0
+}
+
+type ApiKeyConfig struct {
+	Name         string `yaml:"name,omitempty" json:"name,omitempty" mapstructure:"name"`
+	Key          string `yaml:"key,omitempty" json:"key,omitempty" mapstructure:"key"`
+	AccessLimits `yaml:",inline" mapstructure:",squash"`
+}
+
+type ConsumerAccessControlConfig struct {
+	ApiKeys          []ApiKeyConfig
+	DefaultKeyLimits AccessLimits // applies to api keys without limits of their own
+	IpLimits         AccessLimits
+	TrustedProxies   []string // ips or cidrs of proxies whose forwarding header is trusted to hold the client ip
+}
+
+type accessBuckets struct {
+	requests *common.TokenBucket
+	cu       *common.TokenBucket
+}
+
+func (ab *accessBuckets) refill(now time.Time) {
+	for _, bucket := range []*common.TokenBucket{ab.requests, ab.cu} {
+		if bucket != nil {
+			bucket.Refill(now)
+		}
+	}
+}
+
+// the cu of a relay is known only once it was parsed, so it's charged after the relay and a bucket in debt blocks the next ones
+func (ab *accessBuckets) allows() bool {
+	return (ab.requests == nil || ab.requests.Allows(1)) && (ab.cu == nil || ab.cu.Allows(0))
+}
+
+func (ab *accessBuckets) idle(now time.Time) bool {
+	for _, bucket := range []*common.TokenBucket{ab.requests, ab.cu} {
+		if bucket != nil && now.Sub(bucket.LastRefill()) < AccessBucketIdleTimeout {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsumerAccessControl authenticates the consumer's listeners requests by api key and rate limits them per api key and per ip
+type ConsumerAccessControl struct {
+	lock           sync.Mutex
+	apiKeys        map[string]string       // key -> name
+	keyLimits      map[string]AccessLimits // by api key name
+	ipLimits       AccessLimits
+	keyBuckets     map[string]*accessBuckets // by api key name
+	ipBuckets      map[string]*accessBuckets
+	trustedProxies []*net.IPNet
+	maxIpBuckets   int
+	lastCleanup    time.Time
+	now            func() time.Time
+	metricsManager *metrics.ConsumerMetricsManager
+}
+
+// returns nil when there are no api keys nor ip limits configured
+func NewConsumerAccessControl(config ConsumerAccessControlConfig, metricsManager *metrics.ConsumerMetricsManager) (*ConsumerAccessControl, error) {
+	if len(config.ApiKeys) == 0 && !config.IpLimits.enabled() {
+		return nil, nil
+	}
+	apiKeys := map[string]string{}
+	keyLimits := map[string]AccessLimits{}
+	for _, apiKey := range config.ApiKeys {
+		if apiKey.Key == "" || apiKey.Name == "" {
+			return nil, utils.LavaFormatError("api keys must have a name and a key", nil, utils.LogAttr("name", apiKey.Name))
+		}
+		if _, ok := apiKeys[apiKey.Key]; ok {
+			return nil, utils.LavaFormatError("duplicate api key", nil, utils.LogAttr("name", apiKey.Name))
+		}
+		if _, ok := keyLimits[apiKey.Name]; ok {
+			return nil, utils.LavaFormatError("duplicate api key name", nil, utils.LogAttr("name", apiKey.Name))
+		}
+		limits := apiKey.AccessLimits
+		if !limits.enabled() {
+			limits = config.DefaultKeyLimits
+		}
+		apiKeys[apiKey.Key] = apiKey.Name
+		keyLimits[apiKey.Name] = limits
+	}
+	trustedProxies := make([]*net.IPNet, 0, len(config.TrustedProxies))
+	for _, proxy := range config.TrustedProxies {
+		proxyNet, err := parseIpNet(proxy)
+		if err != nil {
+			return nil, utils.LavaFormatError("invalid trusted proxy", err, utils.LogAttr("proxy", proxy))
+		}
+		trustedProxies = append(trustedProxies, proxyNet)
+	}
+	return &ConsumerAccessControl{
+		apiKeys:        apiKeys,
+		keyLimits:      keyLimits,
+		ipLimits:       config.IpLimits,
+		keyBuckets:     map[string]*accessBuckets{},
+		ipBuckets:      map[string]*accessBuckets{},
+		trustedProxies: trustedProxies,
+		maxIpBuckets:   MaxIpBuckets,
+		lastCleanup:    time.Now(),
+		now:            time.Now,
+		metricsManager: metricsManager,
+	}, nil
+}
+
+func (cac *ConsumerAccessControl) Enabled() bool {
+	return cac != nil
+}
+
+func (cac *ConsumerAccessControl) RequiresApiKey() bool {
+	return cac != nil && len(cac.apiKeys) > 0
+}
+
+func (cac *ConsumerAccessControl) getBuckets(buckets map[string]*accessBuckets, key string, limits AccessLimits, now time.Time) *accessBuckets {
+	keyBuckets, ok := buckets[key]
+	if !ok {
+		keyBuckets = &accessBuckets{}
+		if limits.RequestsPerSecond > 0 {
+			keyBuckets.requests = common.NewTokenBucket(limits.RequestsPerSecond, now)
+		}
+		if limits.CuPerSecond > 0 {
+			keyBuckets.cu = common.NewTokenBucket(limits.CuPerSecond, now)
+		}
+		buckets[key] = keyBuckets
+	}
+	keyBuckets.refill(now)
+	return keyBuckets
+}
+
+func (cac *ConsumerAccessControl) cleanup(now time.Time, force bool) {
+	if !force && now.Sub(cac.lastCleanup) < AccessCleanupInterval {
+		return
+	}
+	cac.lastCleanup = now
+	for _, buckets := range []map[string]*accessBuckets{cac.keyBuckets, cac.ipBuckets} {
+		for key, keyBuckets := range buckets {
+			if keyBuckets.idle(now) {
+				delete(buckets, key)
+			}
+		}
+	}
+}
+
+// returns the buckets limiting the requests of an api key and an ip
+func (cac *ConsumerAccessControl) limitingBuckets(apiKeyName string, consumerIp string, now time.Time) []*accessBuckets {
+	limiting := []*accessBuckets{}
+	if limits, ok := cac.keyLimits[apiKeyName]; ok && limits.enabled() {
+		limiting = append(limiting, cac.getBuckets(cac.keyBuckets, apiKeyName, limits, now))
+	}
+	if consumerIp != "" && cac.ipLimits.enabled() {
+		if _, ok := cac.ipBuckets[consumerIp]; !ok && len(cac.ipBuckets) >= cac.maxIpBuckets {
+			cac.cleanup(now, true)
+			if len(cac.ipBuckets) >= cac.maxIpBuckets {
+				consumerIp = overflowIpBucketsKey
+			}
+		}
+		limiting = append(limiting, cac.getBuckets(cac.ipBuckets, consumerIp, cac.ipLimits, now))
+	}
+	return limiting
+}
+
+func (cac *ConsumerAccessControl) isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, proxyNet := range cac.trustedProxies {
+		if proxyNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIp returns the ip the requests are limited by. it's the socket peer of the request, unless the peer is a
+// trusted proxy, then the forwarding chain is walked from the closest hop until the first hop that isn't trusted
+func (cac *ConsumerAccessControl) clientIp(consumerIp string, peerIp string) string {
+	if peerIp == "" {
+		// the consumer ip is the socket peer (websocket and grpc connections)
+		peerIp = consumerIp
+	}
+	clientIp := normalizeConsumerIp(peerIp)
+	if !cac.isTrustedProxy(clientIp) {
+		return clientIp
+	}
+	forwarded := strings.Split(consumerIp, ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := normalizeConsumerIp(forwarded[i])
+		if hop == "" {
+			continue
+		}
+		clientIp = hop
+		if !cac.isTrustedProxy(hop) {
+			break
+		}
+	}
+	return clientIp
+}
+
+// Authorize validates the api key and takes a request from the api key's and ip's buckets, it returns the api key's name.
+// the consumer ip is the forwarding header of the request (if any) and the peer ip is the request's socket peer
+func (cac *ConsumerAccessControl) Authorize(apiKey string, consumerIp string, peerIp string) (apiKeyName string, err error) {
+	if cac == nil {
+		return "", nil
+	}
+	if cac.RequiresApiKey() {
+		name, ok := cac.apiKeys[apiKey]
+		if !ok {
+			return "", common.UnauthorizedApiKeyError
+		}
+		apiKeyName = name
+	}
+
+	cac.lock.Lock()
+	defer cac.lock.Unlock()
+	now := cac.now()
+	cac.cleanup(now, false)
+	limiting := cac.limitingBuckets(apiKeyName, cac.clientIp(consumerIp, peerIp), now)
+	for _, buckets := range limiting {
+		if !buckets.allows() {
+			return apiKeyName, common.RateLimitExceededError.Wrapf("api key: %s", apiKeyName)
+		}
+	}
+	for _, buckets := range limiting {
+		if buckets.requests != nil {
+			buckets.requests.Take(1)
+		}
+	}
+	return apiKeyName, nil
+}
+
+// OnRelayDone charges the relay's cu from the api key's and ip's buckets
+func (cac *ConsumerAccessControl) OnRelayDone(apiKeyName string, consumerIp string, peerIp string, cu uint64) {
+	if cac == nil || cu == 0 {
+		return
+	}
+	cac.lock.Lock()
+	defer cac.lock.Unlock()
+	now := cac.now()
+	for _, buckets := range cac.limitingBuckets(apiKeyName, cac.clientIp(consumerIp, peerIp), now) {
+		if buckets.cu != nil {
+			buckets.cu.Take(float64(cu))
+		}
+	}
+}
+
+func (cac *ConsumerAccessControl) setRelayMetrics(apiKeyName string, chainID string, apiInterface string, cu uint64, err error) {
+	if !cac.RequiresApiKey() {
+		return
+	}
+	result := apiKeyResultSuccess
+	switch {
+	case common.UnauthorizedApiKeyError.Is(err):
+		result = apiKeyResultUnauthorized
+	case common.RateLimitExceededError.Is(err):
+		result = apiKeyResultRateLimited
+	case err != nil:
+		result = apiKeyResultError
+	}
+	if apiKeyName == "" {
+		apiKeyName = apiKeyUnknownName
+	}
+	cac.metricsManager.SetApiKeyRelayMetrics(apiKeyName, chainID, apiInterface, cu, result)
+}
+
+// WrapRelaySender returns a relay sender that authorizes every relay before sending it
+func (cac *ConsumerAccessControl) WrapRelaySender(relaySender RelaySender, chainID string, apiInterface string) RelaySender {
+	if cac == nil {
+		return relaySender
+	}
+	return &accessControlledRelaySender{RelaySender: relaySender, accessControl: cac, chainID: chainID, apiInterface: apiInterface}
+}
+
+type accessControlledRelaySender struct {
+	RelaySender
+	accessControl *ConsumerAccessControl
+	chainID       string
+	apiInterface  string
+}
+
+func (acrs *accessControlledRelaySender) SendRelay(
+	ctx context.Context,
+	url string,
+	req string,
+	connectionType string,
+	dappID string,
+	consumerIp string,
+	analytics *metrics.RelayMetrics,
+	metadataValues []pairingtypes.Metadata,
+) (*common.RelayResult, error) {
+	apiKey, metadataValues := extractApiKey(ctx, metadataValues)
+	peerIp, _ := ctx.Value(peerIpContextKey{}).(string)
+	apiKeyName, err := acrs.accessControl.Authorize(apiKey, consumerIp, peerIp)
+	if err != nil {
+		acrs.accessControl.setRelayMetrics(apiKeyName, acrs.chainID, acrs.apiInterface, 0, err)
+		return &common.RelayResult{StatusCode: accessErrorStatusCode(err)}, err
+	}
+	relayResult, err := acrs.RelaySender.SendRelay(ctx, url, req, connectionType, dappID, consumerIp, analytics, metadataValues)
+	var cu uint64
+	if analytics != nil {
+		cu = analytics.ComputeUnits
+	}
+	acrs.accessControl.OnRelayDone(apiKeyName, consumerIp, peerIp, cu)
+	acrs.accessControl.setRelayMetrics(apiKeyName, acrs.chainID, acrs.apiInterface, cu, err)
+	return relayResult, err
+}
+
+func withApiKey(ctx context.Context, apiKey string) context.Context {
+	if apiKey == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, apiKeyContextKey{}, apiKey)
+}
+
+// the consumer ip of http requests is taken from the forwarding header, the socket peer is kept for the rate limits
+func withPeerIp(ctx context.Context, peerIp string) context.Context {
+	return context.WithValue(ctx, peerIpContextKey{}, peerIp)
+}
+
+// the api key is taken from the context or from the headers, the header is removed so it's not sent to the providers
+func extractApiKey(ctx context.Context, metadataValues []pairingtypes.Metadata) (apiKey string, filtered []pairingtypes.Metadata) {
+	apiKey, _ = ctx.Value(apiKeyContextKey{}).(string)
+	filtered = make([]pairingtypes.Metadata, 0, len(metadataValues))
+	for _, header := range metadataValues {
+		if strings.EqualFold(header.Name, ApiKeyHeaderName) {
+			if apiKey == "" {
+				apiKey = header.Value
+			}
+			continue
+		}
+		filtered = append(filtered, header)
+	}
+	return apiKey, filtered
+}
+
+// websocket connections are authenticated on every message with the api key of the upgrade request
+func apiKeyFromWebsocket(websocketConn *websocket.Conn) string {
+	apiKey, _ := websocketConn.Locals(apiKeyMatchString).(string)
+	return apiKey
+}
+
+// moves the api key from the path to the header so the relay sender finds it like any header key
+func withApiKeyFromPath(handler fiber.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		c.Request().Header.Set(ApiKeyHeaderName, c.Params(apiKeyMatchString))
+		return handler(c)
+	}
+}
+
+func apiKeyPathPrefix() string {
+	return "/" + ApiKeyPathMarker + "/:" + apiKeyMatchString
+}
+
+func addApiKeyPathWebsocketRoutes(app *fiber.App, websocketCallback fiber.Handler) {
+	prefix := apiKeyPathPrefix()
+	app.Use(prefix+"/ws", func(c *fiber.Ctx) error {
+		if websocket.IsWebSocketUpgrade(c) {
+			c.Locals("allowed", true)
+			return c.Next()
+		}
+		return fiber.ErrUpgradeRequired
+	})
+	app.Get(prefix+"/ws", withApiKeyFromPath(websocketCallback))
+	app.Get(prefix+"/websocket", withApiKeyFromPath(websocketCallback))
+}
+
+func normalizeConsumerIp(consumerIp string) string {
+	consumerIp = strings.TrimSpace(consumerIp)
+	if host, _, err := net.SplitHostPort(consumerIp); err == nil {
+		return host
+	}
+	return consumerIp
+}
+
+// trusted proxies are configured as cidrs or single ips
+func parseIpNet(proxy string) (*net.IPNet, error) {
+	if strings.Contains(proxy, "/") {
+		_, proxyNet, err := net.ParseCIDR(proxy)
+		return proxyNet, err
+	}
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid ip %s", proxy)
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+func accessErrorStatusCode(err error) int {
+	if common.UnauthorizedApiKeyError.Is(err) {
+		return http.StatusUnauthorized
+	}
+	return http.StatusTooManyRequests
+}
+
+func accessErrorGrpcCode(err error) (codes.Code, bool) {
+	switch {
+	case common.UnauthorizedApiKeyError.Is(err):
+		return codes.Unauthenticated, true
+	case common.RateLimitExceededError.Is(err):
+		return codes.ResourceExhausted, true
+	}
+	return codes.OK, false
+}
@@ -0,0 +1,202 @@
+package chainlib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	common "github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/metrics"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+type mockRelaySender struct {
+	cu       uint64
+	metadata []pairingtypes.Metadata
+	calls    int
+}
+
+func (mrs *mockRelaySender) SendRelay(ctx context.Context, url string, req string, connectionType string, dappID string, consumerIp string, analytics *metrics.RelayMetrics, metadataValues []pairingtypes.Metadata) (*common.RelayResult, error) {
+	mrs.calls++
+	mrs.metadata = metadataValues
+	if analytics != nil {
+		analytics.ComputeUnits = mrs.cu
+	}
+	return &common.RelayResult{Reply: &pairingtypes.RelayReply{Data: []byte("ok")}}, nil
+}
+
+func TestConsumerAccessControlDisabled(t *testing.T) {
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, accessControl)
+	relaySender := &mockRelaySender{}
+	require.Equal(t, relaySender, accessControl.WrapRelaySender(relaySender, "LAV1", "rest"))
+	_, err = accessControl.Authorize("", "1.1.1.1", "")
+	require.NoError(t, err)
+}
+
+func TestConsumerAccessControlConfig(t *testing.T) {
+	_, err := NewConsumerAccessControl(ConsumerAccessControlConfig{ApiKeys: []ApiKeyConfig{{Name: "team", Key: ""}}}, nil)
+	require.Error(t, err)
+	_, err = NewConsumerAccessControl(ConsumerAccessControlConfig{ApiKeys: []ApiKeyConfig{{Name: "team", Key: "a"}, {Name: "team2", Key: "a"}}}, nil)
+	require.Error(t, err)
+	_, err = NewConsumerAccessControl(ConsumerAccessControlConfig{ApiKeys: []ApiKeyConfig{{Name: "team", Key: "a"}, {Name: "team", Key: "b"}}}, nil)
+	require.Error(t, err)
+}
+
+func TestConsumerAccessControlApiKeys(t *testing.T) {
+	now := time.Now()
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{
+		ApiKeys: []ApiKeyConfig{
+			{Name: "limited", Key: "key1", AccessLimits: AccessLimits{RequestsPerSecond: 2}},
+			{Name: "default", Key: "key2"},
+		},
+		DefaultKeyLimits: AccessLimits{CuPerSecond: 100},
+	}, nil)
+	require.NoError(t, err)
+	accessControl.now = func() time.Time { return now }
+
+	_, err = accessControl.Authorize("", "1.1.1.1", "")
+	require.ErrorIs(t, err, common.UnauthorizedApiKeyError)
+	_, err = accessControl.Authorize("wrong", "1.1.1.1", "")
+	require.ErrorIs(t, err, common.UnauthorizedApiKeyError)
+
+	// requests limit of the key's own
+	for i := 0; i < 2; i++ {
+		name, err := accessControl.Authorize("key1", "1.1.1.1", "")
+		require.NoError(t, err)
+		require.Equal(t, "limited", name)
+	}
+	_, err = accessControl.Authorize("key1", "1.1.1.1", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	now = now.Add(500 * time.Millisecond)
+	_, err = accessControl.Authorize("key1", "1.1.1.1", "")
+	require.NoError(t, err)
+
+	// the default cu limit is charged after the relay, a key in debt is blocked until it refills
+	_, err = accessControl.Authorize("key2", "1.1.1.1", "")
+	require.NoError(t, err)
+	accessControl.OnRelayDone("default", "1.1.1.1", "", 150)
+	_, err = accessControl.Authorize("key2", "1.1.1.1", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	now = now.Add(time.Second)
+	_, err = accessControl.Authorize("key2", "1.1.1.1", "")
+	require.NoError(t, err)
+}
+
+func TestConsumerAccessControlIpLimits(t *testing.T) {
+	now := time.Now()
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{IpLimits: AccessLimits{RequestsPerSecond: 1}}, nil)
+	require.NoError(t, err)
+	require.False(t, accessControl.RequiresApiKey())
+	accessControl.now = func() time.Time { return now }
+
+	_, err = accessControl.Authorize("", "1.1.1.1:4000", "")
+	require.NoError(t, err)
+	// same client through another port
+	_, err = accessControl.Authorize("", "1.1.1.1:4001", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	// the forwarding header of an untrusted peer is ignored
+	_, err = accessControl.Authorize("", "4.4.4.4", "1.1.1.1")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	_, err = accessControl.Authorize("", "2.2.2.2", "")
+	require.NoError(t, err)
+
+	// idle buckets are removed
+	now = now.Add(AccessBucketIdleTimeout + AccessCleanupInterval)
+	_, err = accessControl.Authorize("", "3.3.3.3", "")
+	require.NoError(t, err)
+	require.Len(t, accessControl.ipBuckets, 1)
+}
+
+func TestConsumerAccessControlTrustedProxies(t *testing.T) {
+	_, err := NewConsumerAccessControl(ConsumerAccessControlConfig{IpLimits: AccessLimits{RequestsPerSecond: 1}, TrustedProxies: []string{"proxy"}}, nil)
+	require.Error(t, err)
+
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{IpLimits: AccessLimits{RequestsPerSecond: 1}, TrustedProxies: []string{"10.0.0.0/8", "20.0.0.1"}}, nil)
+	require.NoError(t, err)
+	accessControl.now = time.Now
+
+	// the client is the last hop before the trusted proxies
+	_, err = accessControl.Authorize("", "6.6.6.6, 1.1.1.1, 10.0.0.2", "20.0.0.1:5000")
+	require.NoError(t, err)
+	_, err = accessControl.Authorize("", "1.1.1.1", "10.0.0.3")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	// spoofed hops before the client don't change its ip
+	_, err = accessControl.Authorize("", "7.7.7.7, 1.1.1.1", "10.0.0.3")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	// requests of the trusted proxy itself
+	_, err = accessControl.Authorize("", "20.0.0.1", "20.0.0.1")
+	require.NoError(t, err)
+	_, err = accessControl.Authorize("", "20.0.0.1", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+}
+
+func TestConsumerAccessControlMaxIpBuckets(t *testing.T) {
+	now := time.Now()
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{IpLimits: AccessLimits{RequestsPerSecond: 1}}, nil)
+	require.NoError(t, err)
+	accessControl.now = func() time.Time { return now }
+	accessControl.maxIpBuckets = 2
+
+	_, err = accessControl.Authorize("", "1.1.1.1", "")
+	require.NoError(t, err)
+	_, err = accessControl.Authorize("", "2.2.2.2", "")
+	require.NoError(t, err)
+	// new clients share the overflow bucket
+	_, err = accessControl.Authorize("", "3.3.3.3", "")
+	require.NoError(t, err)
+	_, err = accessControl.Authorize("", "4.4.4.4", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	require.Len(t, accessControl.ipBuckets, 3)
+
+	// idle buckets make room for new clients
+	now = now.Add(AccessBucketIdleTimeout)
+	_, err = accessControl.Authorize("", "4.4.4.4", "")
+	require.NoError(t, err)
+	_, err = accessControl.Authorize("", "4.4.4.4", "")
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+}
+
+func TestAccessControlledRelaySender(t *testing.T) {
+	accessControl, err := NewConsumerAccessControl(ConsumerAccessControlConfig{
+		ApiKeys: []ApiKeyConfig{{Name: "team", Key: "secret", AccessLimits: AccessLimits{CuPerSecond: 10}}},
+	}, nil)
+	require.NoError(t, err)
+	mock := &mockRelaySender{cu: 20}
+	relaySender := accessControl.WrapRelaySender(mock, "LAV1", "rest")
+
+	relayResult, err := relaySender.SendRelay(context.Background(), "/", "", http.MethodGet, "dapp", "1.1.1.1", metrics.NewRelayAnalytics("dapp", "LAV1", "rest"), nil)
+	require.ErrorIs(t, err, common.UnauthorizedApiKeyError)
+	require.Equal(t, http.StatusUnauthorized, relayResult.GetStatusCode())
+	require.Zero(t, mock.calls)
+
+	// the api key header isn't sent to the providers
+	headers := []pairingtypes.Metadata{{Name: "Lava-Api-Key", Value: "secret"}, {Name: "other", Value: "value"}}
+	_, err = relaySender.SendRelay(context.Background(), "/", "", http.MethodGet, "dapp", "1.1.1.1", metrics.NewRelayAnalytics("dapp", "LAV1", "rest"), headers)
+	require.NoError(t, err)
+	require.Equal(t, []pairingtypes.Metadata{{Name: "other", Value: "value"}}, mock.metadata)
+
+	// the relay cost more cu than allowed, the next one is rate limited
+	relayResult, err = relaySender.SendRelay(withApiKey(context.Background(), "secret"), "/", "", http.MethodGet, "dapp", "1.1.1.1", metrics.NewRelayAnalytics("dapp", "LAV1", "rest"), nil)
+	require.ErrorIs(t, err, common.RateLimitExceededError)
+	require.Equal(t, http.StatusTooManyRequests, relayResult.GetStatusCode())
+	require.Equal(t, 1, mock.calls)
+}
+
+func TestApiKeyFromPath(t *testing.T) {
+	app := fiber.New()
+	handler := func(c *fiber.Ctx) error {
+		return c.SendString(c.Get(ApiKeyHeaderName) + " " + c.Params("*"))
+	}
+	app.Get(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handler))
+	resp, err := app.Test(httptest.NewRequest(http.MethodGet, "/key/secret/cosmos/base/v1", nil))
+	require.NoError(t, err)
+	body := make([]byte, 100)
+	n, _ := resp.Body.Read(body)
+	require.Equal(t, "secret cosmos/base/v1", string(body[:n]))
+}
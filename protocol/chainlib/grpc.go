@@ -40,6 +40,7 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	reflectionpbo "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type GrpcNodeErrorResponse struct {
@@ -69,8 +70,14 @@ func (gdc *grpcDescriptorCache) setDescriptor(methodName string, descriptor *des
 type GrpcChainParser struct {
 	BaseChainParser
 
-	registry *dyncodec.Registry
-	codec    *dyncodec.Codec
+	registry         *dyncodec.Registry
+	codec            *dyncodec.Codec
+	streamingMethods sync.Map // method name is the key, grpcMethodStreams is the value
+}
+
+type grpcMethodStreams struct {
+	clientStreams bool
+	serverStreams bool
 }
 
 // NewGrpcChainParser creates a new instance of GrpcChainParser
@@ -109,6 +116,32 @@ func (apip *GrpcChainParser) setupForProvider(reflectionConnection *grpc.ClientC
 	return nil
 }
 
+// returns whether the client and the server of a method send a stream of messages, resolved from the reflected descriptors
+func (apip *GrpcChainParser) methodStreams(method string) (clientStreams bool, serverStreams bool) {
+	if streams, ok := apip.streamingMethods.Load(method); ok {
+		converted, success := streams.(grpcMethodStreams)
+		if success {
+			return converted.clientStreams, converted.serverStreams
+		}
+	}
+	if apip.registry == nil || strings.HasPrefix(method, "grpc.reflection.") {
+		// reflection is relayed as unary, it's used to build the registry
+		return false, false
+	}
+	descriptor, err := apip.registry.FindDescriptorByName(protoreflect.FullName(strings.ReplaceAll(method, "/", ".")))
+	if err != nil {
+		utils.LavaFormatDebug("failed resolving grpc method descriptor, handling as unary", utils.LogAttr("method", method), utils.LogAttr("error", err))
+		return false, false
+	}
+	methodDescriptor, ok := descriptor.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false, false
+	}
+	streams := grpcMethodStreams{clientStreams: methodDescriptor.IsStreamingClient(), serverStreams: methodDescriptor.IsStreamingServer()}
+	apip.streamingMethods.Store(method, streams)
+	return streams.clientStreams, streams.serverStreams
+}
+
 func (apip *GrpcChainParser) CraftMessage(parsing *spectypes.ParseDirective, connectionType string, craftData *CraftData, metadata []pairingtypes.Metadata) (ChainMessageForSend, error) {
 	if craftData != nil {
 		chainMessage, err := apip.ParseMsg(craftData.Path, craftData.Data, craftData.ConnectionType, metadata, extensionslib.ExtensionInfo{LatestBlock: 0})
@@ -157,9 +190,25 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 
 	settingHeaderDirective, _, _ := apip.GetParsingByTag(spectypes.FUNCTION_TAG_SET_LATEST_IN_METADATA)
 
+	// a client stream is relayed with all of its messages, the requested block is parsed from the first one
+	clientStreams, serverStreams := apip.methodStreams(url)
+	msgData := data
+	messagesCount := uint64(1)
+	if clientStreams {
+		msgs, err := rpcInterfaceMessages.DecodeGrpcStreamMessages(data)
+		if err != nil {
+			return nil, utils.LavaFormatError("failed decoding grpc client stream messages", err, utils.LogAttr("method", url))
+		}
+		msgData = nil
+		if len(msgs) > 0 {
+			msgData = msgs[0]
+		}
+		messagesCount = uint64(len(msgs))
+	}
+
 	// Construct grpcMessage
 	grpcMessage := rpcInterfaceMessages.GrpcMessage{
-		Msg:         data,
+		Msg:         msgData,
 		Path:        url,
 		Codec:       apip.codec,
 		Registry:    apip.registry,
@@ -189,7 +238,18 @@ func (apip *GrpcChainParser) ParseMsg(url string, data []byte, connectionType st
 		}
 	}
 
-	nodeMsg := apip.newChainMessage(apiCont.api, requestedBlock, &grpcMessage, apiCollection)
+	api := apiCont.api
+	if clientStreams || serverStreams {
+		grpcMessage.Msg = data
+		// streams are billed per message, the request pays for the client's messages and a server stream
+		// is relayed as a subscription that pays for every message the server sends
+		streamApi := *api
+		streamApi.ComputeUnits = api.ComputeUnits * messagesCount
+		streamApi.Category.Subscription = serverStreams
+		api = &streamApi
+	}
+
+	nodeMsg := apip.newChainMessage(api, requestedBlock, &grpcMessage, apiCollection)
 	apip.BaseChainParser.ExtensionParsing(apiCollection.CollectionData.AddOn, nodeMsg, extensionInfo)
 	return nodeMsg, apip.BaseChainParser.Validate(nodeMsg)
 }
@@ -262,6 +322,9 @@ type GrpcChainListener struct {
 	chainParser    *GrpcChainParser
 	healthReporter HealthReporter
 	refererData    *RefererData
+	accessControl  *ConsumerAccessControl
+
+	reflectionV1Server *dyncodec.ReflectionV1Server
 }
 
 func NewGrpcChainListener(
@@ -272,6 +335,7 @@ func NewGrpcChainListener(
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
 	chainParser ChainParser,
 	refererData *RefererData,
+	accessControl *ConsumerAccessControl,
 ) (chainListener *GrpcChainListener) {
 	// Create a new instance of GrpcChainListener
 	chainListener = &GrpcChainListener{
@@ -281,6 +345,8 @@ func NewGrpcChainListener(
 		chainParser.(*GrpcChainParser),
 		healthReporter,
 		refererData,
+		accessControl,
+		nil,
 	}
 	return chainListener
 }
@@ -293,56 +359,28 @@ func (apil *GrpcChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 	}
 
 	lis := GetListenerWithRetryGrpc("tcp", apil.endpoint.NetworkAddress)
-	apiInterface := apil.endpoint.ApiInterface
 	sendRelayCallback := func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
-		if method == "grpc.reflection.v1.ServerReflection/ServerReflectionInfo" {
-			return nil, nil, status.Error(codes.Unimplemented, "v1 reflection currently not supported by cosmos-sdk")
-		}
-
-		guid := utils.GenerateUniqueIdentifier()
-		ctx = utils.WithUniqueIdentifier(ctx, guid)
-		msgSeed := strconv.FormatUint(guid, 10)
-		metadataValues, _ := metadata.FromIncomingContext(ctx)
-		startTime := time.Now()
-		// Extract dappID from grpc header
-		dappID := extractDappIDFromGrpcHeader(metadataValues)
-
-		grpcHeaders := convertToMetadataMapOfSlices(metadataValues)
-		utils.LavaFormatDebug("in <<< GRPC Relay ",
-			utils.LogAttr("GUID", ctx),
-			utils.LogAttr("_method", method),
-			utils.LogAttr("headers", grpcHeaders),
-		)
-		metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apiInterface)
-		consumerIp := common.GetIpFromGrpcContext(ctx)
-		relayResult, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, consumerIp, metricsData, grpcHeaders)
-		relayReply := relayResult.GetReply()
-		go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
-
+		relayResult, err := apil.sendRelay(ctx, method, reqBody)
 		if err != nil {
-			errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
-			apil.logger.LogRequestAndResponse("http in/out", true, method, string(reqBody), "", errMasking, msgSeed, time.Since(startTime), err)
-			return nil, nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
+			return nil, nil, err
 		}
-		apil.logger.LogRequestAndResponse("http in/out", false, method, string(reqBody), "", "", msgSeed, time.Since(startTime), nil)
-
-		// try checking for node errors.
-		nodeError := &GrpcNodeErrorResponse{}
-		unMarshalingError := json.Unmarshal(relayReply.Data, nodeError)
-		metadataToReply := relayReply.Metadata
-		if unMarshalingError == nil {
-			return nil, convertRelayMetaDataToMDMetaData(metadataToReply), status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+		relayReply := relayResult.GetReply()
+		metadataToReply := convertRelayMetaDataToMDMetaData(relayReply.Metadata)
+		if nodeError := grpcNodeErrorFromReply(relayReply.Data); nodeError != nil {
+			return nil, metadataToReply, nodeError
 		}
-		return relayReply.Data, convertRelayMetaDataToMDMetaData(metadataToReply), nil
+		return relayReply.Data, metadataToReply, nil
 	}
 
-	_, httpServer, err := grpcproxy.NewGRPCProxy(sendRelayCallback, apil.endpoint.HealthCheckPath, cmdFlags, apil.healthReporter)
+	_, httpServer, err := grpcproxy.NewGRPCProxy(sendRelayCallback, apil, apil.endpoint.HealthCheckPath, cmdFlags, apil.healthReporter)
 	if err != nil {
 		utils.LavaFormatFatal("provider failure RegisterServer", err, utils.Attribute{Key: "listenAddr", Value: apil.endpoint.NetworkAddress})
 	}
 
 	// setup chain parser
 	apil.chainParser.setupForConsumer(sendRelayCallback)
+	// reflection v1 is served from the registry as providers' nodes only support v1alpha
+	apil.reflectionV1Server = dyncodec.NewReflectionV1Server(apil.chainParser.registry, sendRelayCallback)
 
 	utils.LavaFormatInfo("Server listening", utils.Attribute{Key: "Address", Value: lis.Addr()})
 
@@ -373,6 +411,107 @@ func (apil *GrpcChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 	}
 }
 
+func (apil *GrpcChainListener) sendRelay(ctx context.Context, method string, reqBody []byte) (*common.RelayResult, error) {
+	guid := utils.GenerateUniqueIdentifier()
+	ctx = utils.WithUniqueIdentifier(ctx, guid)
+	msgSeed := strconv.FormatUint(guid, 10)
+	metadataValues, _ := metadata.FromIncomingContext(ctx)
+	startTime := time.Now()
+	// Extract dappID from grpc header
+	dappID := extractDappIDFromGrpcHeader(metadataValues)
+
+	grpcHeaders := convertToMetadataMapOfSlices(metadataValues)
+	utils.LavaFormatDebug("in <<< GRPC Relay ",
+		utils.LogAttr("GUID", ctx),
+		utils.LogAttr("_method", method),
+		utils.LogAttr("headers", grpcHeaders),
+	)
+	metricsData := metrics.NewRelayAnalytics(dappID, apil.endpoint.ChainID, apil.endpoint.ApiInterface)
+	consumerIp := common.GetIpFromGrpcContext(ctx)
+	relayResult, err := apil.relaySender.SendRelay(ctx, method, string(reqBody), "", dappID, consumerIp, metricsData, grpcHeaders)
+	go apil.logger.AddMetricForGrpc(metricsData, err, &metadataValues)
+
+	if err != nil {
+		errMasking := apil.logger.GetUniqueGuidResponseForError(err, msgSeed)
+		apil.logger.LogRequestAndResponse("http in/out", true, method, string(reqBody), "", errMasking, msgSeed, time.Since(startTime), err)
+		if code, ok := accessErrorGrpcCode(err); ok {
+			return nil, status.Error(code, errMasking)
+		}
+		return nil, utils.LavaFormatError("Failed to SendRelay", fmt.Errorf(errMasking))
+	}
+	apil.logger.LogRequestAndResponse("http in/out", false, method, string(reqBody), "", "", msgSeed, time.Since(startTime), nil)
+	return relayResult, nil
+}
+
+// LocalHandler returns the handlers of the methods the consumer serves itself
+func (apil *GrpcChainListener) LocalHandler(method string) (handler grpc.StreamHandler, found bool) {
+	if method == dyncodec.ReflectionV1Method && apil.reflectionV1Server != nil {
+		return apil.reflectionV1Server.ServerReflectionInfo, true
+	}
+	return nil, false
+}
+
+// MethodStreams resolves if a method supported by the spec streams messages, so the proxy relays it as a stream
+func (apil *GrpcChainListener) MethodStreams(ctx context.Context, method string) (clientStreams bool, serverStreams bool) {
+	if _, err := apil.chainParser.getSupportedApi(method, ""); err != nil {
+		// unsupported methods are rejected by the unary relay
+		return false, false
+	}
+	return apil.chainParser.methodStreams(method)
+}
+
+// RelayStream relays a streaming method, a server stream is carried over a subscription and every message the
+// provider forwards is sent to the client until the stream ends
+func (apil *GrpcChainListener) RelayStream(ctx context.Context, method string, reqBodies [][]byte, send func(respBody []byte, md metadata.MD) error) error {
+	var reqBody []byte
+	if clientStreams, _ := apil.chainParser.methodStreams(method); clientStreams {
+		reqBody = rpcInterfaceMessages.EncodeGrpcStreamMessages(reqBodies)
+	} else if len(reqBodies) > 0 {
+		reqBody = reqBodies[0]
+	}
+	relayResult, err := apil.sendRelay(ctx, method, reqBody)
+	if err != nil {
+		return err
+	}
+	relayReply := relayResult.GetReply()
+	if nodeError := grpcNodeErrorFromReply(relayReply.Data); nodeError != nil {
+		return nodeError
+	}
+	err = send(relayReply.Data, convertRelayMetaDataToMDMetaData(relayReply.Metadata))
+	if err != nil {
+		return err
+	}
+	replyServer := relayResult.GetReplyServer()
+	if replyServer == nil {
+		return nil
+	}
+	for {
+		reply, err := (*replyServer).Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return utils.LavaFormatError("grpc stream ended with an error", err, utils.LogAttr("GUID", ctx), utils.LogAttr("method", method))
+		}
+		if nodeError := grpcNodeErrorFromReply(reply.Data); nodeError != nil {
+			return nodeError
+		}
+		err = send(reply.Data, nil)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// the provider returns node errors as a json GrpcNodeErrorResponse, they are converted back to a grpc status
+func grpcNodeErrorFromReply(data []byte) error {
+	nodeError := &GrpcNodeErrorResponse{}
+	if json.Unmarshal(data, nodeError) != nil {
+		return nil
+	}
+	return status.Error(codes.Code(nodeError.ErrorCode), nodeError.ErrorMessage)
+}
+
 type GrpcChainProxy struct {
 	BaseChainProxy
 	conn             grpcConnectorInterface
@@ -427,14 +566,17 @@ func newGrpcChainProxy(ctx context.Context, averageBlockTime time.Duration, pars
 }
 
 func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{}, chainMessage ChainMessageForSend) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
-	if ch != nil {
-		return nil, "", nil, utils.LavaFormatError("Subscribe is not allowed on grpc", nil, utils.Attribute{Key: "GUID", Value: ctx})
-	}
 	conn, err := cp.conn.GetRpc(ctx, true)
 	if err != nil {
 		return nil, "", nil, utils.LavaFormatError("grpc get connection failed ", err, utils.Attribute{Key: "GUID", Value: ctx})
 	}
-	defer cp.conn.ReturnRpc(conn)
+	// a server stream keeps the connection until the stream ends
+	returnConn := true
+	defer func() {
+		if returnConn {
+			cp.conn.ReturnRpc(conn)
+		}
+	}()
 
 	rpcInputMessage := chainMessage.GetRPCMessage()
 	nodeMessage, ok := rpcInputMessage.(*rpcInterfaceMessages.GrpcMessage)
@@ -476,28 +618,32 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 		cp.descriptorsCache.setDescriptor(methodName, methodDescriptor)
 	}
 
+	if ch != nil && !methodDescriptor.IsServerStreaming() {
+		return nil, "", nil, utils.LavaFormatError("Subscribe is only allowed on grpc server streaming methods", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: nodeMessage.Path})
+	}
+	if ch == nil && methodDescriptor.IsServerStreaming() {
+		return nil, "", nil, utils.LavaFormatError("grpc server streaming methods must be relayed as a subscription", nil, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "method", Value: nodeMessage.Path})
+	}
+
 	msgFactory := dynamic.NewMessageFactoryWithDefaults()
 
-	var reader io.Reader
-	msg := msgFactory.NewMessage(methodDescriptor.GetInputType())
-	formatMessage := false
-	if len(nodeMessage.Msg) > 0 {
-		// guess if json or binary
-		if nodeMessage.Msg[0] != '{' && nodeMessage.Msg[0] != '[' {
-			msgLocal := msgFactory.NewMessage(methodDescriptor.GetInputType())
-			err = proto.Unmarshal(nodeMessage.Msg, msgLocal)
-			if err != nil {
-				return nil, "", nil, utils.LavaFormatError("Failed to unmarshal proto.Unmarshal(nodeMessage.Msg, msgLocal)", err)
-			}
-			jsonBytes, err := marshalJSON(msgLocal)
-			if err != nil {
-				return nil, "", nil, utils.LavaFormatError("Failed to unmarshal marshalJSON(msgLocal)", err)
-			}
-			reader = bytes.NewReader(jsonBytes)
-		} else {
-			reader = bytes.NewReader(nodeMessage.Msg)
+	// client streaming requests hold all of the client's messages
+	requestMsgs := [][]byte{}
+	if methodDescriptor.IsClientStreaming() {
+		requestMsgs, err = rpcInterfaceMessages.DecodeGrpcStreamMessages(nodeMessage.Msg)
+		if err != nil {
+			return nil, "", nil, utils.LavaFormatError("Failed decoding grpc client stream messages", err, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+	} else if len(nodeMessage.Msg) > 0 {
+		requestMsgs = append(requestMsgs, nodeMessage.Msg)
+	}
+	reader := new(bytes.Buffer)
+	for _, requestMsg := range requestMsgs {
+		jsonBytes, err := grpcRequestToJSON(msgFactory, methodDescriptor, requestMsg)
+		if err != nil {
+			return nil, "", nil, err
 		}
-		formatMessage = true
+		reader.Write(jsonBytes)
 	}
 
 	rp, formatter, err := grpcurl.RequestParserAndFormatter(grpcurl.FormatJSON, descriptorSource, reader, grpcurl.FormatOptions{
@@ -512,12 +658,6 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	// used when parsing the grpc result
 	nodeMessage.SetParsingData(methodDescriptor, formatter)
 
-	if formatMessage {
-		err = rp.Next(msg)
-		if err != nil {
-			return nil, "", nil, utils.LavaFormatError("rp.Next(msg) Failed", err, utils.Attribute{Key: "GUID", Value: ctx})
-		}
-	}
 	if debug {
 		utils.LavaFormatDebug("provider sending node message",
 			utils.Attribute{Key: "_method", Value: nodeMessage.Path},
@@ -525,6 +665,17 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 			utils.Attribute{Key: "apiInterface", Value: "grpc"},
 		)
 	}
+	if methodDescriptor.IsClientStreaming() || methodDescriptor.IsServerStreaming() {
+		returnConn = false
+		return cp.sendStreamNodeMsg(ctx, ch, conn, chainMessage, nodeMessage.Path, methodDescriptor, msgFactory, rp, len(requestMsgs))
+	}
+	msg := msgFactory.NewMessage(methodDescriptor.GetInputType())
+	if len(requestMsgs) > 0 {
+		err = rp.Next(msg)
+		if err != nil {
+			return nil, "", nil, utils.LavaFormatError("rp.Next(msg) Failed", err, utils.Attribute{Key: "GUID", Value: ctx})
+		}
+	}
 	var respHeaders metadata.MD
 	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
 	connectCtx, cancel := cp.CapTimeoutForSend(ctx, chainMessage)
@@ -561,6 +712,135 @@ func (cp *GrpcChainProxy) SendNodeMsg(ctx context.Context, ch chan interface{},
 	return reply, "", nil, nil
 }
 
+// sends a streaming request to the node, the first message of the node is returned as the reply, on server streams the
+// following messages are forwarded to ch until the stream ends. the connection is returned when the stream is done
+func (cp *GrpcChainProxy) sendStreamNodeMsg(ctx context.Context, ch chan interface{}, conn *grpc.ClientConn, chainMessage ChainMessageForSend, path string, methodDescriptor *desc.MethodDescriptor, msgFactory *dynamic.MessageFactory, rp grpcurl.RequestParser, requestsCount int) (relayReply *pairingtypes.RelayReply, subscriptionID string, relayReplyServer *rpcclient.ClientSubscription, err error) {
+	serverStreams := methodDescriptor.IsServerStreaming()
+	var streamCtx context.Context
+	var cancel context.CancelFunc
+	if serverStreams {
+		// the stream lives until the node ends it or the subscription is closed
+		streamCtx, cancel = context.WithCancel(ctx)
+	} else {
+		streamCtx, cancel = cp.CapTimeoutForSend(ctx, chainMessage)
+	}
+	endStream := func() {
+		cancel()
+		cp.conn.ReturnRpc(conn)
+	}
+	if !methodDescriptor.IsClientStreaming() {
+		// the server streams replies to a single request message
+		requestsCount = 1
+	}
+
+	streamDesc := &grpc.StreamDesc{StreamName: methodDescriptor.GetName(), ServerStreams: serverStreams, ClientStreams: methodDescriptor.IsClientStreaming()}
+	stream, err := conn.NewStream(streamCtx, streamDesc, "/"+path)
+	if err == nil {
+		for i := 0; i < requestsCount; i++ {
+			msg := msgFactory.NewMessage(methodDescriptor.GetInputType())
+			if errNext := rp.Next(msg); errNext != nil && errNext != io.EOF {
+				endStream()
+				return nil, "", nil, utils.LavaFormatError("rp.Next(msg) Failed", errNext, utils.Attribute{Key: "GUID", Value: ctx})
+			}
+			// when sending fails the node closed the stream, the reason is returned by RecvMsg
+			if stream.SendMsg(msg) != nil {
+				break
+			}
+		}
+		err = stream.CloseSend()
+	}
+	response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
+	if err == nil {
+		err = stream.RecvMsg(response)
+	}
+	var respHeaders metadata.MD
+	if stream != nil {
+		respHeaders, _ = stream.Header()
+	}
+	if err != nil {
+		endStream()
+		if parsedError := cp.HandleNodeError(ctx, err); parsedError != nil {
+			return nil, "", nil, parsedError
+		}
+		// the node's error is returned to the client as a reply, no subscription is created
+		respBytes, handlingError := parseGrpcNodeErrorToReply(ctx, err)
+		if handlingError != nil {
+			return nil, "", nil, handlingError
+		}
+		return &pairingtypes.RelayReply{Data: respBytes, Metadata: convertToMetadataMapOfSlices(respHeaders)}, "", nil, nil
+	}
+	respBytes, err := proto.Marshal(response)
+	if err != nil {
+		endStream()
+		return nil, "", nil, utils.LavaFormatError("proto.Marshal(response) Failed", err, utils.Attribute{Key: "GUID", Value: ctx})
+	}
+	reply := &pairingtypes.RelayReply{
+		Data:     respBytes,
+		Metadata: convertToMetadataMapOfSlices(respHeaders),
+	}
+	if !serverStreams {
+		endStream()
+		return reply, "", nil, nil
+	}
+
+	clientSub, end := rpcclient.NewStreamSubscription(cancel)
+	go func() {
+		defer endStream()
+		for {
+			response := msgFactory.NewMessage(methodDescriptor.GetOutputType())
+			err := stream.RecvMsg(response)
+			if err == io.EOF {
+				end(nil)
+				return
+			}
+			var data []byte
+			if err == nil {
+				data, err = proto.Marshal(response)
+			} else if streamCtx.Err() == nil {
+				// the node ended the stream with an error, it's forwarded to the client as the last message
+				data, err = parseGrpcNodeErrorToReply(ctx, err)
+				if err == nil {
+					err = io.EOF
+				}
+			}
+			if data != nil {
+				select {
+				case ch <- data:
+				case <-streamCtx.Done():
+					end(streamCtx.Err())
+					return
+				}
+			}
+			if err != nil {
+				if err == io.EOF {
+					err = nil
+				}
+				end(err)
+				return
+			}
+		}
+	}()
+	return reply, strconv.FormatUint(utils.GenerateUniqueIdentifier(), 10), clientSub, nil
+}
+
+// converts a request message to json, the message is either json or a binary proto message
+func grpcRequestToJSON(msgFactory *dynamic.MessageFactory, methodDescriptor *desc.MethodDescriptor, requestMsg []byte) ([]byte, error) {
+	// guess if json or binary
+	if len(requestMsg) > 0 && (requestMsg[0] == '{' || requestMsg[0] == '[') {
+		return requestMsg, nil
+	}
+	msgLocal := msgFactory.NewMessage(methodDescriptor.GetInputType())
+	err := proto.Unmarshal(requestMsg, msgLocal)
+	if err != nil {
+		return nil, utils.LavaFormatError("Failed to unmarshal proto.Unmarshal(nodeMessage.Msg, msgLocal)", err)
+	}
+	jsonBytes, err := marshalJSON(msgLocal)
+	if err != nil {
+		return nil, utils.LavaFormatError("Failed to unmarshal marshalJSON(msgLocal)", err)
+	}
+	return jsonBytes, nil
+}
+
 // This method assumes that the error is due to misuse of the request arguments, meaning the user would like to get
 // the response from the server to fix the request arguments. this method will make sure the user will get the response
 // from the node in the same format as expected.
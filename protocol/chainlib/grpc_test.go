@@ -3,6 +3,8 @@ package chainlib
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
@@ -12,11 +14,17 @@ import (
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/parser"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/interop/grpc_testing"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/proto"
 )
 
 const (
@@ -291,3 +299,113 @@ func TestSettingBlocksHeadersGrpc(t *testing.T) {
 		})
 	}
 }
+
+type streamingTestService struct {
+	grpc_testing.UnimplementedTestServiceServer
+}
+
+func (streamingTestService) StreamingOutputCall(req *grpc_testing.StreamingOutputCallRequest, stream grpc_testing.TestService_StreamingOutputCallServer) error {
+	for _, params := range req.ResponseParameters {
+		err := stream.Send(&grpc_testing.StreamingOutputCallResponse{Payload: &grpc_testing.Payload{Body: make([]byte, params.Size)}})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (streamingTestService) StreamingInputCall(stream grpc_testing.TestService_StreamingInputCallServer) error {
+	size := 0
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&grpc_testing.StreamingInputCallResponse{AggregatedPayloadSize: int32(size)})
+		}
+		if err != nil {
+			return err
+		}
+		size += len(req.Payload.Body)
+	}
+}
+
+func TestGrpcChainProxyStreaming(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	grpcServer := grpc.NewServer()
+	grpc_testing.RegisterTestServiceServer(grpcServer, streamingTestService{})
+	reflection.Register(grpcServer)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	const serverStreamApi = "grpc.testing.TestService/StreamingOutputCall"
+	const clientStreamApi = "grpc.testing.TestService/StreamingInputCall"
+	collectionKey := CollectionKey{ConnectionType: ""}
+	chainParser := &GrpcChainParser{
+		BaseChainParser: BaseChainParser{
+			serverApis: map[ApiKey]ApiContainer{
+				{Name: serverStreamApi}: {api: &spectypes.Api{Name: serverStreamApi, Enabled: true, ComputeUnits: 10}, collectionKey: collectionKey},
+				{Name: clientStreamApi}: {api: &spectypes.Api{Name: clientStreamApi, Enabled: true, ComputeUnits: 10}, collectionKey: collectionKey},
+			},
+			apiCollections: map[CollectionKey]*spectypes.ApiCollection{collectionKey: {Enabled: true, CollectionData: spectypes.CollectionData{ApiInterface: spectypes.APIInterfaceGrpc}}},
+		},
+	}
+	endpoint := lavasession.RPCProviderEndpoint{ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceGrpc, NodeUrls: []common.NodeUrl{{Url: lis.Addr().String()}}}
+	chainProxy, err := NewGrpcChainProxy(ctx, 1, endpoint, chainParser)
+	require.NoError(t, err)
+
+	// a server stream is a subscription, the first message is the reply and the rest are sent on the channel
+	reqBytes, err := proto.Marshal(&grpc_testing.StreamingOutputCallRequest{ResponseParameters: []*grpc_testing.ResponseParameters{{Size: 1}, {Size: 2}, {Size: 3}}})
+	require.NoError(t, err)
+	chainMessage, err := chainParser.ParseMsg(serverStreamApi, reqBytes, "", nil, extensionslib.ExtensionInfo{})
+	require.NoError(t, err)
+	require.True(t, IsSubscription(chainMessage))
+	require.Equal(t, uint64(10), GetComputeUnits(chainMessage))
+
+	ch := make(chan interface{})
+	reply, subscriptionID, clientSub, err := chainProxy.SendNodeMsg(ctx, ch, chainMessage)
+	require.NoError(t, err)
+	require.NotEmpty(t, subscriptionID)
+	require.NotNil(t, clientSub)
+	sizes := []int{}
+	readPayloadSize := func(data []byte) {
+		resp := &grpc_testing.StreamingOutputCallResponse{}
+		require.NoError(t, proto.Unmarshal(data, resp))
+		sizes = append(sizes, len(resp.Payload.Body))
+	}
+	readPayloadSize(reply.Data)
+	for done := false; !done; {
+		select {
+		case msg := <-ch:
+			data, ok := msg.([]byte)
+			require.True(t, ok)
+			readPayloadSize(data)
+		case err := <-clientSub.Err():
+			require.NoError(t, err)
+			done = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for the stream to end")
+		}
+	}
+	require.Equal(t, []int{1, 2, 3}, sizes)
+	clientSub.Unsubscribe()
+
+	// a client stream is relayed with all of its messages and billed for each of them
+	msgs := [][]byte{}
+	for _, body := range []string{"ab", "cde"} {
+		msg, err := proto.Marshal(&grpc_testing.StreamingInputCallRequest{Payload: &grpc_testing.Payload{Body: []byte(body)}})
+		require.NoError(t, err)
+		msgs = append(msgs, msg)
+	}
+	chainMessage, err = chainParser.ParseMsg(clientStreamApi, rpcInterfaceMessages.EncodeGrpcStreamMessages(msgs), "", nil, extensionslib.ExtensionInfo{})
+	require.NoError(t, err)
+	require.False(t, IsSubscription(chainMessage))
+	require.Equal(t, uint64(20), GetComputeUnits(chainMessage))
+	reply, _, clientSub, err = chainProxy.SendNodeMsg(ctx, nil, chainMessage)
+	require.NoError(t, err)
+	require.Nil(t, clientSub)
+	resp := &grpc_testing.StreamingInputCallResponse{}
+	require.NoError(t, proto.Unmarshal(reply.Data, resp))
+	require.Equal(t, int32(5), resp.AggregatedPayloadSize)
+}
@@ -0,0 +1,100 @@
+package dyncodec
+
+import (
+	"context"
+	"io"
+
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
+	"github.com/lavanet/lava/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	ReflectionV1Method      = "grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+	ReflectionV1AlphaMethod = "grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+)
+
+// ReflectionV1Server serves grpc reflection v1 from the registry. requests the registry can't answer
+// are relayed as v1alpha, both versions have the same wire format so the messages are relayed as they are.
+type ReflectionV1Server struct {
+	registry *Registry
+	relay    grpcproxy.ProxyCallBack
+}
+
+func NewReflectionV1Server(registry *Registry, relay grpcproxy.ProxyCallBack) *ReflectionV1Server {
+	return &ReflectionV1Server{registry: registry, relay: relay}
+}
+
+// ServerReflectionInfo handles a reflection stream over the proxy's raw bytes codec
+func (rs *ReflectionV1Server) ServerReflectionInfo(srv interface{}, stream grpc.ServerStream) error {
+	for {
+		var reqBytes []byte
+		err := stream.RecvMsg(&reqBytes)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		respBytes, err := rs.handleRequest(stream.Context(), reqBytes)
+		if err != nil {
+			return err
+		}
+		err = stream.SendMsg(respBytes)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (rs *ReflectionV1Server) handleRequest(ctx context.Context, reqBytes []byte) ([]byte, error) {
+	req := &grpc_reflection_v1.ServerReflectionRequest{}
+	err := proto.Unmarshal(reqBytes, req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reflection request: %s", err)
+	}
+
+	var fd protoreflect.FileDescriptor
+	switch msgReq := req.MessageRequest.(type) {
+	case *grpc_reflection_v1.ServerReflectionRequest_FileByFilename:
+		fd, err = rs.registry.FindFileByPath(msgReq.FileByFilename)
+	case *grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol:
+		var descriptor protoreflect.Descriptor
+		descriptor, err = rs.registry.FindDescriptorByName(protoreflect.FullName(msgReq.FileContainingSymbol))
+		if err == nil {
+			fd = descriptor.ParentFile()
+		}
+	default:
+		// services and extensions are listed by the providers
+		var respBytes []byte
+		respBytes, _, err = rs.relay(ctx, ReflectionV1AlphaMethod, reqBytes)
+		if err == nil {
+			return respBytes, nil
+		}
+	}
+
+	resp := &grpc_reflection_v1.ServerReflectionResponse{ValidHost: req.Host, OriginalRequest: req}
+	if err == nil {
+		var fdBytes []byte
+		fdBytes, err = proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+		resp.MessageResponse = &grpc_reflection_v1.ServerReflectionResponse_FileDescriptorResponse{
+			FileDescriptorResponse: &grpc_reflection_v1.FileDescriptorResponse{FileDescriptorProto: [][]byte{fdBytes}},
+		}
+	}
+	if err != nil {
+		utils.LavaFormatDebug("failed serving reflection request", utils.LogAttr("request", req.String()), utils.LogAttr("error", err))
+		resp.MessageResponse = &grpc_reflection_v1.ServerReflectionResponse_ErrorResponse{
+			ErrorResponse: &grpc_reflection_v1.ErrorResponse{ErrorCode: int32(status.Code(err)), ErrorMessage: err.Error()},
+		}
+		if status.Code(err) == codes.Unknown {
+			resp.GetErrorResponse().ErrorCode = int32(codes.NotFound)
+		}
+	}
+	return proto.Marshal(resp)
+}
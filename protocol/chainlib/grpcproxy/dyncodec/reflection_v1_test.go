@@ -0,0 +1,89 @@
+package dyncodec
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy"
+	"github.com/lavanet/lava/protocol/chainlib/grpcproxy/testproto"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type reflectionLocalServer struct {
+	server *ReflectionV1Server
+}
+
+func (rls reflectionLocalServer) LocalHandler(method string) (grpc.StreamHandler, bool) {
+	if method == ReflectionV1Method {
+		return rls.server.ServerReflectionInfo, true
+	}
+	return nil, false
+}
+
+func (reflectionLocalServer) MethodStreams(ctx context.Context, method string) (bool, bool) {
+	return false, false
+}
+
+func (reflectionLocalServer) RelayStream(ctx context.Context, method string, reqBodies [][]byte, send func(respBody []byte, md metadata.MD) error) error {
+	return nil
+}
+
+func TestReflectionV1Server(t *testing.T) {
+	// the node only serves v1alpha, like the cosmos-sdk
+	nodeSrv := grpc.NewServer()
+	grpc_reflection_v1alpha.RegisterServerReflectionServer(nodeSrv, reflection.NewServer(reflection.ServerOptions{Services: nodeSrv}))
+	nodeConn := testproto.InMemoryClientConn(t, nodeSrv)
+	relayedMethods := []string{}
+	relay := func(ctx context.Context, method string, req []byte) ([]byte, metadata.MD, error) {
+		relayedMethods = append(relayedMethods, method)
+		var resp []byte
+		err := nodeConn.Invoke(ctx, "/"+method, req, &resp, grpc.CustomCodecCallOption{Codec: grpcproxy.RawBytesCodec{}})
+		return resp, make(metadata.MD), err
+	}
+	registry := NewRegistry(NewRelayerRemote(relay))
+	proxySrv, _, err := grpcproxy.NewGRPCProxy(relay, reflectionLocalServer{server: NewReflectionV1Server(registry, relay)}, "", common.ConsumerCmdFlags{}, nil)
+	require.NoError(t, err)
+
+	client := grpc_reflection_v1.NewServerReflectionClient(testproto.InMemoryClientConn(t, proxySrv))
+	stream, err := client.ServerReflectionInfo(context.Background())
+	require.NoError(t, err)
+	// several requests are answered on the same stream
+	request := func(req *grpc_reflection_v1.ServerReflectionRequest) *grpc_reflection_v1.ServerReflectionResponse {
+		require.NoError(t, stream.Send(req))
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+		return resp
+	}
+
+	resp := request(&grpc_reflection_v1.ServerReflectionRequest{Host: "test", MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "grpc.reflection.v1alpha.ServerReflection"}})
+	require.Equal(t, "test", resp.ValidHost)
+	fdBytes := resp.GetFileDescriptorResponse().GetFileDescriptorProto()
+	require.Len(t, fdBytes, 1)
+	fd := &descriptorpb.FileDescriptorProto{}
+	require.NoError(t, proto.Unmarshal(fdBytes[0], fd))
+	require.Equal(t, "grpc.reflection.v1alpha", fd.GetPackage())
+
+	// the file is cached in the registry
+	relayedCount := len(relayedMethods)
+	resp = request(&grpc_reflection_v1.ServerReflectionRequest{MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileByFilename{FileByFilename: fd.GetName()}})
+	require.Len(t, resp.GetFileDescriptorResponse().GetFileDescriptorProto(), 1)
+	require.Len(t, relayedMethods, relayedCount)
+
+	// listing services is relayed to the node as v1alpha
+	resp = request(&grpc_reflection_v1.ServerReflectionRequest{MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{}})
+	require.NotEmpty(t, resp.GetListServicesResponse().GetService())
+	require.Equal(t, ReflectionV1AlphaMethod, relayedMethods[len(relayedMethods)-1])
+
+	resp = request(&grpc_reflection_v1.ServerReflectionRequest{MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "not.a.Symbol"}})
+	require.Equal(t, int32(codes.NotFound), resp.GetErrorResponse().GetErrorCode())
+	require.NoError(t, stream.CloseSend())
+}
@@ -42,7 +42,7 @@ func (r RelayerRemote) sendReq(req *grpc_reflection_v1alpha.ServerReflectionRequ
 		return nil, err
 	}
 
-	respBytes, _, err := r.relay(context.Background(), "grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", reqBytes)
+	respBytes, _, err := r.relay(context.Background(), ReflectionV1AlphaMethod, reqBytes)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package grpcproxy
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
@@ -18,12 +19,24 @@ import (
 
 type ProxyCallBack = func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error)
 
+// StreamRelayer relays the methods where the client or the server send a stream of messages,
+// the client's messages are read until it closes its side and are relayed together
+type StreamRelayer interface {
+	MethodStreams(ctx context.Context, method string) (clientStreams bool, serverStreams bool)
+	RelayStream(ctx context.Context, method string, reqBodies [][]byte, send func(respBody []byte, md metadata.MD) error) error
+}
+
+// LocalServer serves some methods on the proxy itself instead of relaying them, it's checked on the StreamRelayer
+type LocalServer interface {
+	LocalHandler(method string) (handler grpc.StreamHandler, found bool)
+}
+
 type HealthReporter interface {
 	IsHealthy() bool
 }
 
-func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.ConsumerCmdFlags, healthReporter HealthReporter) (*grpc.Server, *http.Server, error) {
-	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb)), grpc.ForceServerCodec(RawBytesCodec{}))
+func NewGRPCProxy(cb ProxyCallBack, streamRelayer StreamRelayer, healthCheckPath string, cmdFlags common.ConsumerCmdFlags, healthReporter HealthReporter) (*grpc.Server, *http.Server, error) {
+	s := grpc.NewServer(grpc.UnknownServiceHandler(makeProxyFunc(cb, streamRelayer)), grpc.ForceServerCodec(RawBytesCodec{}))
 	wrappedServer := grpcweb.WrapServer(s)
 	handler := func(resp http.ResponseWriter, req *http.Request) {
 		// Set CORS headers
@@ -60,13 +73,24 @@ func NewGRPCProxy(cb ProxyCallBack, healthCheckPath string, cmdFlags common.Cons
 	return s, httpServer, nil
 }
 
-func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
+func makeProxyFunc(callBack ProxyCallBack, streamRelayer StreamRelayer) grpc.StreamHandler {
 	return func(srv interface{}, stream grpc.ServerStream) error {
 		// currently the callback function does not account for headers.
 		methodName, ok := grpc.MethodFromServerStream(stream)
 		if !ok {
 			return status.Error(codes.Unavailable, "unable to get method name")
 		}
+		if localServer, ok := streamRelayer.(LocalServer); ok {
+			if handler, found := localServer.LocalHandler(methodName[1:]); found {
+				return handler(srv, stream)
+			}
+		}
+		if streamRelayer != nil {
+			clientStreams, serverStreams := streamRelayer.MethodStreams(stream.Context(), methodName[1:])
+			if clientStreams || serverStreams {
+				return proxyStream(streamRelayer, stream, methodName[1:], clientStreams)
+			}
+		}
 		var reqBytes []byte
 		err := stream.RecvMsg(&reqBytes)
 		if err != nil {
@@ -81,6 +105,32 @@ func makeProxyFunc(callBack ProxyCallBack) grpc.StreamHandler {
 	}
 }
 
+func proxyStream(streamRelayer StreamRelayer, stream grpc.ServerStream, methodName string, clientStreams bool) error {
+	reqBodies := [][]byte{}
+	for {
+		var reqBytes []byte
+		err := stream.RecvMsg(&reqBytes)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		reqBodies = append(reqBodies, reqBytes)
+		if !clientStreams {
+			break
+		}
+	}
+	headerSent := false
+	return streamRelayer.RelayStream(stream.Context(), methodName, reqBodies, func(respBody []byte, md metadata.MD) error {
+		if !headerSent {
+			stream.SetHeader(md)
+			headerSent = true
+		}
+		return stream.SendMsg(respBody)
+	})
+}
+
 type RawBytesCodec struct{}
 
 func (RawBytesCodec) Marshal(v interface{}) ([]byte, error) {
@@ -2,11 +2,14 @@ package grpcproxy
 
 import (
 	"context"
+	"io"
+	"strings"
 	"testing"
 
 	"github.com/lavanet/lava/protocol/chainlib/grpcproxy/testproto"
 	"github.com/lavanet/lava/protocol/common"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -21,7 +24,7 @@ func TestGRPCProxy(t *testing.T) {
 		responseHeaders := make(metadata.MD)
 		responseHeaders["test-headers"] = append(responseHeaders["test-headers"], "55")
 		return respBytes, responseHeaders, nil
-	}, "", common.ConsumerCmdFlags{HeadersFlag: "*", OriginFlag: "*", MethodsFlag: "GET,POST,OPTIONS", CDNCacheDuration: "86400"}, nil)
+	}, nil, "", common.ConsumerCmdFlags{HeadersFlag: "*", OriginFlag: "*", MethodsFlag: "GET,POST,OPTIONS", CDNCacheDuration: "86400"}, nil)
 	require.NoError(t, err)
 
 	client := testproto.NewTestClient(testproto.InMemoryClientConn(t, proxyGRPCSrv))
@@ -37,3 +40,76 @@ func TestGRPCProxy(t *testing.T) {
 	do()
 	do()
 }
+
+type mockStreamRelayer struct{}
+
+func (mockStreamRelayer) MethodStreams(ctx context.Context, method string) (clientStreams bool, serverStreams bool) {
+	return strings.HasSuffix(method, "ClientStream"), strings.HasSuffix(method, "ServerStream")
+}
+
+func (mockStreamRelayer) RelayStream(ctx context.Context, method string, reqBodies [][]byte, send func(respBody []byte, md metadata.MD) error) error {
+	if strings.HasSuffix(method, "ClientStream") {
+		// joins all the client's messages into a single reply
+		joined := []string{}
+		for _, reqBody := range reqBodies {
+			joined = append(joined, string(reqBody))
+		}
+		return send([]byte(strings.Join(joined, ",")), metadata.Pairs("test-headers", "55"))
+	}
+	// echoes the request once per character
+	for i := range reqBodies[0] {
+		if err := send(reqBodies[0][i:i+1], metadata.Pairs("test-headers", "55")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGRPCProxyStreams(t *testing.T) {
+	proxyGRPCSrv, _, err := NewGRPCProxy(func(ctx context.Context, method string, reqBody []byte) ([]byte, metadata.MD, error) {
+		return append(reqBody, []byte("-callback")...), nil, nil
+	}, mockStreamRelayer{}, "", common.ConsumerCmdFlags{HeadersFlag: "*", OriginFlag: "*", MethodsFlag: "GET,POST,OPTIONS", CDNCacheDuration: "86400"}, nil)
+	require.NoError(t, err)
+
+	conn := testproto.InMemoryClientConn(t, proxyGRPCSrv)
+	ctx := context.Background()
+
+	// server streaming
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/test.Service/ServerStream", grpc.ForceCodec(RawBytesCodec{}))
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg([]byte("abc")))
+	require.NoError(t, stream.CloseSend())
+	replies := []string{}
+	for {
+		var reply []byte
+		err := stream.RecvMsg(&reply)
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		replies = append(replies, string(reply))
+	}
+	require.Equal(t, []string{"a", "b", "c"}, replies)
+	header, err := stream.Header()
+	require.NoError(t, err)
+	require.Equal(t, []string{"55"}, header.Get("test-headers"))
+
+	// client streaming
+	stream, err = conn.NewStream(ctx, &grpc.StreamDesc{ClientStreams: true}, "/test.Service/ClientStream", grpc.ForceCodec(RawBytesCodec{}))
+	require.NoError(t, err)
+	for _, msg := range []string{"a", "b", "c"} {
+		require.NoError(t, stream.SendMsg([]byte(msg)))
+	}
+	require.NoError(t, stream.CloseSend())
+	var reply []byte
+	require.NoError(t, stream.RecvMsg(&reply))
+	require.Equal(t, "a,b,c", string(reply))
+
+	// unary methods still use the callback
+	stream, err = conn.NewStream(ctx, &grpc.StreamDesc{}, "/test.Service/Unary", grpc.ForceCodec(RawBytesCodec{}))
+	require.NoError(t, err)
+	require.NoError(t, stream.SendMsg([]byte("abc")))
+	require.NoError(t, stream.CloseSend())
+	require.NoError(t, stream.RecvMsg(&reply))
+	require.Equal(t, "abc-callback", string(reply))
+}
@@ -283,6 +283,7 @@ type JsonRPCChainListener struct {
 	healthReporter HealthReporter
 	logger         *metrics.RPCConsumerLogs
 	refererData    *RefererData
+	accessControl  *ConsumerAccessControl
 }
 
 // NewJrpcChainListener creates a new instance of JsonRPCChainListener
@@ -290,6 +291,7 @@ func NewJrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEn
 	relaySender RelaySender, healthReporter HealthReporter,
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
 	refererData *RefererData,
+	accessControl *ConsumerAccessControl,
 ) (chainListener *JsonRPCChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &JsonRPCChainListener{
@@ -298,6 +300,7 @@ func NewJrpcChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEn
 		healthReporter,
 		rpcConsumerLogs,
 		refererData,
+		accessControl,
 	}
 
 	return chainListener
@@ -334,19 +337,22 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 		)
 		startTime := time.Now()
 		msgSeed := apil.logger.GetMessageSeed()
+		wsWriter := newWebsocketWriter(websockConn, apil.logger, spectypes.APIInterfaceJsonRPC, "jsonrpc ws msg")
 		for {
 			if messageType, msg, err = websockConn.ReadMessage(); err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
+				wsWriter.Close()
 				break
 			}
 			dappID, ok := websockConn.Locals("dapp-id").(string)
 			if !ok {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, nil, msgSeed, []byte("Unable to extract dappID"), spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(messageType, nil, msgSeed, []byte("Unable to extract dappID"), time.Since(startTime))
 			}
 			refererMatch, ok := websockConn.Locals(refererMatchString).(string)
 			ctx, cancel := context.WithCancel(context.Background())
 			guid := utils.GenerateUniqueIdentifier()
 			ctx = utils.WithUniqueIdentifier(ctx, guid)
+			ctx = withApiKey(ctx, apiKeyFromWebsocket(websockConn))
 			msgSeed = strconv.FormatUint(guid, 10)
 			defer cancel() // incase there's a problem make sure to cancel the connection
 
@@ -366,50 +372,29 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 			if ok && refererMatch != "" && apil.refererData != nil && err == nil {
 				go apil.refererData.SendReferer(refererMatch, chainID, string(msg), nil, websockConn)
 			}
-			reply := relayResult.GetReply()
 			replyServer := relayResult.GetReplyServer()
 			go apil.logger.AddMetricForWebSocket(metricsData, err, websockConn)
 			if err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
 				continue
 			}
 			// If subscribe the first reply would contain the RPC ID that can be used for disconnect.
 			if replyServer != nil {
-				var reply pairingtypes.RelayReply
-				err = (*replyServer).RecvMsg(&reply) // this reply contains the RPC ID
-				if err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				// the subscribe reply holds the subscription id, it's written before any of the subscription's replies
+				if err = wsWriter.WriteMessage(messageType, relayResult.GetReply().GetData()); err != nil {
+					cancel()
+					wsWriter.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
 					continue
 				}
-
-				if err = websockConn.WriteMessage(messageType, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
-					continue
-				}
-				apil.logger.LogRequestAndResponse("jsonrpc ws msg", false, "ws", websockConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				for {
-					err = (*replyServer).RecvMsg(&reply)
-					if err != nil {
-						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
-						break
-					}
-
-					// If portal cant write to the client
-					if err = websockConn.WriteMessage(messageType, reply.Data); err != nil {
-						cancel()
-						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
-						// break
-					}
-
-					apil.logger.LogRequestAndResponse("jsonrpc ws msg", false, "ws", websockConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				}
-			} else {
-				if err = websockConn.WriteMessage(messageType, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websockConn, messageType, err, msgSeed, msg, spectypes.APIInterfaceJsonRPC, time.Since(startTime))
-					continue
-				}
-				apil.logger.LogRequestAndResponse("jsonrpc ws msg", false, "ws", websockConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
+				// replies are forwarded in the background so the client can keep sending requests on this connection, e.g. eth_unsubscribe
+				go wsWriter.ForwardSubscription(cancel, replyServer, messageType, msgSeed, msg, startTime)
+				continue
 			}
+			if err = wsWriter.WriteMessage(messageType, relayResult.GetReply().GetData()); err != nil {
+				wsWriter.AnalyzeErrorAndWriteMessage(messageType, err, msgSeed, msg, time.Since(startTime))
+				continue
+			}
+			apil.logger.LogRequestAndResponse("jsonrpc ws msg", false, "ws", websockConn.LocalAddr().String(), string(msg), string(relayResult.GetReply().GetData()), msgSeed, time.Since(startTime), nil)
 		}
 	})
 	websocketCallbackWithDappID := constructFiberCallbackWithHeaderAndParameterExtraction(webSocketCallback, apil.logger.StoreMetricData)
@@ -428,6 +413,7 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 		defer cancel()
 		guid := utils.GenerateUniqueIdentifier()
 		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		ctx = withPeerIp(ctx, fiberCtx.IP())
 		msgSeed := strconv.FormatUint(guid, 10)
 		if test_mode {
 			apil.logger.LogTestMode(fiberCtx)
@@ -511,6 +497,10 @@ func (apil *JsonRPCChainListener) Serve(ctx context.Context, cmdFlags common.Con
 		app.Get("/"+apil.refererData.Marker+":"+refererMatchString+"/websocket", websocketCallbackWithDappIDAndReferer)
 		app.Post("/"+apil.refererData.Marker+":"+refererMatchString+"/*", handlerPost)
 	}
+	if apil.accessControl.RequiresApiKey() {
+		addApiKeyPathWebsocketRoutes(app, websocketCallbackWithDappID)
+		app.Post(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handlerPost))
+	}
 	app.Post("/*", handlerPost)
 	// Go
 	ListenWithRetry(app, apil.endpoint.NetworkAddress)
@@ -4,14 +4,21 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"strings"
 	"testing"
 	"time"
 
+	gorillawebsocket "github.com/gorilla/websocket"
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcInterfaceMessages"
 	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/metrics"
 	keepertest "github.com/lavanet/lava/testutil/keeper"
+	"github.com/lavanet/lava/utils/rand"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	plantypes "github.com/lavanet/lava/x/plans/types"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/assert"
@@ -341,3 +348,65 @@ func TestJsonRpcBatchCallSameID(t *testing.T) {
 		}
 	}()
 }
+
+type mockSubscriptionRelaySender struct {
+	replies chan *pairingtypes.RelayReply
+}
+
+func (m *mockSubscriptionRelaySender) SendRelay(ctx context.Context, url string, req string, connectionType string, dappID string, consumerIp string, analytics *metrics.RelayMetrics, metadataValues []pairingtypes.Metadata) (*common.RelayResult, error) {
+	var replyServer pairingtypes.Relayer_RelaySubscribeClient = &mockRelaySubscribeClient{ctx: ctx, replies: m.replies}
+	return &common.RelayResult{
+		Reply:       &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`)},
+		ReplyServer: &replyServer,
+		StatusCode:  http.StatusOK,
+	}, nil
+}
+
+type mockRelaySubscribeClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	ctx     context.Context
+	replies chan *pairingtypes.RelayReply
+}
+
+func (m *mockRelaySubscribeClient) Recv() (*pairingtypes.RelayReply, error) {
+	select {
+	case reply := <-m.replies:
+		return reply, nil
+	case <-m.ctx.Done():
+		return nil, m.ctx.Err()
+	}
+}
+
+func TestJsonRpcWebsocketSubscribeReply(t *testing.T) {
+	rand.InitRandomSeed()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := lis.Addr().String()
+	require.NoError(t, lis.Close())
+
+	logger, err := metrics.NewRPCConsumerLogs(nil, nil)
+	require.NoError(t, err)
+	relaySender := &mockSubscriptionRelaySender{replies: make(chan *pairingtypes.RelayReply, 1)}
+	endpoint := &lavasession.RPCEndpoint{NetworkAddress: address, ChainID: "LAV1", ApiInterface: spectypes.APIInterfaceJsonRPC}
+	listener := NewJrpcChainListener(context.Background(), endpoint, relaySender, nil, logger, nil, nil)
+	go listener.Serve(context.Background(), common.ConsumerCmdFlags{})
+
+	var conn *gorillawebsocket.Conn
+	require.Eventually(t, func() bool {
+		conn, _, err = gorillawebsocket.DefaultDialer.Dial("ws://"+address+"/ws", nil)
+		return err == nil
+	}, 5*time.Second, 50*time.Millisecond)
+	defer conn.Close()
+
+	require.NoError(t, conn.WriteMessage(gorillawebsocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_subscribe","params":["newHeads"]}`)))
+	relaySender.replies <- &pairingtypes.RelayReply{Data: []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1"}}`)}
+
+	// the subscribe reply with the subscription id is received before the subscription's replies
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, string(data))
+	_, data, err = conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0x1"}}`, string(data))
+}
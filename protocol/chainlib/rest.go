@@ -244,6 +244,7 @@ type RestChainListener struct {
 	healthReporter HealthReporter
 	logger         *metrics.RPCConsumerLogs
 	refererData    *RefererData
+	accessControl  *ConsumerAccessControl
 }
 
 // NewRestChainListener creates a new instance of RestChainListener
@@ -251,6 +252,7 @@ func NewRestChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEn
 	relaySender RelaySender, healthReporter HealthReporter,
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
 	refererData *RefererData,
+	accessControl *ConsumerAccessControl,
 ) (chainListener *RestChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &RestChainListener{
@@ -259,6 +261,7 @@ func NewRestChainListener(ctx context.Context, listenEndpoint *lavasession.RPCEn
 		healthReporter,
 		rpcConsumerLogs,
 		refererData,
+		accessControl,
 	}
 
 	return chainListener
@@ -292,6 +295,7 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		restHeaders := convertToMetadataMap(metadataValues)
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = withPeerIp(ctx, fiberCtx.IP())
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		guid, found := utils.GetUniqueIdentifier(ctx)
 		if found {
@@ -362,6 +366,7 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		restHeaders := convertToMetadataMap(metadataValues)
 		ctx, cancel := context.WithCancel(context.Background())
 		ctx = utils.WithUniqueIdentifier(ctx, utils.GenerateUniqueIdentifier())
+		ctx = withPeerIp(ctx, fiberCtx.IP())
 		guid, found := utils.GetUniqueIdentifier(ctx)
 		if found {
 			msgSeed = strconv.FormatUint(guid, 10)
@@ -420,6 +425,10 @@ func (apil *RestChainListener) Serve(ctx context.Context, cmdFlags common.Consum
 		app.Use("/"+apil.refererData.Marker+":"+refererMatchString+"/*", handlerUse)
 	}
 
+	if apil.accessControl.RequiresApiKey() {
+		app.Post(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handlerPost))
+		app.Use(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handlerUse))
+	}
 	app.Post("/*", handlerPost)
 	// Catch the others
 	app.Use("/*", handlerUse)
@@ -313,6 +313,7 @@ type TendermintRpcChainListener struct {
 	healthReporter HealthReporter
 	logger         *metrics.RPCConsumerLogs
 	refererData    *RefererData
+	accessControl  *ConsumerAccessControl
 }
 
 // NewTendermintRpcChainListener creates a new instance of TendermintRpcChainListener
@@ -320,6 +321,7 @@ func NewTendermintRpcChainListener(ctx context.Context, listenEndpoint *lavasess
 	relaySender RelaySender, healthReporter HealthReporter,
 	rpcConsumerLogs *metrics.RPCConsumerLogs,
 	refererData *RefererData,
+	accessControl *ConsumerAccessControl,
 ) (chainListener *TendermintRpcChainListener) {
 	// Create a new instance of JsonRPCChainListener
 	chainListener = &TendermintRpcChainListener{
@@ -328,6 +330,7 @@ func NewTendermintRpcChainListener(ctx context.Context, listenEndpoint *lavasess
 		healthReporter,
 		rpcConsumerLogs,
 		refererData,
+		accessControl,
 	}
 
 	return chainListener
@@ -362,19 +365,22 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		)
 		msgSeed := apil.logger.GetMessageSeed()
 		startTime := time.Now()
+		wsWriter := newWebsocketWriter(websocketConn, apil.logger, "tendermint", "tendermint ws")
 		for {
 			if mt, msg, err = websocketConn.ReadMessage(); err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(mt, err, msgSeed, msg, time.Since(startTime))
+				wsWriter.Close()
 				break
 			}
 			dappID, ok := websocketConn.Locals("dappId").(string)
 			if !ok {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, nil, msgSeed, []byte("Unable to extract dappID"), spectypes.APIInterfaceJsonRPC, time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(mt, nil, msgSeed, []byte("Unable to extract dappID"), time.Since(startTime))
 			}
 
 			ctx, cancel := context.WithCancel(context.Background())
 			guid := utils.GenerateUniqueIdentifier()
 			ctx = utils.WithUniqueIdentifier(ctx, guid)
+			ctx = withApiKey(ctx, apiKeyFromWebsocket(websocketConn))
 			defer cancel() // incase there's a problem make sure to cancel the connection
 
 			logFormattedMsg := string(msg)
@@ -395,49 +401,29 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 			if ok && refererMatch != "" && apil.refererData != nil && err == nil {
 				go apil.refererData.SendReferer(refererMatch, chainID, string(msg), nil, websocketConn)
 			}
-			reply := relayResult.GetReply()
 			replyServer := relayResult.GetReplyServer()
 			go apil.logger.AddMetricForWebSocket(metricsData, err, websocketConn)
 			if err != nil {
-				apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				wsWriter.AnalyzeErrorAndWriteMessage(mt, err, msgSeed, msg, time.Since(startTime))
 				continue
 			}
 			// If subscribe the first reply would contain the RPC ID that can be used for disconnect.
 			if replyServer != nil {
-				var reply pairingtypes.RelayReply
-				err = (*replyServer).RecvMsg(&reply) // this reply contains the RPC ID
-				if err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
+				// the subscribe reply holds the subscription id, it's written before any of the subscription's replies
+				if err = wsWriter.WriteMessage(mt, relayResult.GetReply().GetData()); err != nil {
+					cancel()
+					wsWriter.AnalyzeErrorAndWriteMessage(mt, err, msgSeed, msg, time.Since(startTime))
 					continue
 				}
-
-				if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-					continue
-				}
-				apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				for {
-					err = (*replyServer).RecvMsg(&reply)
-					if err != nil {
-						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-						break
-					}
-
-					// If portal cant write to the client
-					if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-						cancel()
-						apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-						// break
-					}
-					apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
-				}
-			} else {
-				if err = websocketConn.WriteMessage(mt, reply.Data); err != nil {
-					apil.logger.AnalyzeWebSocketErrorAndWriteMessage(websocketConn, mt, err, msgSeed, msg, "tendermint", time.Since(startTime))
-					continue
-				}
-				apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(reply.Data), msgSeed, time.Since(startTime), nil)
+				// replies are forwarded in the background so the client can keep sending requests on this connection, e.g. unsubscribe
+				go wsWriter.ForwardSubscription(cancel, replyServer, mt, msgSeed, msg, startTime)
+				continue
 			}
+			if err = wsWriter.WriteMessage(mt, relayResult.GetReply().GetData()); err != nil {
+				wsWriter.AnalyzeErrorAndWriteMessage(mt, err, msgSeed, msg, time.Since(startTime))
+				continue
+			}
+			apil.logger.LogRequestAndResponse("tendermint ws", false, "ws", websocketConn.LocalAddr().String(), string(msg), string(relayResult.GetReply().GetData()), msgSeed, time.Since(startTime), nil)
 		}
 	})
 	websocketCallbackWithDappID := constructFiberCallbackWithHeaderAndParameterExtraction(webSocketCallback, apil.logger.StoreMetricData)
@@ -455,6 +441,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		ctx, cancel := context.WithCancel(context.Background())
 		guid := utils.GenerateUniqueIdentifier()
 		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		ctx = withPeerIp(ctx, fiberCtx.IP())
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		msgSeed := strconv.FormatUint(guid, 10)
 		metadataValues := fiberCtx.GetReqHeaders()
@@ -527,6 +514,7 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		ctx, cancel := context.WithCancel(context.Background())
 		guid := utils.GenerateUniqueIdentifier()
 		ctx = utils.WithUniqueIdentifier(ctx, guid)
+		ctx = withPeerIp(ctx, fiberCtx.IP())
 		defer cancel() // incase there's a problem make sure to cancel the connection
 		metricsData := metrics.NewRelayAnalytics(dappID, chainID, apiInterface)
 		metadataValues := fiberCtx.GetReqHeaders()
@@ -597,6 +585,11 @@ func (apil *TendermintRpcChainListener) Serve(ctx context.Context, cmdFlags comm
 		app.Get("/"+apil.refererData.Marker+":"+refererMatchString+"/*", handlerGet)
 	}
 
+	if apil.accessControl.RequiresApiKey() {
+		addApiKeyPathWebsocketRoutes(app, websocketCallbackWithDappID)
+		app.Post(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handlerPost))
+		app.Get(apiKeyPathPrefix()+"/*", withApiKeyFromPath(handlerGet))
+	}
 	app.Post("/*", handlerPost)
 	app.Get("/*", handlerGet)
 	//
@@ -29,6 +29,7 @@ const (
 	RelayHealthIntervalFlag         = "relays-health-interval" // interval between each relay health check, default 5m
 	SharedStateFlag                 = "shared-state"
 	DisableConflictTransactionsFlag = "disable-conflict-transactions" // disable conflict transactions, this will hard the network's data reliability and therefore will harm the service.
+	HedgePercentileFlag             = "hedge-percentile"              // the percentile of a provider's expected latency after which a deterministic read is also sent to the next provider
 )
 
 const (
@@ -51,6 +52,7 @@ type ConsumerCmdFlags struct {
 	RelaysHealthIntervalFlag    time.Duration // interval for relay health check
 	DebugRelays                 bool          // enables debug mode for relays
 	DisableConflictTransactions bool          // disable conflict transactions
+	HedgePercentile             float64       // hedge deterministic reads after this percentile of the provider's latency, both providers may be paid. 0 disables hedging
 }
 
 // default rolling logs behavior (if enabled) will store 3 files each 100MB for up to 1 day every time.
@@ -19,6 +19,7 @@ const (
 	StatusCodeMetadataKey              = "status-code"
 	VersionMetadataKey                 = "lavap-version"
 	TimeOutForFetchingLavaBlocksFlag   = "timeout-for-fetching-lava-blocks"
+	LavaEventStreamFlag                = "lava-event-stream"
 )
 
 func ParseEndpointArgs(endpoint_strings, yaml_config_properties []string, endpointsConfigName string) (viper_endpoints *viper.Viper, err error) {
@@ -24,16 +24,23 @@ const (
 	RETRY_COUNT_HEADER_NAME                         = "Lava-Retries"
 	PROVIDER_LATEST_BLOCK_HEADER_NAME               = "Provider-Latest-Block"
 	GUID_HEADER_NAME                                = "Lava-Guid"
+	QUORUM_AGREED_HEADER_NAME                       = "Lava-Quorum-Agreed"
+	QUORUM_RESPONSES_HEADER_NAME                    = "Lava-Quorum-Responses"
+	STREAMED_CU_HEADER_NAME                         = "Lava-Streamed-Cu"
 	// these headers need to be lowercase
 	BLOCK_PROVIDERS_ADDRESSES_HEADER_NAME = "lava-providers-block"
 	RELAY_TIMEOUT_HEADER_NAME             = "lava-relay-timeout"
 	EXTENSION_OVERRIDE_HEADER_NAME        = "lava-extension"
 	FORCE_CACHE_REFRESH_HEADER_NAME       = "lava-force-cache-refresh"
+	QUORUM_HEADER_NAME                    = "lava-quorum" // "N/M" for N matching responses out of M providers (N > M/2), or "M" for a majority of M
 	// send http request to /lava/health to see if the process is up - (ret code 200)
 	DEFAULT_HEALTH_PATH                                       = "/lava/health"
 	MAXIMUM_ALLOWED_TIMEOUT_EXTEND_MULTIPLIER_BY_THE_CONSUMER = 4
 )
 
+// headers that identify the user behind a relay, the privacy strategy doesn't pass them to providers
+var IDENTIFYING_HEADER_NAMES = []string{"x-forwarded-for", "x-real-ip", "forwarded", "user-agent", "origin", "referer", "cookie"}
+
 type NodeUrl struct {
 	Url               string        `yaml:"url,omitempty" json:"url,omitempty" mapstructure:"url"`
 	InternalPath      string        `yaml:"internal-path,omitempty" json:"internal-path,omitempty" mapstructure:"internal-path"`
@@ -8,4 +8,6 @@ var (
 	StatusCodeError429           = sdkerrors.New("Disallowed StatusCode Error", 429, "Disallowed status code error")
 	StatusCodeErrorStrict        = sdkerrors.New("Disallowed StatusCode Error", 800, "Disallowed status code error")
 	APINotSupportedError         = sdkerrors.New("APINotSupported Error", 900, "api not supported")
+	UnauthorizedApiKeyError      = sdkerrors.New("UnauthorizedApiKey Error", 401, "missing or invalid api key")
+	RateLimitExceededError       = sdkerrors.New("RateLimitExceeded Error", 429, "rate limit exceeded")
 )
@@ -0,0 +1,44 @@
+package common
+
+import "time"
+
+// TokenBucket refills at rate tokens per second and holds up to a second worth of tokens, it is not thread safe
+type TokenBucket struct {
+	rate       float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func NewTokenBucket(rate float64, now time.Time) *TokenBucket {
+	return &TokenBucket{rate: rate, tokens: rate, lastRefill: now}
+}
+
+func (tb *TokenBucket) Refill(now time.Time) {
+	tb.tokens += now.Sub(tb.lastRefill).Seconds() * tb.rate
+	if tb.tokens > tb.rate {
+		tb.tokens = tb.rate
+	}
+	tb.lastRefill = now
+}
+
+// a cost bigger than the bucket can only be paid by a full bucket
+func (tb *TokenBucket) Cost(amount float64) float64 {
+	if amount > tb.rate {
+		return tb.rate
+	}
+	return amount
+}
+
+func (tb *TokenBucket) Allows(amount float64) bool {
+	return tb.tokens >= tb.Cost(amount)
+}
+
+// Take takes the full amount and can leave the bucket in debt, it then allows nothing until it's refilled.
+// to charge an amount that was checked with Allows without going into debt, take its Cost
+func (tb *TokenBucket) Take(amount float64) {
+	tb.tokens -= amount
+}
+
+func (tb *TokenBucket) LastRefill() time.Time {
+	return tb.lastRefill
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/lavanet/lava/utils/lavaslices"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/maps"
 )
 
 type FinalizationConsensus struct {
@@ -21,6 +22,13 @@ type FinalizationConsensus struct {
 	currentEpoch                     uint64
 	latestBlockByMedian              uint64 // for caching
 	specId                           string
+	providersAgreement               map[string]*providerAgreement // kept across epochs
+}
+
+// counts the finalization updates of a provider that were compared with the other providers
+type providerAgreement struct {
+	agreed    uint64
+	disagreed uint64
 }
 
 type ProviderHashesConsensus struct {
@@ -37,7 +45,9 @@ type providerDataContainer struct {
 	BlockHeight           int64
 	RelayNum              uint64
 	LatestBlock           int64
-	// TODO:: keep relay request for conflict reporting
+	// the signed reply and the relay session it was signed on, used as proof when a conflict is reported
+	Reply        *pairingtypes.RelayReply
+	RelaySession *pairingtypes.RelaySession
 }
 
 func NewFinalizationConsensus(specId string) *FinalizationConsensus {
@@ -67,11 +77,14 @@ func (fc *FinalizationConsensus) newProviderHashesConsensus(blockDistanceForFina
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		Reply:                 reply,
+		RelaySession:          req,
 	}
 	providerDataContainers := map[string]providerDataContainer{}
 	providerDataContainers[providerAcc] = newProviderDataContainer
 	return ProviderHashesConsensus{
-		FinalizedBlocksHashes: finalizedBlocks,
+		// the group's hashes are extended by the providers that join it, the provider's own hashes are kept as they were signed
+		FinalizedBlocksHashes: maps.Clone(finalizedBlocks),
 		agreeingProviders:     providerDataContainers,
 	}
 }
@@ -86,6 +99,8 @@ func (fc *FinalizationConsensus) insertProviderToConsensus(blockDistanceForFinal
 		RelayNum:              req.RelayNum,
 		BlockHeight:           req.Epoch,
 		LatestBlock:           latestBlock,
+		Reply:                 reply,
+		RelaySession:          req,
 	}
 	consensus.agreeingProviders[providerAcc] = newProviderDataContainer
 
@@ -110,17 +125,19 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 		fc.currentProviderHashesConsensus = append(make([]ProviderHashesConsensus, 0), newHashConsensus)
 	} else {
 		inserted := false
+		// the provider agrees with the others only if a hash it signed was compared and matched
+		matched := false
 		// Looks for discrepancy with current epoch providers
 		// go over all consensus groups, if there is a mismatch add it as a consensus group and send a conflict
 		for _, consensus := range fc.currentProviderHashesConsensus {
-			err := fc.discrepancyChecker(finalizedBlocks, consensus)
+			matchedGroup, conflictingBlock, err := fc.discrepancyChecker(finalizedBlocks, consensus)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
+				finalizationConflict = newFinalizationConflict(reply, req, consensus, conflictingBlock, finalizedBlocks[conflictingBlock])
 				// we need to insert into a new consensus group before returning
 				// or create new consensus group if no consensus matched
 				continue
 			}
+			matched = matched || matchedGroup
 
 			if !inserted {
 				// if no discrepency with this group and not inserted yet -> insert into consensus
@@ -136,17 +153,22 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 		}
 		if finalizationConflict != nil {
 			// means there was a conflict and we need to report
+			fc.updateProviderAgreement(providerAddress, false)
 			return finalizationConflict, utils.LavaFormatError("Simulation: Conflict found in discrepancyChecker", err)
 		}
 
 		// check for discrepancy with old epoch
 		for idx, consensus := range fc.prevEpochProviderHashesConsensus {
-			err := fc.discrepancyChecker(finalizedBlocks, consensus)
+			matchedGroup, conflictingBlock, err := fc.discrepancyChecker(finalizedBlocks, consensus)
 			if err != nil {
-				// TODO: bring the other data as proof
-				finalizationConflict = &conflicttypes.FinalizationConflict{RelayReply0: reply}
+				fc.updateProviderAgreement(providerAddress, false)
+				finalizationConflict = newFinalizationConflict(reply, req, consensus, conflictingBlock, finalizedBlocks[conflictingBlock])
 				return finalizationConflict, utils.LavaFormatError("Simulation: prev epoch Conflict found in discrepancyChecker", err, utils.Attribute{Key: "Consensus idx", Value: strconv.Itoa(idx)}, utils.Attribute{Key: "provider", Value: providerAddress})
 			}
+			matched = matched || matchedGroup
+		}
+		if matched {
+			fc.updateProviderAgreement(providerAddress, true)
 		}
 	}
 	if debug {
@@ -155,7 +177,37 @@ func (fc *FinalizationConsensus) UpdateFinalizedHashes(blockDistanceForFinalized
 	return finalizationConflict, nil
 }
 
-func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, consensus ProviderHashesConsensus) (errRet error) {
+// fc.providerDataContainersMu must be locked
+func (fc *FinalizationConsensus) updateProviderAgreement(providerAddress string, agreed bool) {
+	if fc.providersAgreement == nil {
+		fc.providersAgreement = map[string]*providerAgreement{}
+	}
+	agreement, ok := fc.providersAgreement[providerAddress]
+	if !ok {
+		agreement = &providerAgreement{}
+		fc.providersAgreement[providerAddress] = agreement
+	}
+	if agreed {
+		agreement.agreed++
+	} else {
+		agreement.disagreed++
+	}
+}
+
+// returns the share of the provider's finalization updates that agreed with the other providers
+func (fc *FinalizationConsensus) ProviderAgreement(providerAddress string) (agreement float64, found bool) {
+	fc.providerDataContainersMu.RLock()
+	defer fc.providerDataContainersMu.RUnlock()
+	providerAgreement, ok := fc.providersAgreement[providerAddress]
+	if !ok {
+		return 0, false
+	}
+	total := providerAgreement.agreed + providerAgreement.disagreed
+	return float64(providerAgreement.agreed) / float64(total), true
+}
+
+// returns whether any of the blocks in both finalization data have the same hash, and the block that has different hashes if there is one
+func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]string, consensus ProviderHashesConsensus) (matched bool, conflictingBlock int64, errRet error) {
 	var toIterate map[int64]string   // the smaller map between the two to compare
 	var otherBlocks map[int64]string // the other map
 
@@ -170,13 +222,26 @@ func (fc *FinalizationConsensus) discrepancyChecker(finalizedBlocksA map[int64]s
 	for blockNum, blockHash := range toIterate {
 		if otherHash, ok := otherBlocks[blockNum]; ok {
 			if blockHash != otherHash {
-				// TODO: gather discrepancy data
-				return utils.LavaFormatError("Simulation: reliability discrepancy, different hashes detected for block", HashesConsunsusError, utils.Attribute{Key: "blockNum", Value: blockNum}, utils.Attribute{Key: "Hashes", Value: fmt.Sprintf("%s vs %s", blockHash, otherHash)}, utils.Attribute{Key: "toIterate", Value: toIterate}, utils.Attribute{Key: "otherBlocks", Value: otherBlocks})
+				return false, blockNum, utils.LavaFormatError("Simulation: reliability discrepancy, different hashes detected for block", HashesConsunsusError, utils.Attribute{Key: "blockNum", Value: blockNum}, utils.Attribute{Key: "Hashes", Value: fmt.Sprintf("%s vs %s", blockHash, otherHash)}, utils.Attribute{Key: "toIterate", Value: toIterate}, utils.Attribute{Key: "otherBlocks", Value: otherBlocks})
 			}
+			matched = true
 		}
 	}
 
-	return nil
+	return matched, 0, nil
+}
+
+// the conflict holds the provider's reply and the reply of a provider in the consensus group that signed a different hash for the block
+func newFinalizationConflict(reply *pairingtypes.RelayReply, req *pairingtypes.RelaySession, consensus ProviderHashesConsensus, conflictingBlock int64, blockHash string) *conflicttypes.FinalizationConflict {
+	finalizationConflict := &conflicttypes.FinalizationConflict{RelayReply0: reply, RelaySession0: req}
+	for _, providerData := range consensus.agreeingProviders {
+		if otherHash, ok := providerData.FinalizedBlocksHashes[conflictingBlock]; ok && otherHash != blockHash {
+			finalizationConflict.RelayReply1 = providerData.Reply
+			finalizationConflict.RelaySession1 = providerData.RelaySession
+			break
+		}
+	}
+	return finalizationConflict
 }
 
 func (fc *FinalizationConsensus) NewEpoch(epoch uint64) {
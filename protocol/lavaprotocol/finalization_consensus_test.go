@@ -155,6 +155,79 @@ func TestConsensusHashesInsertion(t *testing.T) {
 	}
 }
 
+func TestProviderAgreement(t *testing.T) {
+	ctx := context.Background()
+	chainID := "LAV1"
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, chainID, "0", func(http.ResponseWriter, *http.Request) {}, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	epoch := uint64(200)
+	_, _, blockDistanceForFinalizedData, blocksInFinalizationProof := chainParser.ChainBlockStats()
+
+	// providers 1-3 agree, provider 0 reports different hashes twice
+	insertions := append(append(finalizationInsertionForProviders(chainID, epoch, 100, 1, 3, true, "", blocksInFinalizationProof, blockDistanceForFinalizedData),
+		finalizationInsertionForProviders(chainID, epoch, 100, 0, 1, false, "A", blocksInFinalizationProof, blockDistanceForFinalizedData)...),
+		finalizationInsertionForProviders(chainID, epoch, 100, 0, 1, false, "B", blocksInFinalizationProof, blockDistanceForFinalizedData)...)
+	finalizationConsensus := NewFinalizationConsensus(chainID)
+	finalizationConsensus.NewEpoch(epoch)
+	// provider 4 reports blocks none of the others reported, nothing is compared
+	insertions = append(insertions, finalizationInsertionForProviders(chainID, epoch, 1000, 4, 1, true, "", blocksInFinalizationProof, blockDistanceForFinalizedData)...)
+	for _, insertion := range insertions {
+		finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
+	}
+
+	// the first provider had no one to be compared with
+	_, found := finalizationConsensus.ProviderAgreement("lava@provider1")
+	require.False(t, found)
+	agreement, found := finalizationConsensus.ProviderAgreement("lava@provider2")
+	require.True(t, found)
+	require.Equal(t, float64(1), agreement)
+	agreement, found = finalizationConsensus.ProviderAgreement("lava@provider0")
+	require.True(t, found)
+	require.Equal(t, float64(0), agreement)
+	_, found = finalizationConsensus.ProviderAgreement("lava@provider4")
+	require.False(t, found)
+	_, found = finalizationConsensus.ProviderAgreement("lava@provider9")
+	require.False(t, found)
+}
+
+func TestFinalizationConflictProof(t *testing.T) {
+	ctx := context.Background()
+	chainID := "LAV1"
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, chainID, "0", func(http.ResponseWriter, *http.Request) {}, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	epoch := uint64(200)
+	_, _, blockDistanceForFinalizedData, blocksInFinalizationProof := chainParser.ChainBlockStats()
+
+	finalizationConsensus := NewFinalizationConsensus(chainID)
+	finalizationConsensus.NewEpoch(epoch)
+	// provider 1 reports later blocks than provider 0, both are merged into the same consensus group
+	for _, insertion := range append(finalizationInsertionForProviders(chainID, epoch, 100, 0, 1, true, "", blocksInFinalizationProof, blockDistanceForFinalizedData),
+		finalizationInsertionForProviders(chainID, epoch, 101, 1, 1, true, "", blocksInFinalizationProof, blockDistanceForFinalizedData)...) {
+		finalizationConflict, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
+		require.NoError(t, err)
+		require.Nil(t, finalizationConflict)
+	}
+
+	// provider 2 signed different hashes for the blocks only provider 1 reported
+	insertion := finalizationInsertionForProviders(chainID, epoch, 101, 2, 1, false, "A", blocksInFinalizationProof, blockDistanceForFinalizedData)[0]
+	latestBlock := int64(101 - blockDistanceForFinalizedData + blocksInFinalizationProof - 1)
+	insertion.finalizedBlocks = map[int64]string{latestBlock: "A"}
+	finalizationConflict, err := finalizationConsensus.UpdateFinalizedHashes(int64(blockDistanceForFinalizedData), insertion.providerAddr, insertion.finalizedBlocks, insertion.relaySession, insertion.relayReply)
+	require.Error(t, err)
+	require.NotNil(t, finalizationConflict)
+	require.Equal(t, insertion.relayReply, finalizationConflict.RelayReply0)
+	require.Equal(t, insertion.relaySession, finalizationConflict.RelaySession0)
+	// the proof is the reply of the provider that signed the other hash
+	require.NotNil(t, finalizationConflict.RelayReply1)
+	require.Equal(t, "lava@provider1", finalizationConflict.RelaySession1.Provider)
+}
+
 func TestQoS(t *testing.T) {
 	decToSet, _ := sdk.NewDecFromStr("0.05") // test values fit 0.05 Availability requirements
 	lavasession.AvailabilityPercentage = decToSet
@@ -41,7 +41,12 @@ func SignRelayResponse(consumerAddress sdk.AccAddress, request pairingtypes.Rela
 	return reply, nil
 }
 
-func VerifyRelayReply(ctx context.Context, reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, addr string) error {
+// the reply can be signed by the provider or by its operator
+func isProviderSigner(signer, providerAddr, operatorAddr string) bool {
+	return signer == providerAddr || (operatorAddr != "" && signer == operatorAddr)
+}
+
+func VerifyRelayReply(ctx context.Context, reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, addr, operatorAddr string) error {
 	relayExchange := pairingtypes.NewRelayExchange(*relayRequest, *reply)
 	serverKey, err := sigs.RecoverPubKey(relayExchange)
 	if err != nil {
@@ -51,14 +56,14 @@ func VerifyRelayReply(ctx context.Context, reply *pairingtypes.RelayReply, relay
 	if err != nil {
 		return err
 	}
-	if serverAddr.String() != addr {
+	if !isProviderSigner(serverAddr.String(), addr, operatorAddr) {
 		return utils.LavaFormatError("reply server address mismatch ", ProviderFinzalizationDataError, utils.LogAttr("GUID", ctx), utils.Attribute{Key: "parsed Address", Value: serverAddr.String()}, utils.Attribute{Key: "expected address", Value: addr}, utils.Attribute{Key: "requestedBlock", Value: relayRequest.RelayData.RequestBlock}, utils.Attribute{Key: "latestBlock", Value: reply.GetLatestBlock()})
 	}
 
 	return nil
 }
 
-func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, providerAddr string, consumerAcc sdk.AccAddress, latestSessionBlock int64, blockDistanceForfinalization uint32) (finalizedBlocks map[int64]string, finalizationConflict *conflicttypes.FinalizationConflict, errRet error) {
+func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairingtypes.RelayRequest, providerAddr, operatorAddr string, consumerAcc sdk.AccAddress, latestSessionBlock int64, blockDistanceForfinalization uint32) (finalizedBlocks map[int64]string, finalizationConflict *conflicttypes.FinalizationConflict, errRet error) {
 	relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(*relayRequest, *reply), consumerAcc)
 	serverKey, err := sigs.RecoverPubKey(relayFinalization)
 	if err != nil {
@@ -70,7 +75,7 @@ func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairin
 		return nil, nil, err
 	}
 
-	if serverAddr.String() != providerAddr {
+	if !isProviderSigner(serverAddr.String(), providerAddr, operatorAddr) {
 		return nil, nil, utils.LavaFormatError("reply server address mismatch in finalization data ", ProviderFinzalizationDataError, utils.Attribute{Key: "parsed Address", Value: serverAddr.String()}, utils.Attribute{Key: "expected address", Value: providerAddr})
 	}
 
@@ -81,6 +86,10 @@ func VerifyFinalizationData(reply *pairingtypes.RelayReply, relayRequest *pairin
 	}
 
 	finalizationConflict, err = verifyFinalizationDataIntegrity(reply, latestSessionBlock, finalizedBlocks, blockDistanceForfinalization, providerAddr)
+	if finalizationConflict != nil {
+		// the session is needed to verify the reply's finalization signature on chain
+		finalizationConflict.RelaySession0 = relayRequest.RelaySession
+	}
 	if err != nil {
 		return nil, finalizationConflict, err
 	}
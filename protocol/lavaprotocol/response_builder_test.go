@@ -59,9 +59,20 @@ func TestSignAndExtractResponse(t *testing.T) {
 	reply.LatestBlock = 123
 	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
 	require.NoError(t, err)
-	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
+	err = VerifyRelayReply(ctx, reply, relay, provider_address.String(), "")
 	require.NoError(t, err)
-	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), "", consumer_address, int64(0), 0)
+	require.NoError(t, err)
+
+	// a reply signed by the provider's operator
+	operator_sk, operator_address := sigs.GenerateFloatingKey()
+	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, operator_sk, reply, true)
+	require.NoError(t, err)
+	err = VerifyRelayReply(ctx, reply, relay, provider_address.String(), "")
+	require.Error(t, err)
+	err = VerifyRelayReply(ctx, reply, relay, provider_address.String(), operator_address.String())
+	require.NoError(t, err)
+	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), operator_address.String(), consumer_address, int64(0), 0)
 	require.NoError(t, err)
 }
 
@@ -109,8 +120,8 @@ func TestSignAndExtractResponseLatest(t *testing.T) {
 	reply.LatestBlock = latestBlock
 	reply, err = SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
 	require.NoError(t, err)
-	err = VerifyRelayReply(ctx, reply, relay, provider_address.String())
+	err = VerifyRelayReply(ctx, reply, relay, provider_address.String(), "")
 	require.NoError(t, err)
-	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+	_, _, err = VerifyFinalizationData(reply, relay, provider_address.String(), "", consumer_address, int64(0), 0)
 	require.NoError(t, err)
 }
@@ -58,6 +58,12 @@ func IsSessionSyncLoss(err error) bool {
 	return code == codes.Code(SessionOutOfSyncError.ABCICode())
 }
 
+// the provider rate limited the consumer, this is not a provider failure
+func IsRateLimited(err error) bool {
+	code := status.Code(err)
+	return code == codes.Code(ConsumerRateLimitedError.ABCICode())
+}
+
 func ConnectgRPCClient(ctx context.Context, address string, allowInsecure bool) (*grpc.ClientConn, error) {
 	var tlsConf tls.Config
 	if allowInsecure {
@@ -93,6 +93,16 @@ func (csm *ConsumerSessionManager) UpdateAllProviders(epoch uint64, pairingList
 	return nil
 }
 
+// the latency expected from a provider for a relay of cu compute units, according to its history
+func (csm *ConsumerSessionManager) ExpectedLatency(providerAddress string, cu uint64) time.Duration {
+	return csm.providerOptimizer.ExpectedLatency(providerAddress, cu)
+}
+
+// the provider selection strategy of this consumer
+func (csm *ConsumerSessionManager) Strategy() provideroptimizer.Strategy {
+	return csm.providerOptimizer.Strategy()
+}
+
 func (csm *ConsumerSessionManager) Initialized() bool {
 	csm.lock.RLock()         // start by locking the class lock.
 	defer csm.lock.RUnlock() // we defer here so in case we return an error it will unlock automatically.
@@ -474,7 +484,7 @@ func (csm *ConsumerSessionManager) GetSessions(ctx context.Context, cuNeededForS
 func (csm *ConsumerSessionManager) getValidProviderAddresses(ignoredProvidersList map[string]struct{}, cu uint64, requestedBlock int64, addon string, extensions []string, stateful uint32) (addresses []string, err error) {
 	// cs.Lock must be Rlocked here.
 	ignoredProvidersListLength := len(ignoredProvidersList)
-	validAddresses := csm.getValidAddresses(addon, extensions)
+	validAddresses := csm.providerOptimizer.PinnedProviders(csm.getValidAddresses(addon, extensions), ignoredProvidersList, csm.atomicReadCurrentEpoch())
 	validAddressesLength := len(validAddresses)
 	totalValidLength := validAddressesLength - ignoredProvidersListLength
 	if totalValidLength <= 0 {
@@ -654,6 +664,16 @@ func (csm *ConsumerSessionManager) OnSessionFailure(consumerSession *SingleConsu
 		return sdkerrors.Wrapf(SessionIsAlreadyBlockListedError, "trying to report a session failure of a blocklisted consumer session")
 	}
 
+	if IsRateLimited(errorReceived) || HedgedRelayCancelledError.Is(errorReceived) {
+		// the provider is healthy but busy with this consumer, or we cancelled the relay ourselves. release the session without penalizing it.
+		// a cancelled relay was already signed, like other failed relays the provider may still claim its cu
+		cuToDecrease := consumerSession.LatestRelayCu
+		consumerSession.LatestRelayCu = 0
+		parentConsumerSessionsWithProvider := consumerSession.Parent
+		consumerSession.Free(errorReceived)
+		return parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease)
+	}
+
 	// check if need to block & report
 	var blockProvider, reportProvider bool
 	if ReportAndBlockProviderError.Is(errorReceived) {
@@ -794,6 +814,30 @@ func (csm *ConsumerSessionManager) OnSessionDoneIncreaseCUOnly(consumerSession *
 	return nil
 }
 
+// On a message streamed by the provider after the relay was done, adds its cu to the session so the next relay on the session pays for it
+func (csm *ConsumerSessionManager) OnStreamedMessage(consumerSession *SingleConsumerSession, cu uint64, virtualEpoch uint64) error {
+	consumerSession.lock.Lock()
+	defer consumerSession.lock.Unlock()
+	err := consumerSession.Parent.addUsedComputeUnits(cu, virtualEpoch)
+	if err != nil {
+		return err
+	}
+	consumerSession.CuSum += cu
+	return nil
+}
+
+// On a session that was fetched but ended up not being used, releases it without affecting the provider's QoS
+func (csm *ConsumerSessionManager) OnSessionUnUsed(consumerSession *SingleConsumerSession) error {
+	if err := consumerSession.VerifyLock(); err != nil {
+		return sdkerrors.Wrapf(err, "OnSessionUnUsed consumerSession.lock must be locked before accessing this method")
+	}
+	cuToDecrease := consumerSession.LatestRelayCu
+	consumerSession.LatestRelayCu = 0                            // making sure no one uses it in a wrong way
+	parentConsumerSessionsWithProvider := consumerSession.Parent // must read this pointer before unlocking
+	consumerSession.Free(nil)
+	return parentConsumerSessionsWithProvider.decreaseUsedComputeUnits(cuToDecrease)
+}
+
 func (csm *ConsumerSessionManager) GenerateReconnectCallback(consumerSessionsWithProvider *ConsumerSessionsWithProvider) func() error {
 	return func() error {
 		_, _, err := csm.probeProvider(context.Background(), consumerSessionsWithProvider, csm.atomicReadCurrentEpoch())
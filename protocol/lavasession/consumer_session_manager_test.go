@@ -18,7 +18,9 @@ import (
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -546,6 +548,55 @@ func TestSessionFailureAndGetReportedProviders(t *testing.T) {
 }
 
 // Test the basic functionality of the consumerSessionManager
+func TestSessionFailureRateLimited(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.NoError(t, err)
+	css, err := csm.GetSessions(ctx, cuForFirstRequest, NewUsedProviders(nil), servicedBlockNumber, "", nil, common.NO_STATE, 0) // get a session
+	require.NoError(t, err)
+
+	rateLimitedErr := status.Error(codes.Code(ConsumerRateLimitedError.ABCICode()), "rate limited")
+	require.True(t, IsRateLimited(rateLimitedErr))
+	for _, cs := range css {
+		require.NotNil(t, cs)
+		err = csm.OnSessionFailure(cs.Session, rateLimitedErr)
+		require.NoError(t, err)
+		require.Equal(t, cs.Session.Parent.UsedComputeUnits, cuSumOnFailure)
+		require.Equal(t, cs.Session.LatestRelayCu, latestRelayCuAfterDone)
+
+		// the provider is not penalized for limiting the consumer
+		require.Empty(t, cs.Session.ConsecutiveErrors)
+		require.False(t, cs.Session.BlockListed)
+		require.False(t, csm.reportedProviders.IsReported(cs.Session.Parent.PublicLavaAddress))
+		require.Contains(t, csm.validAddresses, cs.Session.Parent.PublicLavaAddress)
+	}
+}
+
+func TestSessionFailureHedgedRelayCancelled(t *testing.T) {
+	ctx := context.Background()
+	csm := CreateConsumerSessionManager()
+	pairingList := createPairingList("", true)
+	err := csm.UpdateAllProviders(firstEpochHeight, pairingList) // update the providers.
+	require.NoError(t, err)
+	css, err := csm.GetSessions(ctx, cuForFirstRequest, NewUsedProviders(nil), servicedBlockNumber, "", nil, common.NO_STATE, 0) // get a session
+	require.NoError(t, err)
+
+	for _, cs := range css {
+		require.NotNil(t, cs)
+		err = csm.OnSessionFailure(cs.Session, HedgedRelayCancelledError.Wrapf("another provider answered first"))
+		require.NoError(t, err)
+		require.Equal(t, cs.Session.Parent.UsedComputeUnits, cuSumOnFailure)
+		require.Equal(t, cs.Session.LatestRelayCu, latestRelayCuAfterDone)
+
+		// we cancelled the relay, the provider is not penalized
+		require.Empty(t, cs.Session.ConsecutiveErrors)
+		require.False(t, cs.Session.BlockListed)
+		require.Contains(t, csm.validAddresses, cs.Session.Parent.PublicLavaAddress)
+	}
+}
+
 func TestSessionFailureEpochMisMatch(t *testing.T) {
 	ctx := context.Background()
 	csm := CreateConsumerSessionManager()
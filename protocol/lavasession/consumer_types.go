@@ -45,6 +45,8 @@ type ProviderOptimizer interface {
 	ChooseProvider(allAddresses []string, ignoredProviders map[string]struct{}, cu uint64, requestedBlock int64, perturbationPercentage float64) (addresses []string)
 	GetExcellenceQoSReportForProvider(string) *pairingtypes.QualityOfServiceReport
 	Strategy() provideroptimizer.Strategy
+	PinnedProviders(allAddresses []string, ignoredProviders map[string]struct{}, epoch uint64) []string
+	ExpectedLatency(providerAddress string, cu uint64) time.Duration
 }
 
 type ignoredProviders struct {
@@ -117,6 +119,7 @@ func (rpce *RPCEndpoint) Key() string {
 type ConsumerSessionsWithProvider struct {
 	Lock              sync.RWMutex
 	PublicLavaAddress string
+	OperatorAddress   string // the address signing for the provider, when different from PublicLavaAddress
 	Endpoints         []*Endpoint
 	Sessions          map[int64]*SingleConsumerSession
 	MaxComputeUnits   uint64
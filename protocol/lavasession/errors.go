@@ -26,6 +26,7 @@ var ( // Consumer Side Errors
 	FailedToConnectToEndPointForDataReliabilityError     = sdkerrors.New("FailedToConnectToEndPointForDataReliability Error", 683, "Failed to connect to a providers endpoints")
 	DataReliabilityEpochMismatchError                    = sdkerrors.New("DataReliabilityEpochMismatch Error", 684, "Data reliability epoch mismatch original session epoch.")
 	NoDataReliabilitySessionWasCreatedError              = sdkerrors.New("NoDataReliabilitySessionWasCreated Error", 685, "No Data reliability session was created")
+	HedgedRelayCancelledError                            = sdkerrors.New("HedgedRelayCancelled Error", 686, "Relay was cancelled because another provider answered the hedged request first")
 )
 
 var ( // Provider Side Errors
@@ -48,4 +49,5 @@ var ( // Provider Side Errors
 	CouldNotFindIndexAsConsumerNotYetRegisteredError = sdkerrors.New("CouldNotFindIndexAsConsumerNotYetRegistered Error", 897, "fetching provider index from psm failed")
 	ProviderIndexMisMatchError                       = sdkerrors.New("ProviderIndexMisMatch Error", 898, "provider index mismatch")
 	SessionIdNotFoundError                           = sdkerrors.New("SessionIdNotFound Error", 899, "Session Id not found")
+	ConsumerRateLimitedError                         = sdkerrors.New("ConsumerRateLimited Error", 900, "Consumer exceeded the provider's relay rate limit, retry with another provider")
 )
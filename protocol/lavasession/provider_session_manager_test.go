@@ -451,6 +451,30 @@ func TestPSMUpdateCu(t *testing.T) {
 	require.Equal(t, sps.userSessionsParent.epochData.UsedComputeUnits, maxCu)
 }
 
+func TestPSMAddStreamedCu(t *testing.T) {
+	ctx := context.Background()
+	psm, sps := prepareSession(t, ctx)
+	err := psm.OnSessionDone(sps, relayNumber)
+	require.NoError(t, err)
+
+	// two messages were streamed after the relay
+	require.NoError(t, sps.AddStreamedCU(relayCu, 0))
+	require.NoError(t, sps.AddStreamedCU(relayCu, 0))
+	require.Equal(t, 3*relayCu, sps.CuSum)
+	require.Equal(t, 3*relayCu, sps.userSessionsParent.epochData.UsedComputeUnits)
+
+	// the next relay pays for the streamed messages
+	sps, err = psm.GetSession(ctx, consumerOneAddress, epoch1, sessionId, relayNumber+1, nil)
+	require.NoError(t, err)
+	err = sps.PrepareSessionForUsage(ctx, relayCu, 4*relayCu, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, psm.OnSessionDone(sps, relayNumber+1))
+
+	// streaming can't exceed the consumer's max cu
+	err = sps.AddStreamedCU(maxCu, 0)
+	require.True(t, MaximumCULimitReachedByConsumer.Is(err))
+}
+
 func TestPSMUpdateCuMaxCuReached(t *testing.T) {
 	ctx := context.Background()
 	// init test
@@ -32,6 +32,13 @@ func (sps *SingleProviderSession) IsPayingRelay() bool {
 	return sps.LatestRelayCu > 0
 }
 
+func (sps *SingleProviderSession) GetProjectId() string {
+	if sps.userSessionsParent == nil {
+		return ""
+	}
+	return sps.userSessionsParent.consumersProjectId
+}
+
 func (sps *SingleProviderSession) IsBadgeSession() bool {
 	return sps.BadgeUserData != nil
 }
@@ -202,6 +209,20 @@ func (sps *SingleProviderSession) PrepareSessionForUsage(ctx context.Context, cu
 	return nil
 }
 
+// adds the cu of a message streamed after the relay was done, the consumer adds it to its session too
+// so the next relay on the session pays for it
+func (sps *SingleProviderSession) AddStreamedCU(cu uint64, virtualEpoch uint64) error {
+	sps.lock.Lock()
+	defer sps.lock.Unlock()
+	maxCu := sps.userSessionsParent.atomicReadMaxComputeUnits()
+	err := sps.validateAndAddUsedCU(cu, maxCu, virtualEpoch)
+	if err != nil {
+		return err
+	}
+	sps.CuSum += cu
+	return nil
+}
+
 func (sps *SingleProviderSession) DisbandSession() error {
 	if sps.lock.TryLock() { // verify.
 		// if we managed to lock throw an error for misuse.
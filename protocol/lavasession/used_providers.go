@@ -31,6 +31,8 @@ type UsedProviders struct {
 	unwantedProviders   map[string]struct{}
 	blockOnSyncLoss     map[string]struct{}
 	sessionsLatestBatch int
+	batchOpen           bool
+	sessionsOpenBatch   int
 }
 
 func (up *UsedProviders) CurrentlyUsed() int {
@@ -124,15 +126,38 @@ func (up *UsedProviders) AddUsed(sessions ConsumerSessionsMap, err error) {
 	defer up.lock.Unlock()
 	// this is nil safe
 	if len(sessions) > 0 && err == nil {
-		up.sessionsLatestBatch = 0
+		if !up.batchOpen || up.sessionsOpenBatch == 0 {
+			up.sessionsLatestBatch = 0
+		}
 		for provider := range sessions { // the key for ConsumerSessionsMap is the provider public address
 			up.providers[provider] = struct{}{}
 			up.sessionsLatestBatch++
+			up.sessionsOpenBatch++
 		}
 	}
 	up.selecting = false
 }
 
+// sessions added between StartBatch and EndBatch are counted as a single batch, used when a batch requires several selections
+func (up *UsedProviders) StartBatch() {
+	if up == nil {
+		return
+	}
+	up.lock.Lock()
+	defer up.lock.Unlock()
+	up.batchOpen = true
+	up.sessionsOpenBatch = 0
+}
+
+func (up *UsedProviders) EndBatch() {
+	if up == nil {
+		return
+	}
+	up.lock.Lock()
+	defer up.lock.Unlock()
+	up.batchOpen = false
+}
+
 func (up *UsedProviders) setUnwanted(provider string) {
 	if up == nil {
 		return
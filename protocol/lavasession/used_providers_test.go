@@ -56,6 +56,27 @@ func TestUsedProviders(t *testing.T) {
 	})
 }
 
+func TestUsedProvidersBatch(t *testing.T) {
+	usedProviders := NewUsedProviders(nil)
+	usedProviders.AddUsed(ConsumerSessionsMap{"test": &SessionInfo{}}, nil)
+	require.Equal(t, 1, usedProviders.SessionsLatestBatch())
+	// sessions selected within a batch are accumulated
+	usedProviders.StartBatch()
+	usedProviders.AddUsed(ConsumerSessionsMap{"test2": &SessionInfo{}, "test3": &SessionInfo{}}, nil)
+	usedProviders.AddUsed(ConsumerSessionsMap{"test4": &SessionInfo{}}, nil)
+	usedProviders.EndBatch()
+	require.Equal(t, 3, usedProviders.SessionsLatestBatch())
+	require.Equal(t, 4, usedProviders.CurrentlyUsed())
+	// a failed batch keeps the latest batch size
+	usedProviders.StartBatch()
+	usedProviders.AddUsed(nil, PairingListEmptyError)
+	usedProviders.EndBatch()
+	require.Equal(t, 3, usedProviders.SessionsLatestBatch())
+	// outside of a batch every selection is a new batch
+	usedProviders.AddUsed(ConsumerSessionsMap{"test5": &SessionInfo{}}, nil)
+	require.Equal(t, 1, usedProviders.SessionsLatestBatch())
+}
+
 func TestUsedProvidersAsync(t *testing.T) {
 	t.Run("concurrency", func(t *testing.T) {
 		usedProviders := NewUsedProviders(nil)
@@ -28,6 +28,8 @@ type ConsumerMetricsManager struct {
 	lock                          sync.Mutex
 	protocolVersionMetric         *prometheus.GaugeVec
 	providerRelays                map[string]uint64
+	apiKeyRelaysMetric            *prometheus.CounterVec
+	apiKeyCuMetric                *prometheus.CounterVec
 }
 
 func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
@@ -93,6 +95,14 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		Name: "lava_provider_protocol_version",
 		Help: "The current running lavap version for the process. major := version / 1000000, minor := (version / 1000) % 1000, patch := version % 1000",
 	}, []string{"version"})
+	apiKeyRelaysMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_consumer_api_key_relays",
+		Help: "The total number of relays requested with each api key by result (success, error, unauthorized, rate_limited).",
+	}, []string{"api_key", "spec", "apiInterface", "result"})
+	apiKeyCuMetric := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "lava_consumer_api_key_cu",
+		Help: "The total number of CUs requested with each api key.",
+	}, []string{"api_key", "spec", "apiInterface"})
 	// Register the metrics with the Prometheus registry.
 	prometheus.MustRegister(totalCURequestedMetric)
 	prometheus.MustRegister(totalRelaysRequestedMetric)
@@ -106,6 +116,8 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 	prometheus.MustRegister(virtualEpochMetric)
 	prometheus.MustRegister(endpointsHealthChecksOkMetric)
 	prometheus.MustRegister(protocolVersionMetric)
+	prometheus.MustRegister(apiKeyRelaysMetric)
+	prometheus.MustRegister(apiKeyCuMetric)
 
 	consumerMetricsManager := &ConsumerMetricsManager{
 		totalCURequestedMetric:        totalCURequestedMetric,
@@ -122,6 +134,8 @@ func NewConsumerMetricsManager(networkAddress string) *ConsumerMetricsManager {
 		endpointsHealthChecksOkMetric: endpointsHealthChecksOkMetric,
 		endpointsHealthChecksOk:       1,
 		protocolVersionMetric:         protocolVersionMetric,
+		apiKeyRelaysMetric:            apiKeyRelaysMetric,
+		apiKeyCuMetric:                apiKeyCuMetric,
 	}
 
 	http.Handle("/metrics", promhttp.Handler())
@@ -170,6 +184,15 @@ func (pme *ConsumerMetricsManager) SetRelayMetrics(relayMetric *RelayMetrics, er
 	}
 }
 
+// api keys are labeled by their configured name, never by the key itself
+func (pme *ConsumerMetricsManager) SetApiKeyRelayMetrics(apiKeyName string, chainId string, apiInterface string, cu uint64, result string) {
+	if pme == nil {
+		return
+	}
+	pme.apiKeyRelaysMetric.WithLabelValues(apiKeyName, chainId, apiInterface, result).Add(1)
+	pme.apiKeyCuMetric.WithLabelValues(apiKeyName, chainId, apiInterface).Add(float64(cu))
+}
+
 func (pme *ConsumerMetricsManager) SetQOSMetrics(chainId string, apiInterface string, providerAddress string, qos *pairingtypes.QualityOfServiceReport, qosExcellence *pairingtypes.QualityOfServiceReport, latestBlock int64, relays uint64) {
 	if pme == nil {
 		return
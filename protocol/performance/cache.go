@@ -2,17 +2,60 @@ package performance
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/lavanet/lava/protocol/chainlib/chainproxy"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
+const (
+	CacheReconnectMinBackoff       = time.Second
+	CacheReconnectMaxBackoff       = 30 * time.Second
+	CacheFailuresBeforeCircuitOpen = 3
+	CacheCircuitOpenCooldown       = 10 * time.Second
+	CacheSetTimeout                = time.Second
+	// a cache get can take at most this fraction of the time left for the relay
+	CacheRelayTimeoutShare = 4
+)
+
+var (
+	cacheMetricsOnce     sync.Once
+	cacheAvailableMetric *prometheus.GaugeVec
+	cacheRequestsMetric  *prometheus.CounterVec
+)
+
+func registerCacheMetrics() {
+	cacheMetricsOnce.Do(func() {
+		cacheAvailableMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lava_cache_available",
+			Help: "1 when the cache service is connected and used, 0 when it's disconnected or its circuit breaker is open",
+		}, []string{"address"})
+		cacheRequestsMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "lava_cache_requests_total",
+			Help: "The total number of requests to the cache service by result",
+		}, []string{"address", "method", "result"})
+		prometheus.MustRegister(cacheAvailableMetric)
+		prometheus.MustRegister(cacheRequestsMetric)
+	})
+}
+
 type Cache struct {
 	client  pairingtypes.RelayerCacheClient
 	address string
+	lock    sync.Mutex
+	// circuit breaker, opened after consecutive connection failures so a failing cache doesn't add latency to every relay
+	consecutiveFailures uint64
+	circuitOpenUntil    time.Time
+	halfOpenProbe       bool // a single call is let through after the cooldown to check if the cache is back
 }
 
 func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string) (*pairingtypes.RelayerCacheClient, error) {
@@ -28,25 +71,161 @@ func ConnectGRPCConnectionToRelayerCacheService(ctx context.Context, addr string
 	return &c, nil
 }
 
+// connects to the cache service, if it's not reachable the returned cache keeps trying to connect in the background
 func InitCache(ctx context.Context, addr string) (*Cache, error) {
+	registerCacheMetrics()
+	cache := &Cache{address: addr}
 	relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, addr)
 	if err != nil {
-		return &Cache{client: nil, address: addr}, err
+		cache.setAvailable(false)
+		go cache.reconnect(ctx)
+		return cache, err
+	}
+	cache.setClient(*relayerCacheClient)
+	return cache, nil
+}
+
+func (cache *Cache) reconnect(ctx context.Context) {
+	backoff := CacheReconnectMinBackoff
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		relayerCacheClient, err := ConnectGRPCConnectionToRelayerCacheService(ctx, cache.address)
+		if err == nil {
+			cache.setClient(*relayerCacheClient)
+			utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cache.address})
+			return
+		}
+		utils.LavaFormatDebug("failed reconnecting to cache service", utils.Attribute{Key: "address", Value: cache.address}, utils.Attribute{Key: "backoff", Value: backoff})
+		backoff *= 2
+		if backoff > CacheReconnectMaxBackoff {
+			backoff = CacheReconnectMaxBackoff
+		}
+	}
+}
+
+func (cache *Cache) setClient(client pairingtypes.RelayerCacheClient) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	cache.client = client
+	cache.consecutiveFailures = 0
+	cache.circuitOpenUntil = time.Time{}
+	cache.halfOpenProbe = false
+	cache.setAvailable(true)
+}
+
+func (cache *Cache) setAvailable(available bool) {
+	if cacheAvailableMetric == nil {
+		return
+	}
+	value := 0.0
+	if available {
+		value = 1
+	}
+	cacheAvailableMetric.WithLabelValues(cache.address).Set(value)
+}
+
+func (cache *Cache) countRequest(method string, result string) {
+	if cacheRequestsMetric == nil {
+		return
+	}
+	cacheRequestsMetric.WithLabelValues(cache.address, method, result).Inc()
+}
+
+// returns the client if a call is allowed by the circuit breaker
+func (cache *Cache) acquireClient() (pairingtypes.RelayerCacheClient, error) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if cache.client == nil {
+		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	}
+	if !cache.circuitOpenUntil.IsZero() {
+		if time.Now().Before(cache.circuitOpenUntil) || cache.halfOpenProbe {
+			return nil, CircuitOpenError.Wrapf("cache at address: %s", cache.address)
+		}
+		cache.halfOpenProbe = true
+	}
+	return cache.client, nil
+}
+
+// ctx is the caller's context, the cache call's own context is derived from it
+func (cache *Cache) releaseClient(ctx context.Context, err error) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+	if !isCacheConnectionError(ctx, err) {
+		// a miss is an answer too, the cache is reachable
+		if !cache.circuitOpenUntil.IsZero() {
+			utils.LavaFormatInfo("cache service is responsive again, closing circuit breaker", utils.Attribute{Key: "address", Value: cache.address})
+			cache.setAvailable(true)
+		}
+		cache.consecutiveFailures = 0
+		cache.circuitOpenUntil = time.Time{}
+		cache.halfOpenProbe = false
+		return
+	}
+	cache.consecutiveFailures++
+	if cache.halfOpenProbe || cache.consecutiveFailures >= CacheFailuresBeforeCircuitOpen {
+		if cache.circuitOpenUntil.IsZero() {
+			utils.LavaFormatWarning("cache service is failing, opening circuit breaker", err, utils.Attribute{Key: "address", Value: cache.address}, utils.Attribute{Key: "cooldown", Value: CacheCircuitOpenCooldown})
+		}
+		cache.circuitOpenUntil = time.Now().Add(CacheCircuitOpenCooldown)
+		cache.halfOpenProbe = false
+		cache.setAvailable(false)
+	}
+}
+
+// a timeout counts as a cache failure only when the cache call's own timeout fired, when the caller's context is done
+// (the relay timed out or was cancelled) the cache isn't to blame
+func isCacheConnectionError(ctx context.Context, err error) bool {
+	if err == nil {
+		return false
+	}
+	code := status.Code(err)
+	if errors.Is(err, context.DeadlineExceeded) || code == codes.DeadlineExceeded {
+		return ctx.Err() == nil
+	}
+	return code == codes.Unavailable
+}
+
+// bounds a cache call by the timeout and by a share of the time left for the relay
+func cacheCallContext(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if deadline, ok := ctx.Deadline(); ok {
+		if relayShare := time.Until(deadline) / CacheRelayTimeoutShare; relayShare < timeout {
+			timeout = relayShare
+		}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func cacheResult(ctx context.Context, err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case isCacheConnectionError(ctx, err):
+		return "error"
+	default:
+		return "miss"
 	}
-	cache := Cache{client: *relayerCacheClient, address: addr}
-	return &cache, nil
 }
 
 func (cache *Cache) GetEntry(ctx context.Context, relayCacheGet *pairingtypes.RelayCacheGet) (reply *pairingtypes.CacheRelayReply, err error) {
 	if cache == nil {
-		// TODO: try to connect again once in a while
 		return nil, NotInitialisedError
 	}
-	if cache.client == nil {
-		return nil, NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	client, err := cache.acquireClient()
+	if err != nil {
+		cache.countRequest("get", "skipped")
+		return nil, err
 	}
-	// TODO: handle disconnections and error types here
-	return cache.client.GetRelay(ctx, relayCacheGet)
+	callCtx, cancel := cacheCallContext(ctx, common.CacheTimeout)
+	defer cancel()
+	reply, err = client.GetRelay(callCtx, relayCacheGet)
+	cache.releaseClient(ctx, err)
+	cache.countRequest("get", cacheResult(ctx, err))
+	return reply, err
 }
 
 func (cache *Cache) CacheActive() bool {
@@ -55,13 +234,35 @@ func (cache *Cache) CacheActive() bool {
 
 func (cache *Cache) SetEntry(ctx context.Context, cacheSet *pairingtypes.RelayCacheSet) error {
 	if cache == nil {
-		// TODO: try to connect again once in a while
 		return NotInitialisedError
 	}
-	if cache.client == nil {
-		return NotConnectedError.Wrapf("No client connected to address: %s", cache.address)
+	client, err := cache.acquireClient()
+	if err != nil {
+		cache.countRequest("set", "skipped")
+		return err
 	}
-	// TODO: handle disconnections and SetRelay error types here
-	_, err := cache.client.SetRelay(ctx, cacheSet)
+	callCtx, cancel := context.WithTimeout(ctx, CacheSetTimeout)
+	defer cancel()
+	_, err = client.SetRelay(callCtx, cacheSet)
+	cache.releaseClient(ctx, err)
+	cache.countRequest("set", cacheResult(ctx, err))
+	return err
+}
+
+// invalidates the non finalized entries of the chain from fromBlock onwards, used when a fork is detected
+func (cache *Cache) InvalidateEntries(ctx context.Context, chainID string, fromBlock int64) error {
+	if cache == nil {
+		return NotInitialisedError
+	}
+	client, err := cache.acquireClient()
+	if err != nil {
+		cache.countRequest("invalidate", "skipped")
+		return err
+	}
+	callCtx, cancel := context.WithTimeout(ctx, CacheSetTimeout)
+	defer cancel()
+	_, err = client.InvalidateRelays(callCtx, &pairingtypes.RelayCacheInvalidate{ChainId: chainID, FromBlock: fromBlock})
+	cache.releaseClient(ctx, err)
+	cache.countRequest("invalidate", cacheResult(ctx, err))
 	return err
 }
@@ -0,0 +1,120 @@
+package performance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockCacheClient struct {
+	pairingtypes.RelayerCacheClient
+	err   error
+	calls int
+}
+
+func (mcc *mockCacheClient) GetRelay(ctx context.Context, in *pairingtypes.RelayCacheGet, opts ...grpc.CallOption) (*pairingtypes.CacheRelayReply, error) {
+	mcc.calls++
+	if mcc.err != nil {
+		return nil, mcc.err
+	}
+	return &pairingtypes.CacheRelayReply{}, nil
+}
+
+func (mcc *mockCacheClient) SetRelay(ctx context.Context, in *pairingtypes.RelayCacheSet, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	mcc.calls++
+	return &emptypb.Empty{}, mcc.err
+}
+
+func TestCacheCircuitBreaker(t *testing.T) {
+	ctx := context.Background()
+	client := &mockCacheClient{err: status.Error(codes.Unavailable, "down")}
+	cache := &Cache{address: "mock"}
+	cache.setClient(client)
+
+	for i := 0; i < CacheFailuresBeforeCircuitOpen; i++ {
+		_, err := cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+		require.Error(t, err)
+	}
+	require.Equal(t, CacheFailuresBeforeCircuitOpen, client.calls)
+
+	// circuit is open, calls don't reach the cache
+	_, err := cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+	require.ErrorIs(t, err, CircuitOpenError)
+	require.ErrorIs(t, cache.SetEntry(ctx, &pairingtypes.RelayCacheSet{}), CircuitOpenError)
+	require.Equal(t, CacheFailuresBeforeCircuitOpen, client.calls)
+
+	// after the cooldown a single probe goes through, a failure opens the circuit again
+	cache.circuitOpenUntil = time.Now().Add(-time.Millisecond)
+	_, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+	require.Error(t, err)
+	require.Equal(t, CacheFailuresBeforeCircuitOpen+1, client.calls)
+	_, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+	require.ErrorIs(t, err, CircuitOpenError)
+
+	// a miss means the cache is back, the circuit closes
+	client.err = status.Error(codes.Unknown, "cache miss")
+	cache.circuitOpenUntil = time.Now().Add(-time.Millisecond)
+	_, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+	require.Error(t, err)
+	require.True(t, cache.circuitOpenUntil.IsZero())
+	client.err = nil
+	_, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{})
+	require.NoError(t, err)
+}
+
+func TestCacheDeadlineExceeded(t *testing.T) {
+	client := &mockCacheClient{err: status.Error(codes.DeadlineExceeded, "timeout")}
+	cache := &Cache{address: "mock"}
+	cache.setClient(client)
+
+	// the relay's context ended, the cache isn't to blame
+	expiredCtx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+	for i := 0; i < CacheFailuresBeforeCircuitOpen; i++ {
+		_, err := cache.GetEntry(expiredCtx, &pairingtypes.RelayCacheGet{})
+		require.Error(t, err)
+	}
+	require.Zero(t, cache.consecutiveFailures)
+	require.True(t, cache.circuitOpenUntil.IsZero())
+
+	// the cache call's own timeout fired
+	for i := 0; i < CacheFailuresBeforeCircuitOpen; i++ {
+		_, err := cache.GetEntry(context.Background(), &pairingtypes.RelayCacheGet{})
+		require.Error(t, err)
+	}
+	require.False(t, cache.circuitOpenUntil.IsZero())
+}
+
+func TestCacheCallContext(t *testing.T) {
+	// without a relay deadline the cache timeout applies
+	callCtx, cancel := cacheCallContext(context.Background(), time.Second)
+	deadline, ok := callCtx.Deadline()
+	cancel()
+	require.True(t, ok)
+	require.InDelta(t, time.Second, time.Until(deadline), float64(100*time.Millisecond))
+
+	// a relay close to its deadline gives the cache only a share of the time left
+	relayCtx, relayCancel := context.WithTimeout(context.Background(), 400*time.Millisecond)
+	defer relayCancel()
+	callCtx, cancel = cacheCallContext(relayCtx, time.Second)
+	defer cancel()
+	deadline, ok = callCtx.Deadline()
+	require.True(t, ok)
+	require.LessOrEqual(t, time.Until(deadline), 400*time.Millisecond/CacheRelayTimeoutShare)
+}
+
+func TestCacheNotConnected(t *testing.T) {
+	var nilCache *Cache
+	_, err := nilCache.GetEntry(context.Background(), &pairingtypes.RelayCacheGet{})
+	require.ErrorIs(t, err, NotInitialisedError)
+	cache := &Cache{address: "mock"}
+	_, err = cache.GetEntry(context.Background(), &pairingtypes.RelayCacheGet{})
+	require.ErrorIs(t, err, NotConnectedError)
+}
@@ -7,4 +7,5 @@ import (
 var (
 	NotConnectedError   = sdkerrors.New("Not Connected Error", 700, "No Connection To grpc server")
 	NotInitialisedError = sdkerrors.New("Not Initialised Error", 701, "to use cache run initCache")
+	CircuitOpenError    = sdkerrors.New("Circuit Open Error", 702, "cache is failing, calls are skipped until the cooldown ends")
 )
@@ -1,7 +1,11 @@
 package provideroptimizer
 
 import (
+	crand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
 	"math"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -29,6 +33,12 @@ const (
 	DEFAULT_EXPLORATION_CHANCE = 0.1
 	COST_EXPLORATION_CHANCE    = 0.01
 	WANTED_PRECISION           = int64(8)
+	// cost: providers we didn't relay with yet need to be this much better to be picked
+	COST_UNTOUCHED_PROVIDER_PENALTY = 1.5
+	// privacy: the number of providers a consumer is pinned to in each epoch
+	PRIVACY_PINNED_PROVIDERS = 3
+	// accuracy: the score of a provider that never agreed with the others is multiplied by 1+ACCURACY_DISAGREEMENT_PENALTY
+	ACCURACY_DISAGREEMENT_PENALTY = 4
 )
 
 type ConcurrentBlockStore struct {
@@ -37,6 +47,11 @@ type ConcurrentBlockStore struct {
 	Block uint64
 }
 
+// AgreementTracker reports the share of finalization data each provider agreed on with the other providers
+type AgreementTracker interface {
+	ProviderAgreement(providerAddress string) (agreement float64, found bool)
+}
+
 type cacheInf interface {
 	Get(key interface{}) (interface{}, bool)
 	Set(key, value interface{}, cost int64) bool
@@ -50,6 +65,11 @@ type ProviderOptimizer struct {
 	baseWorldLatency                time.Duration
 	wantedNumProvidersInConcurrency uint
 	latestSyncData                  ConcurrentBlockStore
+	privacySeed                     []byte           // keeps the pinned providers of this consumer unpredictable to others
+	agreementTracker                AgreementTracker // used by the accuracy strategy
+	agreementTrackerLock            sync.RWMutex
+	knownProviders                  map[string]struct{} // the providers with data in providersStorage, used for snapshots
+	knownProvidersLock              sync.RWMutex
 }
 
 type ProviderData struct {
@@ -99,7 +119,7 @@ func (po *ProviderOptimizer) appendRelayData(providerAddress string, latency tim
 		syncLag := po.calculateSyncLag(latestSync, timeSync, providerData.SyncBlock, sampleTime)
 		providerData = po.updateProbeEntrySync(providerData, syncLag, po.averageBlockTime, halfTime, sampleTime)
 	}
-	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.setProviderData(providerAddress, providerData)
 	po.updateRelayTime(providerAddress, sampleTime)
 	if debug {
 		utils.LavaFormatDebug("relay update", utils.Attribute{Key: "providerData", Value: providerData}, utils.Attribute{Key: "syncBlock", Value: syncBlock}, utils.Attribute{Key: "cu", Value: cu}, utils.Attribute{Key: "providerAddress", Value: providerAddress}, utils.Attribute{Key: "latency", Value: latency}, utils.Attribute{Key: "success", Value: success})
@@ -115,7 +135,7 @@ func (po *ProviderOptimizer) AppendProbeRelayData(providerAddress string, latenc
 		// base latency for a probe is the world latency
 		providerData = po.updateProbeEntryLatency(providerData, latency, po.baseWorldLatency, PROBE_UPDATE_WEIGHT, halfTime, sampleTime)
 	}
-	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.setProviderData(providerAddress, providerData)
 	if debug {
 		utils.LavaFormatDebug("probe update", utils.Attribute{Key: "providerAddress", Value: providerAddress}, utils.Attribute{Key: "latency", Value: latency}, utils.Attribute{Key: "success", Value: success})
 	}
@@ -142,6 +162,7 @@ func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProvid
 		}
 		// latency score
 		latencyScoreCurrent := po.calculateLatencyScore(providerData, cu, requestedBlock) // smaller == better i.e less latency
+		latencyScoreCurrent *= po.strategyScoreFactor(providerAddress)
 		// latency perturbation
 		latencyScoreCurrent = pertrubWithNormalGaussian(latencyScoreCurrent, perturbationPercentage)
 
@@ -149,7 +170,7 @@ func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProvid
 		syncScoreCurrent := float64(0)
 		if requestedBlock < 0 {
 			// means user didn't ask for a specific block and we want to give him the best
-			syncScoreCurrent = po.calculateSyncScore(providerData.Sync) // smaller == better i.e less sync lag
+			syncScoreCurrent = po.calculateSyncScore(providerData.Sync) * po.strategyScoreFactor(providerAddress) // smaller == better i.e less sync lag
 			// sync perturbation
 			syncScoreCurrent = pertrubWithNormalGaussian(syncScoreCurrent, perturbationPercentage)
 		}
@@ -178,6 +199,74 @@ func (po *ProviderOptimizer) ChooseProvider(allAddresses []string, ignoredProvid
 	return returnedProviders
 }
 
+// returns a multiplier for the provider's scores according to the strategy, bigger = worse
+func (po *ProviderOptimizer) strategyScoreFactor(providerAddress string) float64 {
+	switch po.strategy {
+	case STRATEGY_COST:
+		// stick to providers we already have sessions with so we touch as few providers as possible
+		if len(po.getRelayStatsTimes(providerAddress)) == 0 {
+			return COST_UNTOUCHED_PROVIDER_PENALTY
+		}
+	case STRATEGY_ACCURACY:
+		po.agreementTrackerLock.RLock()
+		agreementTracker := po.agreementTracker
+		po.agreementTrackerLock.RUnlock()
+		if agreementTracker == nil {
+			return 1
+		}
+		if agreement, found := agreementTracker.ProviderAgreement(providerAddress); found {
+			return 1 + (1-agreement)*ACCURACY_DISAGREEMENT_PENALTY
+		}
+	}
+	return 1
+}
+
+// sets the source of the providers agreement history used by the accuracy strategy
+func (po *ProviderOptimizer) SetAgreementTracker(agreementTracker AgreementTracker) {
+	po.agreementTrackerLock.Lock()
+	defer po.agreementTrackerLock.Unlock()
+	po.agreementTracker = agreementTracker
+}
+
+// returns the providers the privacy strategy uses for the epoch, a small set that is stable throughout the epoch.
+// blocked providers are not in allAddresses so the next ranked providers replace them. when all the pinned providers
+// are ignored after failing, the next ranked providers that are not ignored are used instead.
+// other strategies use all the given providers
+func (po *ProviderOptimizer) PinnedProviders(allAddresses []string, ignoredProviders map[string]struct{}, epoch uint64) []string {
+	if po.strategy != STRATEGY_PRIVACY || len(allAddresses) <= PRIVACY_PINNED_PROVIDERS {
+		return allAddresses
+	}
+	epochBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(epochBytes, epoch)
+	hashes := make(map[string][]byte, len(allAddresses))
+	for _, address := range allAddresses {
+		hash := sha256.Sum256(append(append(append([]byte{}, po.privacySeed...), epochBytes...), address...))
+		hashes[address] = hash[:]
+	}
+	pinned := append([]string{}, allAddresses...)
+	sort.Slice(pinned, func(i, j int) bool {
+		return string(hashes[pinned[i]]) < string(hashes[pinned[j]])
+	})
+	for _, address := range pinned[:PRIVACY_PINNED_PROVIDERS] {
+		if _, ignored := ignoredProviders[address]; !ignored {
+			return pinned[:PRIVACY_PINNED_PROVIDERS]
+		}
+	}
+	repinned := []string{}
+	for _, address := range pinned[PRIVACY_PINNED_PROVIDERS:] {
+		if _, ignored := ignoredProviders[address]; !ignored {
+			repinned = append(repinned, address)
+			if len(repinned) == PRIVACY_PINNED_PROVIDERS {
+				break
+			}
+		}
+	}
+	if len(repinned) == 0 {
+		return pinned[:PRIVACY_PINNED_PROVIDERS]
+	}
+	return repinned
+}
+
 // calculate the expected average time until this provider catches up with the given latestSync block
 // for the first block difference we take the minimum between the time passed since block arrived and the average block time
 // for any other block we take the averageBlockTime
@@ -260,7 +349,13 @@ func (po *ProviderOptimizer) calculateSyncScore(syncScore score.ScoreStore) floa
 	return historicalSyncLatency.Seconds()
 }
 
-func (po *ProviderOptimizer) calculateLatencyScore(providerData ProviderData, cu uint64, requestedBlock int64) float64 {
+// returns the average latency of the provider for a relay of cu compute units
+func (po *ProviderOptimizer) ExpectedLatency(providerAddress string, cu uint64) time.Duration {
+	providerData, _ := po.getProviderData(providerAddress)
+	return po.calculateHistoricalLatency(providerData, cu)
+}
+
+func (po *ProviderOptimizer) calculateHistoricalLatency(providerData ProviderData, cu uint64) time.Duration {
 	baseLatency := po.baseWorldLatency + common.BaseTimePerCU(cu)/2 // divide by two because the returned time is for timeout not for average
 	timeoutDuration := common.GetTimePerCu(cu) + common.AverageWorldLatency
 	var historicalLatency time.Duration
@@ -273,6 +368,13 @@ func (po *ProviderOptimizer) calculateLatencyScore(providerData ProviderData, cu
 		// can't have a bigger latency than timeout
 		historicalLatency = timeoutDuration
 	}
+	return historicalLatency
+}
+
+func (po *ProviderOptimizer) calculateLatencyScore(providerData ProviderData, cu uint64, requestedBlock int64) float64 {
+	baseLatency := po.baseWorldLatency + common.BaseTimePerCU(cu)/2 // divide by two because the returned time is for timeout not for average
+	timeoutDuration := common.GetTimePerCu(cu) + common.AverageWorldLatency
+	historicalLatency := po.calculateHistoricalLatency(providerData, cu)
 	probabilityBlockError := po.CalculateProbabilityOfBlockError(requestedBlock, providerData)
 	probabilityOfTimeout := po.CalculateProbabilityOfTimeout(providerData.Availability)
 	probabilityOfSuccess := (1 - probabilityBlockError) * (1 - probabilityOfTimeout)
@@ -353,6 +455,13 @@ func (po *ProviderOptimizer) getProviderData(providerAddress string) (providerDa
 	return providerData, found
 }
 
+func (po *ProviderOptimizer) setProviderData(providerAddress string, providerData ProviderData) {
+	po.providersStorage.Set(providerAddress, providerData, 1)
+	po.knownProvidersLock.Lock()
+	defer po.knownProvidersLock.Unlock()
+	po.knownProviders[providerAddress] = struct{}{}
+}
+
 func (po *ProviderOptimizer) updateProbeEntrySync(providerData ProviderData, sync, baseSync, halfTime time.Duration, sampleTime time.Time) ProviderData {
 	newScore := score.NewScoreStore(sync.Seconds(), baseSync.Seconds(), sampleTime)
 	oldScore := providerData.Sync
@@ -436,11 +545,15 @@ func NewProviderOptimizer(strategy Strategy, averageBlockTIme, baseWorldLatency
 	if err != nil {
 		utils.LavaFormatFatal("failed setting up cache for queries", err)
 	}
-	if strategy == STRATEGY_PRIVACY {
-		// overwrite
+	if strategy == STRATEGY_PRIVACY || strategy == STRATEGY_COST {
+		// overwrite, a single provider per relay
 		wantedNumProvidersInConcurrency = 1
 	}
-	return &ProviderOptimizer{strategy: strategy, providersStorage: cache, averageBlockTime: averageBlockTIme, baseWorldLatency: baseWorldLatency, providerRelayStats: relayCache, wantedNumProvidersInConcurrency: wantedNumProvidersInConcurrency}
+	privacySeed := make([]byte, 32)
+	if _, err := crand.Read(privacySeed); err != nil {
+		utils.LavaFormatFatal("failed generating privacy seed", err)
+	}
+	return &ProviderOptimizer{strategy: strategy, providersStorage: cache, averageBlockTime: averageBlockTIme, baseWorldLatency: baseWorldLatency, providerRelayStats: relayCache, wantedNumProvidersInConcurrency: wantedNumProvidersInConcurrency, privacySeed: privacySeed, knownProviders: map[string]struct{}{}}
 }
 
 // calculate the probability a random variable with a poisson distribution
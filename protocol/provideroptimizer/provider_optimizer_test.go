@@ -7,7 +7,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/lavanet/lava/protocol/common"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/lavaslices"
 	"github.com/lavanet/lava/utils/rand"
 	spectypes "github.com/lavanet/lava/x/spec/types"
 	"github.com/stretchr/testify/require"
@@ -572,3 +574,146 @@ func TestPerturbationWithNormalGaussianOnConcurrentComputation(t *testing.T) {
 	wg.Wait()
 	fmt.Println("Test completed successfully")
 }
+
+func TestProviderOptimizerPrivacyPinnedProviders(t *testing.T) {
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(20)
+
+	// other strategies use all providers
+	require.Equal(t, providersGen.providersAddresses, providerOptimizer.PinnedProviders(providersGen.providersAddresses, nil, 100))
+
+	providerOptimizer.strategy = STRATEGY_PRIVACY
+	pinned := providerOptimizer.PinnedProviders(providersGen.providersAddresses, nil, 100)
+	require.Len(t, pinned, PRIVACY_PINNED_PROVIDERS)
+	// stable during the epoch regardless of the order of the providers
+	reversed := make([]string, len(providersGen.providersAddresses))
+	for i, address := range providersGen.providersAddresses {
+		reversed[len(reversed)-1-i] = address
+	}
+	require.Equal(t, pinned, providerOptimizer.PinnedProviders(reversed, nil, 100))
+
+	// when all the pinned providers failed the next ranked providers are used
+	ignored := map[string]struct{}{}
+	for _, address := range pinned {
+		ignored[address] = struct{}{}
+	}
+	repinned := providerOptimizer.PinnedProviders(providersGen.providersAddresses, ignored, 100)
+	require.Len(t, repinned, PRIVACY_PINNED_PROVIDERS)
+	for _, address := range repinned {
+		require.NotContains(t, pinned, address)
+	}
+	require.Equal(t, repinned, providerOptimizer.PinnedProviders(reversed, ignored, 100))
+	// a single failing provider doesn't change the pinned set
+	require.Equal(t, pinned, providerOptimizer.PinnedProviders(providersGen.providersAddresses, map[string]struct{}{pinned[0]: {}}, 100))
+
+	// the pinned set changes between epochs
+	changed := false
+	for epoch := uint64(101); epoch < 110; epoch++ {
+		if !lavaslices.UnorderedEqual(pinned, providerOptimizer.PinnedProviders(providersGen.providersAddresses, nil, epoch)) {
+			changed = true
+		}
+	}
+	require.True(t, changed)
+
+	// another consumer is pinned to different providers
+	otherOptimizer := setupProviderOptimizer(1)
+	otherOptimizer.strategy = STRATEGY_PRIVACY
+	changed = false
+	for epoch := uint64(100); epoch < 110; epoch++ {
+		if !lavaslices.UnorderedEqual(providerOptimizer.PinnedProviders(providersGen.providersAddresses, nil, epoch), otherOptimizer.PinnedProviders(providersGen.providersAddresses, nil, epoch)) {
+			changed = true
+		}
+	}
+	require.True(t, changed)
+}
+
+func TestProviderOptimizerCostPrefersTouchedProviders(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_COST
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	syncBlock := uint64(requestBlock)
+
+	// provider 3 is the only one we relayed with, its latency is a bit worse than the default
+	for i := 0; i < 5; i++ {
+		providerOptimizer.AppendRelayData(providersGen.providersAddresses[3], TEST_BASE_WORLD_LATENCY*2+10*time.Millisecond, false, requestCU, syncBlock)
+		time.Sleep(4 * time.Millisecond)
+	}
+	returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	require.Equal(t, providersGen.providersAddresses[3], returnedProviders[0])
+
+	require.Equal(t, float64(1), providerOptimizer.strategyScoreFactor(providersGen.providersAddresses[3]))
+	require.Equal(t, COST_UNTOUCHED_PROVIDER_PENALTY, providerOptimizer.strategyScoreFactor(providersGen.providersAddresses[0]))
+
+	providerOptimizer.strategy = STRATEGY_BALANCED
+	require.Equal(t, float64(1), providerOptimizer.strategyScoreFactor(providersGen.providersAddresses[0]))
+}
+
+type agreementTrackerMock map[string]float64
+
+func (atm agreementTrackerMock) ProviderAgreement(providerAddress string) (float64, bool) {
+	agreement, found := atm[providerAddress]
+	return agreement, found
+}
+
+func TestProviderOptimizerAccuracyAgreement(t *testing.T) {
+	rand.InitRandomSeed()
+	providerOptimizer := setupProviderOptimizer(1)
+	providerOptimizer.strategy = STRATEGY_ACCURACY
+	providerOptimizer.wantedNumProvidersInConcurrency = 1
+	providersGen := (&providersGenerator{}).setupProvidersForTest(5)
+	requestCU := uint64(10)
+	requestBlock := int64(1000)
+	syncBlock := uint64(requestBlock)
+
+	// provider 0 has the best latency but disagrees with the others
+	for i := 0; i < 10; i++ {
+		for idx, address := range providersGen.providersAddresses {
+			latency := TEST_BASE_WORLD_LATENCY * 2
+			if idx == 0 {
+				latency = TEST_BASE_WORLD_LATENCY
+			}
+			providerOptimizer.AppendRelayData(address, latency, false, requestCU, syncBlock)
+		}
+		time.Sleep(4 * time.Millisecond)
+	}
+	returnedProviders := providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	require.Equal(t, providersGen.providersAddresses[0], returnedProviders[0])
+
+	agreement := agreementTrackerMock{}
+	for idx, address := range providersGen.providersAddresses {
+		agreement[address] = 1
+		if idx == 0 {
+			agreement[address] = 0.5
+		}
+	}
+	providerOptimizer.SetAgreementTracker(agreement)
+	returnedProviders = providerOptimizer.ChooseProvider(providersGen.providersAddresses, nil, requestCU, requestBlock, 0)
+	require.NotEqual(t, providersGen.providersAddresses[0], returnedProviders[0])
+}
+
+func TestProviderOptimizerExpectedLatency(t *testing.T) {
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(3)
+	requestCU := uint64(10)
+	syncBlock := uint64(1000)
+
+	// a provider we know nothing about is expected to have the base latency
+	initialLatency := providerOptimizer.ExpectedLatency(providersGen.providersAddresses[2], requestCU)
+	require.Greater(t, initialLatency, time.Duration(0))
+
+	for i := 0; i < 10; i++ {
+		providerOptimizer.AppendRelayData(providersGen.providersAddresses[0], TEST_BASE_WORLD_LATENCY, false, requestCU, syncBlock)
+		providerOptimizer.AppendRelayData(providersGen.providersAddresses[1], TEST_BASE_WORLD_LATENCY*10, false, requestCU, syncBlock)
+		time.Sleep(4 * time.Millisecond)
+	}
+	fastLatency := providerOptimizer.ExpectedLatency(providersGen.providersAddresses[0], requestCU)
+	slowLatency := providerOptimizer.ExpectedLatency(providersGen.providersAddresses[1], requestCU)
+	require.Less(t, fastLatency, slowLatency)
+	// heavier relays take longer
+	require.Less(t, fastLatency, providerOptimizer.ExpectedLatency(providersGen.providersAddresses[0], requestCU*10))
+	// the expected latency is capped by the relay timeout
+	require.LessOrEqual(t, slowLatency, common.GetTimePerCu(requestCU)+common.AverageWorldLatency)
+}
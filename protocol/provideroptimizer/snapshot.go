@@ -0,0 +1,147 @@
+package provideroptimizer
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/score"
+)
+
+const (
+	SNAPSHOT_INTERVAL = time.Minute
+	// snapshots older than this are not restored, the scores decayed completely
+	MAX_SNAPSHOT_AGE = INITIAL_DATA_STALENESS * time.Hour
+)
+
+// the provider scores of an optimizer at a point in time
+type ProviderOptimizerSnapshot struct {
+	Timestamp time.Time               `json:"timestamp"`
+	Providers map[string]ProviderData `json:"providers"`
+}
+
+// returns the scores of all the providers the optimizer currently holds
+func (po *ProviderOptimizer) Snapshot() ProviderOptimizerSnapshot {
+	po.knownProvidersLock.RLock()
+	addresses := make([]string, 0, len(po.knownProviders))
+	for address := range po.knownProviders {
+		addresses = append(addresses, address)
+	}
+	po.knownProvidersLock.RUnlock()
+
+	snapshot := ProviderOptimizerSnapshot{Timestamp: time.Now(), Providers: map[string]ProviderData{}}
+	for _, address := range addresses {
+		providerData, found := po.getProviderData(address)
+		if !found {
+			// evicted from the cache
+			po.knownProvidersLock.Lock()
+			delete(po.knownProviders, address)
+			po.knownProvidersLock.Unlock()
+			continue
+		}
+		snapshot.Providers[address] = providerData
+	}
+	return snapshot
+}
+
+// loads the scores of a snapshot, the older the snapshot the closer the scores are set to the initial scores.
+// providers that already have data are not overwritten
+func (po *ProviderOptimizer) RestoreSnapshot(snapshot ProviderOptimizerSnapshot, now time.Time) {
+	age := now.Sub(snapshot.Timestamp)
+	if age < 0 {
+		age = 0
+	}
+	if age > MAX_SNAPSHOT_AGE {
+		utils.LavaFormatDebug("optimizer snapshot is too old, ignoring it", utils.LogAttr("timestamp", snapshot.Timestamp))
+		return
+	}
+	// the weight of the snapshot scores, the rest is taken from the initial scores
+	weight := math.Exp(-math.Ln2 * age.Seconds() / HALF_LIFE_TIME.Seconds())
+	for address, snapshotData := range snapshot.Providers {
+		if _, found := po.getProviderData(address); found {
+			continue
+		}
+		providerData, _ := po.getProviderData(address) // initial scores
+		providerData.Availability = decayScoreToInitial(snapshotData.Availability, providerData.Availability, weight, now)
+		providerData.Latency = decayScoreToInitial(snapshotData.Latency, providerData.Latency, weight, now)
+		providerData.Sync = decayScoreToInitial(snapshotData.Sync, providerData.Sync, weight, now)
+		// the sync block is not restored, the chain advanced since the snapshot and an old block would count as a block error
+		po.setProviderData(address, providerData)
+	}
+}
+
+func decayScoreToInitial(snapshotScore, initialScore score.ScoreStore, weight float64, now time.Time) score.ScoreStore {
+	if snapshotScore.Denom <= 0 {
+		return initialScore
+	}
+	// the snapshot samples are worth as much as they would have been after decaying since the snapshot,
+	// so a restored score that is backed by many samples isn't overridden by the first new sample
+	mean := snapshotScore.Num / snapshotScore.Denom
+	initialMean := initialScore.Num / initialScore.Denom
+	denom := snapshotScore.Denom * weight
+	return score.NewScoreStore((mean*weight+initialMean*(1-weight))*denom, denom, now)
+}
+
+// writes the snapshot to a file, the file is replaced atomically
+func (snapshot ProviderOptimizerSnapshot) WriteToFile(path string) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	tmpPath := path + ".tmp"
+	err = os.WriteFile(tmpPath, data, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func ReadSnapshotFromFile(path string) (ProviderOptimizerSnapshot, error) {
+	snapshot := ProviderOptimizerSnapshot{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return snapshot, err
+	}
+	err = json.Unmarshal(data, &snapshot)
+	return snapshot, err
+}
+
+// restores the scores from the snapshot file and keeps it updated until the context is done
+func (po *ProviderOptimizer) PersistToFile(ctx context.Context, path string, interval time.Duration) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return utils.LavaFormatError("failed creating optimizer snapshots directory", err, utils.LogAttr("path", path))
+	}
+	snapshot, err := ReadSnapshotFromFile(path)
+	if err == nil {
+		po.RestoreSnapshot(snapshot, time.Now())
+		utils.LavaFormatInfo("restored optimizer scores", utils.LogAttr("path", path), utils.LogAttr("providers", len(snapshot.Providers)), utils.LogAttr("timestamp", snapshot.Timestamp))
+	} else if !os.IsNotExist(err) {
+		utils.LavaFormatWarning("failed reading optimizer snapshot, starting without it", err, utils.LogAttr("path", path))
+	}
+
+	writeSnapshot := func() {
+		err := po.Snapshot().WriteToFile(path)
+		if err != nil {
+			utils.LavaFormatWarning("failed writing optimizer snapshot", err, utils.LogAttr("path", path))
+		}
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				writeSnapshot()
+				return
+			case <-ticker.C:
+				writeSnapshot()
+			}
+		}
+	}()
+	return nil
+}
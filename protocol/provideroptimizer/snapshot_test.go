@@ -0,0 +1,92 @@
+package provideroptimizer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderOptimizerSnapshotRestore(t *testing.T) {
+	providerOptimizer := setupProviderOptimizer(1)
+	providersGen := (&providersGenerator{}).setupProvidersForTest(3)
+	requestCU := uint64(10)
+	syncBlock := uint64(1000)
+	for i := 0; i < 10; i++ {
+		providerOptimizer.AppendRelayData(providersGen.providersAddresses[0], TEST_BASE_WORLD_LATENCY, false, requestCU, syncBlock)
+		providerOptimizer.AppendRelayFailure(providersGen.providersAddresses[1])
+		time.Sleep(4 * time.Millisecond)
+	}
+	snapshot := providerOptimizer.Snapshot()
+	require.Len(t, snapshot.Providers, 2)
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	require.NoError(t, snapshot.WriteToFile(path))
+	readSnapshot, err := ReadSnapshotFromFile(path)
+	require.NoError(t, err)
+	require.Len(t, readSnapshot.Providers, 2)
+
+	mean := func(providerData ProviderData) (availability, latency float64) {
+		return providerData.Availability.Num / providerData.Availability.Denom, providerData.Latency.Num / providerData.Latency.Denom
+	}
+	originalAvailability, originalLatency := mean(snapshot.Providers[providersGen.providersAddresses[1]])
+
+	// a fresh snapshot restores the scores as they were
+	restoredOptimizer := setupProviderOptimizer(1)
+	restoredOptimizer.RestoreSnapshot(readSnapshot, readSnapshot.Timestamp)
+	time.Sleep(4 * time.Millisecond)
+	providerData, found := restoredOptimizer.getProviderData(providersGen.providersAddresses[1])
+	require.True(t, found)
+	availability, _ := mean(providerData)
+	require.InDelta(t, originalAvailability, availability, 0.0001)
+	require.InDelta(t, snapshot.Providers[providersGen.providersAddresses[1]].Availability.Denom, providerData.Availability.Denom, 0.0001)
+	_, found = restoredOptimizer.getProviderData(providersGen.providersAddresses[2])
+	require.False(t, found)
+
+	// after a half life the scores are half way back to the initial scores
+	decayedOptimizer := setupProviderOptimizer(1)
+	decayedOptimizer.RestoreSnapshot(readSnapshot, readSnapshot.Timestamp.Add(HALF_LIFE_TIME))
+	time.Sleep(4 * time.Millisecond)
+	providerData, found = decayedOptimizer.getProviderData(providersGen.providersAddresses[1])
+	require.True(t, found)
+	initialData, _ := decayedOptimizer.getProviderData(providersGen.providersAddresses[2])
+	initialAvailability, initialLatency := mean(initialData)
+	availability, latency := mean(providerData)
+	require.InDelta(t, (originalAvailability+initialAvailability)/2, availability, 0.0001)
+	require.InDelta(t, (originalLatency+initialLatency)/2, latency, 0.0001)
+	// the samples of the snapshot decayed as well
+	require.InDelta(t, snapshot.Providers[providersGen.providersAddresses[1]].Availability.Denom/2, providerData.Availability.Denom, 0.0001)
+	require.Zero(t, providerData.SyncBlock)
+
+	// an old snapshot is ignored
+	staleOptimizer := setupProviderOptimizer(1)
+	staleOptimizer.RestoreSnapshot(readSnapshot, readSnapshot.Timestamp.Add(MAX_SNAPSHOT_AGE+time.Minute))
+	time.Sleep(4 * time.Millisecond)
+	_, found = staleOptimizer.getProviderData(providersGen.providersAddresses[1])
+	require.False(t, found)
+}
+
+func TestProviderOptimizerPersistToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "optimizer", "LAV1.json")
+	providersGen := (&providersGenerator{}).setupProvidersForTest(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	providerOptimizer := setupProviderOptimizer(1)
+	require.NoError(t, providerOptimizer.PersistToFile(ctx, path, time.Hour))
+	providerOptimizer.AppendRelayFailure(providersGen.providersAddresses[0])
+	time.Sleep(4 * time.Millisecond)
+	// the snapshot is written when the consumer stops
+	cancel()
+	require.Eventually(t, func() bool {
+		snapshot, err := ReadSnapshotFromFile(path)
+		return err == nil && len(snapshot.Providers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	restoredOptimizer := setupProviderOptimizer(1)
+	require.NoError(t, restoredOptimizer.PersistToFile(context.Background(), path, time.Hour))
+	time.Sleep(4 * time.Millisecond)
+	_, found := restoredOptimizer.getProviderData(providersGen.providersAddresses[0])
+	require.True(t, found)
+}
@@ -0,0 +1,625 @@
+package rpcconsumer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"sync"
+
+	"github.com/lavanet/lava/ecosystem/cache/format"
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/chainproxy/rpcclient"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavaprotocol"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/utils"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/tidwall/gjson"
+)
+
+const (
+	MaxSubscriptionFailovers      = 3
+	MaxSubscriptionAttachAttempts = 3
+	SubscriptionClientBufferSize  = 100
+)
+
+// holds the cancel functions of the subscriptions opened by websocket clients so they can be unsubscribed,
+// and the provider streams shared by identical subscriptions keyed by the hashed request
+type ActiveSubscriptions struct {
+	lock          sync.Mutex
+	subscriptions map[string]context.CancelFunc
+	shared        map[string]*sharedSubscription
+}
+
+func NewActiveSubscriptions() *ActiveSubscriptions {
+	return &ActiveSubscriptions{subscriptions: map[string]context.CancelFunc{}, shared: map[string]*sharedSubscription{}}
+}
+
+func (as *ActiveSubscriptions) key(dappID string, consumerIp string, subscriptionID string) string {
+	// consumerIp includes the client port so it is unique per websocket connection
+	return common.GetUniqueToken(dappID, consumerIp) + "|" + subscriptionID
+}
+
+func (as *ActiveSubscriptions) Store(dappID string, consumerIp string, subscriptionID string, cancel context.CancelFunc) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	key := as.key(dappID, consumerIp, subscriptionID)
+	if existing, ok := as.subscriptions[key]; ok {
+		// the same connection subscribed twice with identical params, both share the subscription id
+		previousCancel := existing
+		as.subscriptions[key] = func() {
+			previousCancel()
+			cancel()
+		}
+		return
+	}
+	as.subscriptions[key] = cancel
+}
+
+func (as *ActiveSubscriptions) Remove(dappID string, consumerIp string, subscriptionID string) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	delete(as.subscriptions, as.key(dappID, consumerIp, subscriptionID))
+}
+
+// cancels the subscription and removes it, returns false if the subscription was not found
+func (as *ActiveSubscriptions) Cancel(dappID string, consumerIp string, subscriptionID string) bool {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	key := as.key(dappID, consumerIp, subscriptionID)
+	cancel, ok := as.subscriptions[key]
+	if !ok {
+		return false
+	}
+	cancel()
+	delete(as.subscriptions, key)
+	return true
+}
+
+// cancels all subscriptions of a single client, used for tendermint unsubscribe_all
+func (as *ActiveSubscriptions) CancelAll(dappID string, consumerIp string) int {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	prefix := as.key(dappID, consumerIp, "")
+	canceled := 0
+	for key, cancel := range as.subscriptions {
+		if len(key) >= len(prefix) && key[:len(prefix)] == prefix {
+			cancel()
+			delete(as.subscriptions, key)
+			canceled++
+		}
+	}
+	return canceled
+}
+
+// returns the shared subscription of the hashed request, created is true if the caller needs to open its provider stream
+func (as *ActiveSubscriptions) getOrCreateShared(hashKey string) (shared *sharedSubscription, created bool) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	if shared, ok := as.shared[hashKey]; ok {
+		return shared, false
+	}
+	shared = &sharedSubscription{
+		hashKey: hashKey,
+		ready:   make(chan struct{}),
+		clients: map[*subscriptionClient]struct{}{},
+	}
+	as.shared[hashKey] = shared
+	return shared, true
+}
+
+func (as *ActiveSubscriptions) removeShared(shared *sharedSubscription) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	as.removeSharedInner(shared)
+}
+
+// as.lock must be locked
+func (as *ActiveSubscriptions) removeSharedInner(shared *sharedSubscription) {
+	shared.closed = true
+	if current, ok := as.shared[shared.hashKey]; ok && current == shared {
+		delete(as.shared, shared.hashKey)
+	}
+}
+
+func (as *ActiveSubscriptions) attach(ctx context.Context, shared *sharedSubscription, outputFormatter func([]byte) []byte) (client *subscriptionClient, attached bool) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	if shared.closed {
+		return nil, false
+	}
+	client = &subscriptionClient{
+		Relayer_RelaySubscribeClient: shared.stream,
+		ctx:                          ctx,
+		replies:                      make(chan *pairingtypes.RelayReply, SubscriptionClientBufferSize),
+		outputFormatter:              outputFormatter,
+	}
+	shared.clients[client] = struct{}{}
+	return client, true
+}
+
+// detaches a client from the shared subscription, the provider stream is torn down when the last client leaves
+func (as *ActiveSubscriptions) detach(shared *sharedSubscription, client *subscriptionClient) {
+	as.lock.Lock()
+	defer as.lock.Unlock()
+	if _, ok := shared.clients[client]; !ok {
+		return
+	}
+	delete(shared.clients, client)
+	client.close(context.Canceled)
+	if len(shared.clients) == 0 && !shared.closed {
+		as.removeSharedInner(shared)
+		shared.cancel()
+	}
+}
+
+// reads the provider stream and sends every reply to all attached clients
+func (as *ActiveSubscriptions) fanOut(shared *sharedSubscription) {
+	for {
+		reply, err := shared.stream.Recv()
+		as.lock.Lock()
+		if err != nil {
+			as.removeSharedInner(shared)
+			for client := range shared.clients {
+				client.close(err)
+				delete(shared.clients, client)
+			}
+			as.lock.Unlock()
+			shared.cancel()
+			return
+		}
+		for client := range shared.clients {
+			select {
+			case client.replies <- reply:
+			default:
+				// a client that doesn't keep up must not hold back the others
+				utils.LavaFormatWarning("subscription client is too slow, disconnecting it", nil, utils.LogAttr("GUID", client.ctx))
+				client.close(utils.LavaFormatError("subscription client buffer is full", nil))
+				delete(shared.clients, client)
+			}
+		}
+		if len(shared.clients) == 0 {
+			as.removeSharedInner(shared)
+			as.lock.Unlock()
+			shared.cancel()
+			return
+		}
+		as.lock.Unlock()
+	}
+}
+
+// a provider stream shared between all the clients that sent an identical subscription request
+type sharedSubscription struct {
+	hashKey     string
+	ready       chan struct{} // closed once the provider stream was opened or failed
+	relayResult *common.RelayResult
+	err         error
+	stream      *consumerSubscriptionStream
+	cancel      context.CancelFunc
+	clients     map[*subscriptionClient]struct{}
+	closed      bool
+}
+
+// subscriptionClient is the stream a single websocket client reads from, replies are pushed by the shared subscription
+type subscriptionClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	ctx             context.Context
+	replies         chan *pairingtypes.RelayReply
+	outputFormatter func([]byte) []byte
+	err             error
+	closed          bool
+}
+
+// ActiveSubscriptions.lock must be locked
+func (sc *subscriptionClient) close(err error) {
+	if sc.closed {
+		return
+	}
+	sc.closed = true
+	sc.err = err
+	close(sc.replies)
+}
+
+func (sc *subscriptionClient) Recv() (*pairingtypes.RelayReply, error) {
+	select {
+	case reply, ok := <-sc.replies:
+		if !ok {
+			return nil, sc.err
+		}
+		data := reply.Data
+		if gjson.GetBytes(data, format.IDFieldName).Exists() {
+			// replies carrying the request id (e.g. tendermint events) get the id of this client's request
+			data = sc.outputFormatter(data)
+		}
+		return &pairingtypes.RelayReply{Data: data, LatestBlock: reply.LatestBlock}, nil
+	case <-sc.ctx.Done():
+		return nil, sc.ctx.Err()
+	}
+}
+
+func (sc *subscriptionClient) RecvMsg(m interface{}) error {
+	reply, err := sc.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pairingtypes.RelayReply)
+	if !ok {
+		return utils.LavaFormatError("invalid message type for subscription stream", nil, utils.LogAttr("type", m))
+	}
+	*out = *reply
+	return nil
+}
+
+// consumerSubscriptionStream wraps the RelaySubscribe stream of a provider, when the stream breaks it re-subscribes to a different provider
+// and translates the new provider's subscription id to the one the client already knows
+type consumerSubscriptionStream struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	ctx                    context.Context
+	rpccs                  *RPCConsumerServer
+	chainMessage           chainlib.ChainMessage
+	relayRequestData       *pairingtypes.RelayPrivateData
+	usedProviders          *lavasession.UsedProviders
+	clientSubscriptionID   string
+	upstreamSubscriptionID string
+	provider               string
+	failovers              int
+}
+
+func (css *consumerSubscriptionStream) Recv() (*pairingtypes.RelayReply, error) {
+	for {
+		reply, err := css.Relayer_RelaySubscribeClient.Recv()
+		if err == nil {
+			reply.Data = css.translateSubscriptionID(reply.Data)
+			return reply, nil
+		}
+		if css.ctx.Err() != nil {
+			// the client disconnected or unsubscribed, no need to fail over
+			return nil, err
+		}
+		utils.LavaFormatWarning("subscription stream broke, failing over to another provider", err,
+			utils.LogAttr("GUID", css.ctx),
+			utils.LogAttr("provider", css.provider),
+			utils.LogAttr("failovers", css.failovers),
+		)
+		if css.failovers >= MaxSubscriptionFailovers {
+			return nil, utils.LavaFormatError("subscription failover attempts exhausted", err, utils.LogAttr("GUID", css.ctx), utils.LogAttr("failovers", css.failovers))
+		}
+		css.failovers++
+		relayResult, errSubscribe := css.rpccs.subscribeWithRetries(css.ctx, css.chainMessage, css.relayRequestData, css.usedProviders)
+		if errSubscribe != nil {
+			return nil, errSubscribe
+		}
+		stream := relayResult.ReplyServer
+		if stream == nil {
+			// the new provider returned a node error instead of subscribing
+			return nil, utils.LavaFormatError("failed re-subscribing to a new provider", nil, utils.LogAttr("GUID", css.ctx), utils.LogAttr("reply", string(relayResult.Reply.GetData())))
+		}
+		css.Relayer_RelaySubscribeClient = *stream
+		css.provider = relayResult.GetProvider()
+		css.upstreamSubscriptionID = getSubscriptionIDFromReply(relayResult.Reply)
+	}
+}
+
+func (css *consumerSubscriptionStream) RecvMsg(m interface{}) error {
+	reply, err := css.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pairingtypes.RelayReply)
+	if !ok {
+		return utils.LavaFormatError("invalid message type for subscription stream", nil, utils.LogAttr("type", m))
+	}
+	*out = *reply
+	return nil
+}
+
+func (css *consumerSubscriptionStream) translateSubscriptionID(data []byte) []byte {
+	if css.upstreamSubscriptionID == "" || css.upstreamSubscriptionID == css.clientSubscriptionID {
+		return data
+	}
+	upstream, err := json.Marshal(css.upstreamSubscriptionID)
+	if err != nil {
+		return data
+	}
+	client, err := json.Marshal(css.clientSubscriptionID)
+	if err != nil {
+		return data
+	}
+	return bytes.ReplaceAll(data, upstream, client)
+}
+
+// meteredSubscriptionStream adds the cu of every message the provider streams to the session that opened the stream,
+// reconciling against the total streamed cu the provider reports on each message
+type meteredSubscriptionStream struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	rpccs                 *RPCConsumerServer
+	singleConsumerSession *lavasession.SingleConsumerSession
+	cu                    uint64
+	streamedCu            uint64
+}
+
+func (mss *meteredSubscriptionStream) Recv() (*pairingtypes.RelayReply, error) {
+	reply, err := mss.Relayer_RelaySubscribeClient.Recv()
+	if err != nil {
+		return nil, err
+	}
+	streamedCu, messageCu, err := getStreamedCu(reply, mss.streamedCu, mss.cu)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("invalid streamed cu from provider, closing the stream", err, utils.LogAttr("GUID", mss.Context()))
+	}
+	err = mss.rpccs.consumerSessionManager.OnStreamedMessage(mss.singleConsumerSession, messageCu, mss.rpccs.consumerTxSender.GetLatestVirtualEpoch())
+	if err != nil {
+		return nil, utils.LavaFormatWarning("failed adding streamed message cu, closing the stream", err, utils.LogAttr("GUID", mss.Context()))
+	}
+	mss.streamedCu = streamedCu
+	return reply, nil
+}
+
+// returns the provider's total streamed cu reported on the reply and the cu of this message. providers that don't
+// report the total are billed the api cu per message, a provider can't bill a message for more than the api cu
+func getStreamedCu(reply *pairingtypes.RelayReply, lastStreamedCu uint64, cu uint64) (streamedCu uint64, messageCu uint64, err error) {
+	for _, metadata := range reply.GetMetadata() {
+		if metadata.Name != common.STREAMED_CU_HEADER_NAME {
+			continue
+		}
+		streamedCu, err = strconv.ParseUint(metadata.Value, 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		if streamedCu < lastStreamedCu || streamedCu-lastStreamedCu > cu {
+			return 0, 0, utils.LavaFormatWarning("provider reported streamed cu out of range", nil,
+				utils.LogAttr("streamedCu", streamedCu),
+				utils.LogAttr("lastStreamedCu", lastStreamedCu),
+				utils.LogAttr("cu", cu),
+			)
+		}
+		return streamedCu, streamedCu - lastStreamedCu, nil
+	}
+	return lastStreamedCu + cu, cu, nil
+}
+
+func (mss *meteredSubscriptionStream) RecvMsg(m interface{}) error {
+	reply, err := mss.Recv()
+	if err != nil {
+		return err
+	}
+	out, ok := m.(*pairingtypes.RelayReply)
+	if !ok {
+		return utils.LavaFormatError("invalid message type for subscription stream", nil, utils.LogAttr("type", m))
+	}
+	*out = *reply
+	return nil
+}
+
+// returns the subscription id of a subscription reply, jsonrpc subscriptions return the id as the result,
+// tendermint subscriptions return an empty result and are identified by their query
+func getSubscriptionIDFromReply(reply *pairingtypes.RelayReply) string {
+	var msg rpcclient.JsonrpcMessage
+	if err := json.Unmarshal(reply.GetData(), &msg); err != nil {
+		return ""
+	}
+	var subscriptionID string
+	if err := json.Unmarshal(msg.Result, &subscriptionID); err != nil {
+		return ""
+	}
+	return subscriptionID
+}
+
+// returns the subscription id a client references in a subscribe or unsubscribe request,
+// jsonrpc uses the first positional param and tendermint uses the query
+func getSubscriptionIDFromRequest(data []byte) string {
+	var msg rpcclient.JsonrpcMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return ""
+	}
+	var positionalParams []interface{}
+	if err := json.Unmarshal(msg.Params, &positionalParams); err == nil {
+		if len(positionalParams) > 0 {
+			if subscriptionID, ok := positionalParams[0].(string); ok {
+				return subscriptionID
+			}
+		}
+		return ""
+	}
+	var namedParams map[string]interface{}
+	if err := json.Unmarshal(msg.Params, &namedParams); err == nil {
+		if query, ok := namedParams["query"].(string); ok {
+			return query
+		}
+	}
+	return ""
+}
+
+// handles a subscription message, identical subscriptions share a single provider stream, so the first client
+// opens a RelaySubscribe stream and the following ones attach to it
+func (rpccs *RPCConsumerServer) sendSubscriptionRelay(ctx context.Context, directiveHeaders map[string]string, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, dappID string, consumerIp string) (*common.RelayResult, error) {
+	if rpccs.listenEndpoint.ApiInterface == spectypes.APIInterfaceGrpc {
+		// a grpc server stream belongs to the client's call, it isn't shared and can't fail over once it started streaming
+		return rpccs.subscribeWithRetries(ctx, chainMessage, relayRequestData, lavasession.NewUsedProviders(directiveHeaders))
+	}
+	hashKey, outputFormatter, err := chainlib.HashCacheRequest(relayRequestData, rpccs.listenEndpoint.ChainID)
+	if err != nil {
+		return nil, utils.LavaFormatError("failed hashing subscription request", err, utils.LogAttr("GUID", ctx))
+	}
+	for attempt := 0; attempt < MaxSubscriptionAttachAttempts; attempt++ {
+		shared, created := rpccs.activeSubscriptions.getOrCreateShared(string(hashKey))
+		// the subscription lives as long as the client's context or until it unsubscribes
+		clientCtx, cancel := context.WithCancel(ctx)
+		var client *subscriptionClient
+		if created {
+			client = rpccs.openSharedSubscription(ctx, shared, clientCtx, outputFormatter, directiveHeaders, chainMessage, relayRequestData)
+		} else {
+			select {
+			case <-shared.ready:
+			case <-ctx.Done():
+				cancel()
+				return nil, ctx.Err()
+			}
+			if shared.err == nil && shared.stream != nil {
+				client, _ = rpccs.activeSubscriptions.attach(clientCtx, shared, outputFormatter)
+			}
+		}
+		if shared.err != nil {
+			cancel()
+			return shared.relayResult, shared.err
+		}
+		if shared.stream == nil {
+			// the provider replied with a node error, return it as a regular reply
+			cancel()
+			relayResult := *shared.relayResult
+			relayResult.Reply = &pairingtypes.RelayReply{Data: outputFormatter(shared.relayResult.Reply.Data), LatestBlock: shared.relayResult.Reply.LatestBlock}
+			return &relayResult, nil
+		}
+		if client == nil {
+			// the shared stream ended while we were waiting for it, open a new one
+			cancel()
+			continue
+		}
+		clientSubscriptionID := shared.stream.clientSubscriptionID
+		rpccs.activeSubscriptions.Store(dappID, consumerIp, clientSubscriptionID, cancel)
+		go func() {
+			<-clientCtx.Done()
+			rpccs.activeSubscriptions.Remove(dappID, consumerIp, clientSubscriptionID)
+			rpccs.activeSubscriptions.detach(shared, client)
+		}()
+		relayResult := *shared.relayResult
+		relayResult.Reply = &pairingtypes.RelayReply{Data: outputFormatter(shared.relayResult.Reply.Data), LatestBlock: shared.relayResult.Reply.LatestBlock}
+		var replyServer pairingtypes.Relayer_RelaySubscribeClient = client
+		relayResult.ReplyServer = &replyServer
+		return &relayResult, nil
+	}
+	return nil, utils.LavaFormatError("shared subscription ended before the client could attach to it", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("attempts", MaxSubscriptionAttachAttempts))
+}
+
+// opens the provider stream of a shared subscription, attaches the first client and starts fanning out the replies.
+// the first client is attached before the fan out starts so a reply arriving right away doesn't find the subscription
+// without clients and tear it down. the upstream context is not tied to the first client as it can leave while other
+// clients are still attached. returns nil if the subscription failed or the provider replied with a node error
+func (rpccs *RPCConsumerServer) openSharedSubscription(ctx context.Context, shared *sharedSubscription, clientCtx context.Context, outputFormatter func([]byte) []byte, directiveHeaders map[string]string, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData) *subscriptionClient {
+	defer close(shared.ready)
+	upstreamCtx, cancel := context.WithCancel(context.Background())
+	guid, found := utils.GetUniqueIdentifier(ctx)
+	if found {
+		upstreamCtx = utils.WithUniqueIdentifier(upstreamCtx, guid)
+	}
+	shared.cancel = cancel
+	usedProviders := lavasession.NewUsedProviders(directiveHeaders)
+	relayResult, err := rpccs.subscribeWithRetries(upstreamCtx, chainMessage, relayRequestData, usedProviders)
+	shared.relayResult = relayResult
+	shared.err = err
+	if err != nil || relayResult.ReplyServer == nil {
+		cancel()
+		rpccs.activeSubscriptions.removeShared(shared)
+		return nil
+	}
+	clientSubscriptionID := getSubscriptionIDFromReply(relayResult.Reply)
+	if clientSubscriptionID == "" {
+		clientSubscriptionID = getSubscriptionIDFromRequest(relayRequestData.Data)
+	}
+	shared.stream = &consumerSubscriptionStream{
+		Relayer_RelaySubscribeClient: *relayResult.ReplyServer,
+		ctx:                          upstreamCtx,
+		rpccs:                        rpccs,
+		chainMessage:                 chainMessage,
+		relayRequestData:             relayRequestData,
+		usedProviders:                usedProviders,
+		clientSubscriptionID:         clientSubscriptionID,
+		upstreamSubscriptionID:       clientSubscriptionID,
+		provider:                     relayResult.GetProvider(),
+	}
+	client, _ := rpccs.activeSubscriptions.attach(clientCtx, shared, outputFormatter)
+	go rpccs.activeSubscriptions.fanOut(shared)
+	return client
+}
+
+// handles an unsubscribe message locally by closing the matching subscription streams
+func (rpccs *RPCConsumerServer) handleUnsubscribe(ctx context.Context, chainMessage chainlib.ChainMessage, req string, dappID string, consumerIp string) (*common.RelayResult, error) {
+	var msg rpcclient.JsonrpcMessage
+	if err := json.Unmarshal([]byte(req), &msg); err != nil {
+		return nil, utils.LavaFormatError("failed parsing unsubscribe message", err, utils.LogAttr("GUID", ctx))
+	}
+	var found bool
+	if chainMessage.GetApi().Name == lavasession.TendermintUnsubscribeAll {
+		found = rpccs.activeSubscriptions.CancelAll(dappID, consumerIp) > 0
+	} else {
+		found = rpccs.activeSubscriptions.Cancel(dappID, consumerIp, getSubscriptionIDFromRequest([]byte(req)))
+	}
+	var result json.RawMessage
+	if rpccs.listenEndpoint.ApiInterface == spectypes.APIInterfaceTendermintRPC {
+		result = json.RawMessage("{}")
+	} else {
+		result, _ = json.Marshal(found)
+	}
+	reply, err := json.Marshal(rpcclient.JsonrpcMessage{Version: msg.Version, ID: msg.ID, Result: result})
+	if err != nil {
+		return nil, utils.LavaFormatError("failed marshaling unsubscribe reply", err, utils.LogAttr("GUID", ctx))
+	}
+	return &common.RelayResult{
+		Reply:        &pairingtypes.RelayReply{Data: reply},
+		StatusCode:   200,
+		ProviderInfo: common.ProviderInfo{ProviderAddress: ""},
+	}, nil
+}
+
+// tries subscribing on different providers until one accepts the subscription
+func (rpccs *RPCConsumerServer) subscribeWithRetries(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, usedProviders *lavasession.UsedProviders) (relayResult *common.RelayResult, err error) {
+	for i := 0; i < MaxRelayRetries; i++ {
+		relayResult, err = rpccs.subscribeToProvider(ctx, chainMessage, relayRequestData, usedProviders)
+		if err == nil {
+			return relayResult, nil
+		}
+		if lavasession.PairingListEmptyError.Is(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	return nil, utils.LavaFormatError("failed subscribing to providers", err, utils.LogAttr("GUID", ctx), utils.LogAttr("endpoint", rpccs.listenEndpoint.Key()))
+}
+
+func (rpccs *RPCConsumerServer) subscribeToProvider(ctx context.Context, chainMessage chainlib.ChainMessage, relayRequestData *pairingtypes.RelayPrivateData, usedProviders *lavasession.UsedProviders) (*common.RelayResult, error) {
+	reqBlock, _ := chainMessage.RequestedBlock()
+	virtualEpoch := rpccs.consumerTxSender.GetLatestVirtualEpoch()
+	sessions, err := rpccs.consumerSessionManager.GetSessions(ctx, chainlib.GetComputeUnits(chainMessage), usedProviders, reqBlock, chainlib.GetAddon(chainMessage), chainMessage.GetExtensions(), chainlib.GetStateful(chainMessage), virtualEpoch)
+	if err != nil {
+		return nil, err
+	}
+	var relayResult *common.RelayResult
+	for providerPublicAddress, sessionInfo := range sessions {
+		singleConsumerSession := sessionInfo.Session
+		if relayResult != nil {
+			// subscriptions are served by a single provider, release the sessions we don't need
+			errUnUsed := rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
+			if errUnUsed != nil {
+				utils.LavaFormatError("failed releasing unused subscription session", errUnUsed, utils.LogAttr("GUID", ctx))
+			}
+			continue
+		}
+		localRelayRequestData := *relayRequestData
+		localRelayResult := &common.RelayResult{
+			ProviderInfo: common.ProviderInfo{ProviderAddress: providerPublicAddress, ProviderStake: sessionInfo.StakeSize, ProviderQoSExcellenceSummery: sessionInfo.QoSSummeryResult},
+			Finalized:    false,
+		}
+		relayRequest, errConstruct := lavaprotocol.ConstructRelayRequest(ctx, rpccs.privKey, rpccs.lavaChainID, rpccs.listenEndpoint.ChainID, &localRelayRequestData, providerPublicAddress, singleConsumerSession, int64(sessionInfo.Epoch), sessionInfo.ReportedProviders)
+		if errConstruct != nil {
+			err = utils.LavaFormatError("Failed ConstructRelayRequest", errConstruct, utils.LogAttr("Request data", localRelayRequestData))
+			errReport := rpccs.consumerSessionManager.OnSessionUnUsed(singleConsumerSession)
+			if errReport != nil {
+				utils.LavaFormatError("failed releasing subscription session", errReport, utils.LogAttr("GUID", ctx))
+			}
+			continue
+		}
+		localRelayResult.Request = relayRequest
+		endpointClient := *singleConsumerSession.Endpoint.Client
+		errSubscribe := rpccs.relaySubscriptionInner(ctx, endpointClient, singleConsumerSession, localRelayResult, chainMessage)
+		if errSubscribe != nil {
+			err = errSubscribe
+			continue
+		}
+		relayResult = localRelayResult
+	}
+	if relayResult == nil {
+		return nil, err
+	}
+	return relayResult, nil
+}
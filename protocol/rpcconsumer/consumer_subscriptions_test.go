@@ -0,0 +1,226 @@
+package rpcconsumer
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSubscriptionIDFromReply(t *testing.T) {
+	playbook := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{name: "jsonrpc subscription id", data: `{"jsonrpc":"2.0","id":1,"result":"0x9cef478923ff08bf67fde6c64013158d"}`, expected: "0x9cef478923ff08bf67fde6c64013158d"},
+		{name: "tendermint empty result", data: `{"jsonrpc":"2.0","id":1,"result":{}}`, expected: ""},
+		{name: "node error", data: `{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"bad"}}`, expected: ""},
+		{name: "invalid json", data: `not json`, expected: ""},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.Equal(t, play.expected, getSubscriptionIDFromReply(&pairingtypes.RelayReply{Data: []byte(play.data)}))
+		})
+	}
+}
+
+func TestGetSubscriptionIDFromRequest(t *testing.T) {
+	playbook := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{name: "eth_unsubscribe", data: `{"jsonrpc":"2.0","id":2,"method":"eth_unsubscribe","params":["0x9cef478923ff08bf67fde6c64013158d"]}`, expected: "0x9cef478923ff08bf67fde6c64013158d"},
+		{name: "tendermint unsubscribe", data: `{"jsonrpc":"2.0","id":2,"method":"unsubscribe","params":{"query":"tm.event='NewBlock'"}}`, expected: "tm.event='NewBlock'"},
+		{name: "no params", data: `{"jsonrpc":"2.0","id":2,"method":"unsubscribe_all"}`, expected: ""},
+		{name: "non string param", data: `{"jsonrpc":"2.0","id":2,"method":"eth_unsubscribe","params":[5]}`, expected: ""},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			require.Equal(t, play.expected, getSubscriptionIDFromRequest([]byte(play.data)))
+		})
+	}
+}
+
+func TestActiveSubscriptions(t *testing.T) {
+	activeSubscriptions := NewActiveSubscriptions()
+	dappID := "dapp"
+	ip := "1.1.1.1:443"
+	otherIp := "1.1.1.1:444"
+
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	ctx3, cancel3 := context.WithCancel(context.Background())
+	activeSubscriptions.Store(dappID, ip, "0x1", cancel1)
+	activeSubscriptions.Store(dappID, ip, "0x2", cancel2)
+	activeSubscriptions.Store(dappID, otherIp, "0x1", cancel3)
+
+	// a different connection can't unsubscribe someone else's subscription
+	require.False(t, activeSubscriptions.Cancel(dappID, "2.2.2.2:443", "0x1"))
+	require.True(t, activeSubscriptions.Cancel(dappID, ip, "0x1"))
+	require.Error(t, ctx1.Err())
+	require.NoError(t, ctx2.Err())
+	require.NoError(t, ctx3.Err())
+	// already cancelled
+	require.False(t, activeSubscriptions.Cancel(dappID, ip, "0x1"))
+
+	require.Equal(t, 1, activeSubscriptions.CancelAll(dappID, ip))
+	require.Error(t, ctx2.Err())
+	require.NoError(t, ctx3.Err())
+}
+
+func TestTranslateSubscriptionID(t *testing.T) {
+	stream := &consumerSubscriptionStream{clientSubscriptionID: "0xaaaa", upstreamSubscriptionID: "0xbbbb"}
+	data := []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xbbbb","result":{"number":"0x1"}}}`)
+	require.Equal(t, `{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xaaaa","result":{"number":"0x1"}}}`, string(stream.translateSubscriptionID(data)))
+
+	// same provider, nothing to translate
+	stream.upstreamSubscriptionID = "0xaaaa"
+	require.Equal(t, string(data), string(stream.translateSubscriptionID(data)))
+}
+
+func TestGetStreamedCu(t *testing.T) {
+	streamedReply := func(streamedCu string) *pairingtypes.RelayReply {
+		return &pairingtypes.RelayReply{Metadata: []pairingtypes.Metadata{{Name: common.STREAMED_CU_HEADER_NAME, Value: streamedCu}}}
+	}
+	playbook := []struct {
+		name           string
+		reply          *pairingtypes.RelayReply
+		lastStreamedCu uint64
+		streamedCu     uint64
+		messageCu      uint64
+		valid          bool
+	}{
+		{name: "first message", reply: streamedReply("10"), lastStreamedCu: 0, streamedCu: 10, messageCu: 10, valid: true},
+		{name: "next message", reply: streamedReply("20"), lastStreamedCu: 10, streamedCu: 20, messageCu: 10, valid: true},
+		{name: "provider charged less", reply: streamedReply("15"), lastStreamedCu: 10, streamedCu: 15, messageCu: 5, valid: true},
+		{name: "provider didn't charge", reply: streamedReply("10"), lastStreamedCu: 10, streamedCu: 10, messageCu: 0, valid: true},
+		{name: "no streamed cu reported", reply: &pairingtypes.RelayReply{}, lastStreamedCu: 10, streamedCu: 20, messageCu: 10, valid: true},
+		{name: "provider charged more than the api cu", reply: streamedReply("30"), lastStreamedCu: 10, valid: false},
+		{name: "streamed cu went back", reply: streamedReply("5"), lastStreamedCu: 10, valid: false},
+		{name: "invalid streamed cu", reply: streamedReply("ten"), lastStreamedCu: 10, valid: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			streamedCu, messageCu, err := getStreamedCu(play.reply, play.lastStreamedCu, 10)
+			if !play.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.streamedCu, streamedCu)
+			require.Equal(t, play.messageCu, messageCu)
+		})
+	}
+}
+
+type mockRelaySubscribeClient struct {
+	pairingtypes.Relayer_RelaySubscribeClient
+	replies chan *pairingtypes.RelayReply
+}
+
+func (m *mockRelaySubscribeClient) Recv() (*pairingtypes.RelayReply, error) {
+	reply, ok := <-m.replies
+	if !ok {
+		return nil, io.EOF
+	}
+	return reply, nil
+}
+
+func TestSharedSubscriptionFanOut(t *testing.T) {
+	activeSubscriptions := NewActiveSubscriptions()
+	shared, created := activeSubscriptions.getOrCreateShared("hash")
+	require.True(t, created)
+	_, created = activeSubscriptions.getOrCreateShared("hash")
+	require.False(t, created)
+
+	upstreamCtx, upstreamCancel := context.WithCancel(context.Background())
+	upstream := &mockRelaySubscribeClient{replies: make(chan *pairingtypes.RelayReply, 10)}
+	shared.cancel = upstreamCancel
+	// no failovers so a broken upstream ends the shared subscription
+	shared.stream = &consumerSubscriptionStream{Relayer_RelaySubscribeClient: upstream, ctx: upstreamCtx, failovers: MaxSubscriptionFailovers}
+	close(shared.ready)
+
+	identityFormatter := func(data []byte) []byte { return data }
+	ctx1, cancel1 := context.WithCancel(context.Background())
+	client1, attached := activeSubscriptions.attach(ctx1, shared, identityFormatter)
+	require.True(t, attached)
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	client2, attached := activeSubscriptions.attach(ctx2, shared, func(data []byte) []byte { return []byte(`{"id":2}`) })
+	require.True(t, attached)
+	go activeSubscriptions.fanOut(shared)
+
+	upstream.replies <- &pairingtypes.RelayReply{Data: []byte(`{"params":{"subscription":"0x1"}}`)}
+	reply, err := client1.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"params":{"subscription":"0x1"}}`, string(reply.Data))
+	reply, err = client2.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"params":{"subscription":"0x1"}}`, string(reply.Data))
+
+	// replies carrying an id are formatted per client
+	upstream.replies <- &pairingtypes.RelayReply{Data: []byte(`{"id":1}`)}
+	reply, err = client2.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"id":2}`, string(reply.Data))
+
+	// first client leaves, upstream stays open for the second
+	cancel1()
+	activeSubscriptions.detach(shared, client1)
+	require.NoError(t, upstreamCtx.Err())
+	_, found := activeSubscriptions.shared["hash"]
+	require.True(t, found)
+
+	// last client leaves, upstream is torn down
+	activeSubscriptions.detach(shared, client2)
+	require.Error(t, upstreamCtx.Err())
+	_, found = activeSubscriptions.shared["hash"]
+	require.False(t, found)
+	_, attached = activeSubscriptions.attach(context.Background(), shared, identityFormatter)
+	require.False(t, attached)
+}
+
+func TestSharedSubscriptionUpstreamEnds(t *testing.T) {
+	activeSubscriptions := NewActiveSubscriptions()
+	shared, _ := activeSubscriptions.getOrCreateShared("hash")
+	upstreamCtx, upstreamCancel := context.WithCancel(context.Background())
+	upstream := &mockRelaySubscribeClient{replies: make(chan *pairingtypes.RelayReply)}
+	shared.cancel = upstreamCancel
+	shared.stream = &consumerSubscriptionStream{Relayer_RelaySubscribeClient: upstream, ctx: upstreamCtx, failovers: MaxSubscriptionFailovers}
+	close(shared.ready)
+	client, attached := activeSubscriptions.attach(context.Background(), shared, func(data []byte) []byte { return data })
+	require.True(t, attached)
+	go activeSubscriptions.fanOut(shared)
+
+	close(upstream.replies)
+	_, err := client.Recv()
+	require.Error(t, err)
+	require.Eventually(t, func() bool { return upstreamCtx.Err() != nil }, time.Second, 10*time.Millisecond)
+}
+
+// the first client is attached before the fan out starts, a reply the provider sends right away reaches it
+func TestSharedSubscriptionImmediateReply(t *testing.T) {
+	activeSubscriptions := NewActiveSubscriptions()
+	shared, _ := activeSubscriptions.getOrCreateShared("hash")
+	upstreamCtx, upstreamCancel := context.WithCancel(context.Background())
+	defer upstreamCancel()
+	upstream := &mockRelaySubscribeClient{replies: make(chan *pairingtypes.RelayReply, 1)}
+	upstream.replies <- &pairingtypes.RelayReply{Data: []byte(`{"params":{"subscription":"0x1"}}`)}
+	shared.cancel = upstreamCancel
+	shared.stream = &consumerSubscriptionStream{Relayer_RelaySubscribeClient: upstream, ctx: upstreamCtx, failovers: MaxSubscriptionFailovers}
+	client, attached := activeSubscriptions.attach(context.Background(), shared, func(data []byte) []byte { return data })
+	require.True(t, attached)
+	go activeSubscriptions.fanOut(shared)
+	close(shared.ready)
+
+	reply, err := client.Recv()
+	require.NoError(t, err)
+	require.Equal(t, `{"params":{"subscription":"0x1"}}`, string(reply.Data))
+	require.NoError(t, upstreamCtx.Err())
+}
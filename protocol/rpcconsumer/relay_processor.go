@@ -39,6 +39,7 @@ func NewRelayProcessor(ctx context.Context, usedProviders *lavasession.UsedProvi
 	return &RelayProcessor{
 		usedProviders:          usedProviders,
 		requiredSuccesses:      requiredSuccesses,
+		quorumSize:             requiredSuccesses/2 + 1,                     // a majority of the required successes must agree
 		responses:              make(chan *relayResponse, MaxCallsPerRelay), // we set it as buffered so it is not blocking
 		nodeResponseErrors:     RelayErrors{relayErrors: []RelayError{}},
 		protocolResponseErrors: RelayErrors{relayErrors: []RelayError{}, onFailureMergeAll: true},
@@ -55,6 +56,7 @@ type RelayProcessor struct {
 	usedProviders          *lavasession.UsedProviders
 	responses              chan *relayResponse
 	requiredSuccesses      int
+	quorumSize             int
 	nodeResponseErrors     RelayErrors
 	protocolResponseErrors RelayErrors
 	successResults         []common.RelayResult
@@ -65,6 +67,8 @@ type RelayProcessor struct {
 	consumerConsistency    *ConsumerConsistency
 	dappID                 string
 	consumerIp             string
+	hedgedRelaysCtx        context.Context // the relays of a hedged request are cancelled once it is answered
+	cancelHedgedRelays     context.CancelFunc
 }
 
 func (rp *RelayProcessor) String() string {
@@ -84,6 +88,74 @@ func (rp *RelayProcessor) String() string {
 		results, nodeErrors, protocolErrors, strings.Join(unwantedAddresses, ";"), strings.Join(currentlyUsedAddresses, ";"))
 }
 
+// sets how many matching results are needed out of the required successes
+func (rp *RelayProcessor) SetQuorumSize(quorumSize int) {
+	if rp == nil {
+		return
+	}
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	if quorumSize <= 0 || quorumSize > rp.requiredSuccesses {
+		utils.LavaFormatWarning("invalid quorum size, keeping the default", nil, utils.LogAttr("quorumSize", quorumSize), utils.LogAttr("requiredSuccesses", rp.requiredSuccesses), utils.LogAttr("GUID", rp.guid))
+		return
+	}
+	rp.quorumSize = quorumSize
+}
+
+// returns how many more node results are needed, this is how many providers a new batch should be sent to
+func (rp *RelayProcessor) MissingResults() int {
+	if rp == nil {
+		return 0
+	}
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	missing := rp.requiredSuccesses - len(rp.successResults)
+	if rp.selection == Quorum {
+		missing -= len(rp.nodeResponseErrors.relayErrors)
+	}
+	if missing < 1 {
+		missing = 1
+	}
+	return missing
+}
+
+// the relays sent after this call are cancelled by CancelHedgedRelays
+func (rp *RelayProcessor) EnableHedging() {
+	if rp == nil {
+		return
+	}
+	rp.lock.Lock()
+	defer rp.lock.Unlock()
+	if rp.hedgedRelaysCtx == nil {
+		rp.hedgedRelaysCtx, rp.cancelHedgedRelays = context.WithCancel(context.Background())
+	}
+}
+
+// the parent context for the relays sent to providers, relays don't depend on the user's context so we can use their responses after returning
+func (rp *RelayProcessor) RelaysContext() context.Context {
+	if rp == nil {
+		return context.Background()
+	}
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	if rp.hedgedRelaysCtx == nil {
+		return context.Background()
+	}
+	return rp.hedgedRelaysCtx
+}
+
+// cancels the relays that are still in flight when a hedged request got its response
+func (rp *RelayProcessor) CancelHedgedRelays() {
+	if rp == nil {
+		return
+	}
+	rp.lock.RLock()
+	defer rp.lock.RUnlock()
+	if rp.cancelHedgedRelays != nil {
+		rp.cancelHedgedRelays()
+	}
+}
+
 func (rp *RelayProcessor) GetUsedProviders() *lavasession.UsedProviders {
 	if rp == nil {
 		utils.LavaFormatError("RelayProcessor.GetUsedProviders is nil, misuse detected", nil)
@@ -363,19 +435,26 @@ func (rp *RelayProcessor) ProcessingResult() (returnedResult *common.RelayResult
 	// there are enough successes
 	successResultsCount := len(rp.successResults)
 	if successResultsCount >= rp.requiredSuccesses {
-		return rp.responsesQuorum(rp.successResults, rp.requiredSuccesses)
+		return rp.responsesQuorum(rp.successResults, rp.quorumSize)
 	}
 	nodeResults := rp.nodeResultsInner()
 	// there are not enough successes, let's check if there are enough node errors
 
 	if len(nodeResults) >= rp.requiredSuccesses {
 		if rp.selection == Quorum {
-			return rp.responsesQuorum(nodeResults, rp.requiredSuccesses)
+			return rp.responsesQuorum(nodeResults, rp.quorumSize)
 		} else if rp.selection == BestResult && successResultsCount > len(rp.nodeResponseErrors.relayErrors) {
 			// we have more than half succeeded, and we are success oriented
 			return rp.responsesQuorum(rp.successResults, (rp.requiredSuccesses+1)/2)
 		}
 	}
+	// not all of the providers answered, but a majority can still agree
+	if rp.quorumSize < rp.requiredSuccesses && len(nodeResults) >= rp.quorumSize && rp.selection == Quorum {
+		result, err := rp.responsesQuorum(nodeResults, rp.quorumSize)
+		if err == nil {
+			return result, nil
+		}
+	}
 	// we don't have enough for a quorum, prefer a node error on protocol errors
 	if len(rp.nodeResponseErrors.relayErrors) >= rp.requiredSuccesses { // if we have node errors, we prefer returning them over protocol errors.
 		nodeErr := rp.nodeResponseErrors.GetBestErrorMessageForUser()
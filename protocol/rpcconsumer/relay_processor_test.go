@@ -387,3 +387,82 @@ func TestRelayProcessorLatest(t *testing.T) {
 		// require.NotEqual(t, spectypes.LATEST_BLOCK, reqBlock) // disabled until we enable requested block modification again
 	})
 }
+
+func sendSuccessRespWithData(relayProcessor *RelayProcessor, provider string, delay time.Duration, data string) {
+	time.Sleep(delay)
+	relayProcessor.GetUsedProviders().RemoveUsed(provider, nil)
+	response := &relayResponse{
+		relayResult: common.RelayResult{
+			Request: &pairingtypes.RelayRequest{
+				RelaySession: &pairingtypes.RelaySession{},
+				RelayData:    &pairingtypes.RelayPrivateData{},
+			},
+			Reply:        &pairingtypes.RelayReply{Data: []byte(data)},
+			ProviderInfo: common.ProviderInfo{ProviderAddress: provider},
+			StatusCode:   http.StatusOK,
+		},
+		err: nil,
+	}
+	relayProcessor.SetResponse(response)
+}
+
+func TestRelayProcessorQuorum(t *testing.T) {
+	playbook := []struct {
+		name          string
+		responses     map[string]string
+		protocolError bool
+		expected      string
+		quorum        int
+	}{
+		{name: "all agree", responses: map[string]string{"lava@test": "ok", "lava@test2": "ok", "lava@test3": "ok"}, expected: "ok", quorum: 3},
+		{name: "majority agrees", responses: map[string]string{"lava@test": "ok", "lava@test2": "bad", "lava@test3": "ok"}, expected: "ok", quorum: 2},
+		{name: "no majority", responses: map[string]string{"lava@test": "ok", "lava@test2": "bad", "lava@test3": "other"}, expected: ""},
+		{name: "majority with a protocol error", responses: map[string]string{"lava@test": "ok", "lava@test2": "ok"}, protocolError: true, expected: "ok", quorum: 2},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			ctx := context.Background()
+			serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Handle the incoming request and provide the desired response
+				w.WriteHeader(http.StatusOK)
+			})
+			specId := "LAV1"
+			chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, specId, spectypes.APIInterfaceRest, serverHandler, "../../", nil)
+			if closeServer != nil {
+				defer closeServer()
+			}
+			require.NoError(t, err)
+			chainMsg, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+			require.NoError(t, err)
+			relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(nil), 3, chainMsg, nil, "", "")
+			require.Equal(t, 3, relayProcessor.MissingResults())
+
+			usedProviders := relayProcessor.GetUsedProviders()
+			// a quorum batch is built from several selections
+			usedProviders.StartBatch()
+			usedProviders.AddUsed(lavasession.ConsumerSessionsMap{"lava@test": &lavasession.SessionInfo{}, "lava@test2": &lavasession.SessionInfo{}}, nil)
+			usedProviders.AddUsed(lavasession.ConsumerSessionsMap{"lava@test3": &lavasession.SessionInfo{}}, nil)
+			usedProviders.EndBatch()
+			require.Equal(t, 3, usedProviders.SessionsLatestBatch())
+
+			for provider, data := range play.responses {
+				go sendSuccessRespWithData(relayProcessor, provider, time.Millisecond*5, data)
+			}
+			if play.protocolError {
+				go sendProtocolError(relayProcessor, "lava@test3", time.Millisecond*5, fmt.Errorf("bad"))
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*200)
+			defer cancel()
+			err = relayProcessor.WaitForResults(ctx)
+			require.NoError(t, err)
+			returnedResult, err := relayProcessor.ProcessingResult()
+			if play.expected == "" {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.expected, string(returnedResult.Reply.Data))
+			require.Equal(t, play.quorum, returnedResult.Quorum)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"sync"
@@ -43,6 +44,16 @@ const (
 	refererBackendAddressFlagName = "referer-be-address"
 	refererMarkerFlagName         = "referer-marker"
 	reportsSendBEAddress          = "reports-be-address"
+	ApiKeysConfigName             = "api-keys" // a list of api keys in the config file, each with a name, key and optional limits
+	ApiKeyRequestsLimitFlagName   = "api-key-requests-limit"
+	ApiKeyCuLimitFlagName         = "api-key-cu-limit"
+	IpRequestsLimitFlagName       = "ip-requests-limit"
+	IpCuLimitFlagName             = "ip-cu-limit"
+	IpTrustedProxiesFlagName      = "ip-trusted-proxies"
+	SecureFlagName                = "secure"
+	QuorumSizeFlagName            = "quorum-size"
+	OptimizerSnapshotDirFlagName  = "optimizer-snapshot-dir"
+	DefaultQuorumSize             = 3
 )
 
 var (
@@ -118,6 +129,8 @@ type rpcConsumerStartOptions struct {
 	cmdFlags                  common.ConsumerCmdFlags
 	stateShare                bool
 	refererData               *chainlib.RefererData
+	accessControlConfig       chainlib.ConsumerAccessControlConfig
+	optimizerSnapshotDir      string
 }
 
 // spawns a new RPCConsumer server with all it's processes and internals ready for communications
@@ -134,6 +147,10 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 		utils.LavaFormatFatal("failed creating RPCConsumer logs", err)
 	}
 	consumerMetricsManager.SetVersion(upgrade.GetCurrentVersion().ConsumerVersion)
+	accessControl, err := chainlib.NewConsumerAccessControl(options.accessControlConfig, consumerMetricsManager)
+	if err != nil {
+		utils.LavaFormatFatal("invalid api keys configuration", err)
+	}
 
 	// spawn up ConsumerStateTracker
 	lavaChainFetcher := chainlib.NewLavaChainFetcher(ctx, options.clientCtx)
@@ -230,6 +247,14 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 					// doesn't exist for this chain create a new one
 					baseLatency := common.AverageWorldLatency / 2 // we want performance to be half our timeout or better
 					optimizer = provideroptimizer.NewProviderOptimizer(options.strategy, averageBlockTime, baseLatency, options.maxConcurrentProviders)
+					if options.optimizerSnapshotDir != "" {
+						// restore the provider scores from the previous run so we don't start cold
+						snapshotPath := filepath.Join(options.optimizerSnapshotDir, chainID+".json")
+						persistErr := optimizer.PersistToFile(ctx, snapshotPath, provideroptimizer.SNAPSHOT_INTERVAL)
+						if persistErr != nil {
+							utils.LavaFormatError("failed persisting optimizer scores, continuing without it", persistErr, utils.Attribute{Key: "endpoint", Value: rpcEndpoint.Key()})
+						}
+					}
 					optimizers.Store(chainID, optimizer)
 				} else {
 					var ok bool
@@ -279,6 +304,10 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 				errCh <- err
 				return err
 			}
+			if options.strategy == provideroptimizer.STRATEGY_ACCURACY {
+				// prefer the providers that agree the most with the others on finalized blocks
+				optimizer.SetAgreementTracker(finalizationConsensus)
+			}
 
 			// Register For Updates
 			consumerSessionManager := lavasession.NewConsumerSessionManager(rpcEndpoint, optimizer, consumerMetricsManager, consumerReportsManager)
@@ -291,7 +320,7 @@ func (rpcc *RPCConsumer) Start(ctx context.Context, options *rpcConsumerStartOpt
 			}
 			rpcConsumerServer := &RPCConsumerServer{}
 			utils.LavaFormatInfo("RPCConsumer Listening", utils.Attribute{Key: "endpoints", Value: rpcEndpoint.String()})
-			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, options.requiredResponses, privKey, lavaChainID, options.cache, rpcConsumerMetrics, consumerAddr, consumerConsistency, relaysMonitor, options.cmdFlags, options.stateShare, options.refererData, consumerReportsManager)
+			err = rpcConsumerServer.ServeRPCRequests(ctx, rpcEndpoint, rpcc.consumerStateTracker, chainParser, finalizationConsensus, consumerSessionManager, options.requiredResponses, privKey, lavaChainID, options.cache, rpcConsumerMetrics, consumerAddr, consumerConsistency, relaysMonitor, options.cmdFlags, options.stateShare, options.refererData, accessControl, consumerReportsManager)
 			if err != nil {
 				err = utils.LavaFormatError("failed serving rpc requests", err, utils.Attribute{Key: "endpoint", Value: rpcEndpoint})
 				errCh <- err
@@ -481,7 +510,15 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			txFactory = txFactory.WithGasAdjustment(viper.GetFloat64(flags.FlagGasAdjustment))
 
 			rpcConsumer := RPCConsumer{}
-			requiredResponses := 1 // TODO: handle secure flag, for a majority between providers
+			requiredResponses := 1
+			if viper.GetBool(SecureFlagName) {
+				// deterministic relays are sent to quorumSize providers and only a majority agreed response is returned
+				requiredResponses = viper.GetInt(QuorumSizeFlagName)
+				if requiredResponses < 2 || requiredResponses > MaxQuorumProviders {
+					utils.LavaFormatFatal("secure mode requires a quorum of at least 2 providers and at most the maximum", nil, utils.LogAttr(QuorumSizeFlagName, requiredResponses), utils.LogAttr("maximum", MaxQuorumProviders))
+				}
+				utils.LavaFormatInfo("Working in secure mode", utils.LogAttr("providers", requiredResponses), utils.LogAttr("agreement", requiredResponses/2+1))
+			}
 			utils.LavaFormatInfo("lavap Binary Version: " + upgrade.GetCurrentVersion().ConsumerVersion)
 			rand.InitRandomSeed()
 
@@ -492,7 +529,7 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 			} else if cacheAddr != "" {
 				cache, err = performance.InitCache(ctx, cacheAddr)
 				if err != nil {
-					utils.LavaFormatError("Failed To Connect to cache at address", err, utils.Attribute{Key: "address", Value: cacheAddr})
+					utils.LavaFormatError("Failed To Connect to cache at address, reconnecting in the background", err, utils.Attribute{Key: "address", Value: cacheAddr})
 				} else {
 					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cacheAddr})
 				}
@@ -515,6 +552,16 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				}
 			}
 
+			accessControlConfig := chainlib.ConsumerAccessControlConfig{
+				DefaultKeyLimits: chainlib.AccessLimits{RequestsPerSecond: viper.GetFloat64(ApiKeyRequestsLimitFlagName), CuPerSecond: viper.GetFloat64(ApiKeyCuLimitFlagName)},
+				IpLimits:         chainlib.AccessLimits{RequestsPerSecond: viper.GetFloat64(IpRequestsLimitFlagName), CuPerSecond: viper.GetFloat64(IpCuLimitFlagName)},
+				TrustedProxies:   viper.GetStringSlice(IpTrustedProxiesFlagName),
+			}
+			err = viper.UnmarshalKey(ApiKeysConfigName, &accessControlConfig.ApiKeys)
+			if err != nil {
+				utils.LavaFormatFatal("failed reading api keys from the config file", err)
+			}
+
 			maxConcurrentProviders := viper.GetUint(common.MaximumConcurrentProvidersFlagName)
 
 			consumerPropagatedFlags := common.ConsumerCmdFlags{
@@ -527,10 +574,11 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 				RelaysHealthIntervalFlag:    viper.GetDuration(common.RelayHealthIntervalFlag),
 				DebugRelays:                 viper.GetBool(DebugRelaysFlagName),
 				DisableConflictTransactions: viper.GetBool(common.DisableConflictTransactionsFlag),
+				HedgePercentile:             viper.GetFloat64(common.HedgePercentileFlag),
 			}
 
 			rpcConsumerSharedState := viper.GetBool(common.SharedStateFlag)
-			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState, refererData})
+			err = rpcConsumer.Start(ctx, &rpcConsumerStartOptions{txFactory, clientCtx, rpcEndpoints, requiredResponses, cache, strategyFlag.Strategy, maxConcurrentProviders, analyticsServerAddressess, consumerPropagatedFlags, rpcConsumerSharedState, refererData, accessControlConfig, viper.GetString(OptimizerSnapshotDirFlagName)})
 			return err
 		},
 	}
@@ -541,15 +589,18 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().Uint64(common.GeolocationFlag, 0, "geolocation to run from")
 	cmdRPCConsumer.Flags().Uint(common.MaximumConcurrentProvidersFlagName, 3, "max number of concurrent providers to communicate with")
 	cmdRPCConsumer.MarkFlagRequired(common.GeolocationFlag)
-	cmdRPCConsumer.Flags().Bool("secure", false, "secure sends reliability on every message")
+	cmdRPCConsumer.Flags().Bool(SecureFlagName, false, "secure sends reliability on every message, deterministic relays are sent to several providers and only a majority agreed response is returned")
+	cmdRPCConsumer.Flags().Int(QuorumSizeFlagName, DefaultQuorumSize, "number of providers each deterministic relay is sent to in secure mode, a majority of them must agree on the response")
 	cmdRPCConsumer.Flags().Bool(lavasession.AllowInsecureConnectionToProvidersFlag, false, "allow insecure provider-dialing. used for development and testing")
 	cmdRPCConsumer.Flags().Bool(common.TestModeFlagName, false, "test mode causes rpcconsumer to send dummy data and print all of the metadata in it's listeners")
 	cmdRPCConsumer.Flags().String(performance.PprofAddressFlagName, "", "pprof server address, used for code profiling")
 	cmdRPCConsumer.Flags().String(performance.CacheFlagName, "", "address for a cache server to improve performance")
+	cmdRPCConsumer.Flags().String(OptimizerSnapshotDirFlagName, "", "a directory to save the provider optimizer scores to periodically, they are restored on startup so a restart doesn't start with no information on providers")
 	cmdRPCConsumer.Flags().Var(&strategyFlag, "strategy", fmt.Sprintf("the strategy to use to pick providers (%s)", strings.Join(strategyNames, "|")))
 	cmdRPCConsumer.Flags().String(metrics.MetricsListenFlagName, metrics.DisabledFlagOption, "the address to expose prometheus metrics (such as localhost:7779)")
 	cmdRPCConsumer.Flags().String(metrics.RelayServerFlagName, metrics.DisabledFlagOption, "the http address of the relay usage server api endpoint (example http://127.0.0.1:8080)")
 	cmdRPCConsumer.Flags().Bool(DebugRelaysFlagName, false, "adding debug information to relays")
+	cmdRPCConsumer.Flags().Float64(common.HedgePercentileFlag, 0, "send deterministic reads to the next best provider as well when the provider didn't answer by this percentile of its expected latency (between 0 and 1, e.g 0.95), the first response is used. the consumer may pay both providers for a hedged read. 0 disables hedging")
 	// CORS related flags
 	cmdRPCConsumer.Flags().String(common.CorsCredentialsFlag, "true", "Set up CORS allowed credentials,default \"true\"")
 	cmdRPCConsumer.Flags().String(common.CorsHeadersFlag, "", "Set up CORS allowed headers, * for all, default simple cors specification headers")
@@ -563,9 +614,15 @@ rpcconsumer consumer_examples/full_consumer_example.yml --cache-be "127.0.0.1:77
 	cmdRPCConsumer.Flags().String(refererBackendAddressFlagName, "", "address to send referer to")
 	cmdRPCConsumer.Flags().String(refererMarkerFlagName, "lava-referer-", "the string marker to identify referer")
 	cmdRPCConsumer.Flags().String(reportsSendBEAddress, "", "address to send reports to")
+	cmdRPCConsumer.Flags().Float64(ApiKeyRequestsLimitFlagName, 0, "the requests per second allowed for each api key without limits of its own, 0 disables the limit (api keys are set in the config file under "+ApiKeysConfigName+")")
+	cmdRPCConsumer.Flags().Float64(ApiKeyCuLimitFlagName, 0, "the compute units per second allowed for each api key without limits of its own, 0 disables the limit")
+	cmdRPCConsumer.Flags().Float64(IpRequestsLimitFlagName, 0, "the requests per second allowed for each client ip, 0 disables the limit")
+	cmdRPCConsumer.Flags().Float64(IpCuLimitFlagName, 0, "the compute units per second allowed for each client ip, 0 disables the limit")
+	cmdRPCConsumer.Flags().StringSlice(IpTrustedProxiesFlagName, []string{}, "ips or cidrs of the proxies in front of the consumer, the client ip of the ip limits is taken from the "+common.IP_FORWARDING_HEADER_NAME+" header only for requests from these proxies")
 	cmdRPCConsumer.Flags().BoolVar(&lavasession.DebugProbes, DebugProbesFlagName, false, "adding information to probes")
 	cmdRPCConsumer.Flags().Bool(common.DisableConflictTransactionsFlag, false, "disabling conflict transactions, this flag should not be used as it harms the network's data reliability and therefore the service.")
 	cmdRPCConsumer.Flags().DurationVar(&updaters.TimeOutForFetchingLavaBlocks, common.TimeOutForFetchingLavaBlocksFlag, time.Second*5, "setting the timeout for fetching lava blocks")
+	cmdRPCConsumer.Flags().BoolVar(&updaters.EventStreamEnabled, common.LavaEventStreamFlag, false, "read the lava events from the node's websocket event stream instead of polling the results of every block, missed blocks are still polled")
 
 	common.AddRollingLogConfig(cmdRPCConsumer)
 	return cmdRPCConsumer
@@ -1,8 +1,11 @@
 package rpcconsumer
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -18,7 +21,9 @@ import (
 	"github.com/lavanet/lava/protocol/lavasession"
 	"github.com/lavanet/lava/protocol/metrics"
 	"github.com/lavanet/lava/protocol/performance"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
 	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/lavaslices"
 	"github.com/lavanet/lava/utils/protocopy"
 	"github.com/lavanet/lava/utils/rand"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
@@ -30,7 +35,10 @@ import (
 )
 
 const (
-	MaxRelayRetries = 6
+	MaxRelayRetries    = 6
+	MaxQuorumProviders = 10
+	// the accuracy strategy compares the responses of this many providers on deterministic relays
+	AccuracyQuorumProviders = 3
 )
 
 var NoResponseTimeout = sdkerrors.New("NoResponseTimeout Error", 685, "timeout occurred while waiting for providers responses")
@@ -53,6 +61,9 @@ type RPCConsumerServer struct {
 	relaysMonitor          *metrics.RelaysMonitor
 	reporter               metrics.Reporter
 	debugRelays            bool
+	activeSubscriptions    *ActiveSubscriptions
+	strategy               provideroptimizer.Strategy
+	hedgePercentile        float64
 }
 
 type relayResponse struct {
@@ -82,6 +93,7 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	cmdFlags common.ConsumerCmdFlags,
 	sharedState bool,
 	refererData *chainlib.RefererData,
+	accessControl *chainlib.ConsumerAccessControl,
 	reporter metrics.Reporter,
 ) (err error) {
 	rpccs.consumerSessionManager = consumerSessionManager
@@ -99,7 +111,10 @@ func (rpccs *RPCConsumerServer) ServeRPCRequests(ctx context.Context, listenEndp
 	rpccs.sharedState = sharedState
 	rpccs.reporter = reporter
 	rpccs.debugRelays = cmdFlags.DebugRelays
-	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpccs, rpcConsumerLogs, chainParser, refererData)
+	rpccs.activeSubscriptions = NewActiveSubscriptions()
+	rpccs.strategy = consumerSessionManager.Strategy()
+	rpccs.hedgePercentile = cmdFlags.HedgePercentile
+	chainListener, err := chainlib.NewChainListener(ctx, listenEndpoint, rpccs, rpccs, rpcConsumerLogs, chainParser, refererData, accessControl)
 	if err != nil {
 		return err
 	}
@@ -271,15 +286,17 @@ func (rpccs *RPCConsumerServer) SendRelay(
 
 	// remove lava directive headers
 	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders(metadata)
+	if rpccs.strategy == provideroptimizer.STRATEGY_PRIVACY {
+		metadata = stripIdentifyingMetadata(metadata)
+	}
 	relaySentTime := time.Now()
 	chainMessage, err := rpccs.chainParser.ParseMsg(url, []byte(req), connectionType, metadata, rpccs.getExtensionsFromDirectiveHeaders(directiveHeaders))
 	if err != nil {
 		return nil, err
 	}
-	// temporarily disable subscriptions
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		return &common.RelayResult{ProviderInfo: common.ProviderInfo{ProviderAddress: ""}}, utils.LavaFormatError("Subscriptions are not supported at the moment", nil)
+	if chainlib.IsUnsubscribe(chainMessage) {
+		// subscriptions are held by the consumer, so unsubscribing is done by closing the provider's stream
+		return rpccs.handleUnsubscribe(ctx, chainMessage, req, dappID, consumerIp)
 	}
 
 	rpccs.HandleDirectiveHeadersForMessage(chainMessage, directiveHeaders)
@@ -291,14 +308,30 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 	relayRequestData := lavaprotocol.NewRelayData(ctx, connectionType, url, []byte(req), seenBlock, reqBlock, rpccs.listenEndpoint.ApiInterface, chainMessage.GetRPCMessage().GetHeaders(), chainlib.GetAddon(chainMessage), common.GetExtensionNames(chainMessage.GetExtensions()))
 
+	if chainlib.IsSubscription(chainMessage) {
+		relayResult, err := rpccs.sendSubscriptionRelay(ctx, directiveHeaders, chainMessage, relayRequestData, dappID, consumerIp)
+		if err != nil {
+			return relayResult, err
+		}
+		rpccs.appendHeadersToRelayResult(ctx, relayResult, 0)
+		if analytics != nil {
+			analytics.Latency = time.Since(relaySentTime).Milliseconds()
+			analytics.ComputeUnits = chainMessage.GetApi().ComputeUnits
+		}
+		rpccs.relaysMonitor.LogRelay()
+		return relayResult, nil
+	}
+
 	relayProcessor, err := rpccs.ProcessRelaySend(ctx, directiveHeaders, chainMessage, relayRequestData, dappID, consumerIp)
 	if err != nil && !relayProcessor.HasResults() {
 		// we can't send anymore, and we don't have any responses
 		return nil, utils.LavaFormatError("failed getting responses from providers", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.LogAttr("endpoint", rpccs.listenEndpoint.Key()))
 	}
+	// a quorum relay already compared several providers, so disagreements are reported instead of sending data reliability
+	quorumRelay := relayProcessor.requiredSuccesses > 1
 	// Handle Data Reliability
 	enabled, dataReliabilityThreshold := rpccs.chainParser.DataReliabilityParams()
-	if enabled {
+	if enabled && !quorumRelay && rpccs.dataReliabilityAllowed() {
 		// new context is needed for data reliability as some clients cancel the context they provide when the relay returns
 		// as data reliability happens in a go routine it will continue while the response returns.
 		guid, found := utils.GetUniqueIdentifier(ctx)
@@ -310,7 +343,22 @@ func (rpccs *RPCConsumerServer) SendRelay(
 	}
 
 	returnedResult, err := relayProcessor.ProcessingResult()
+	if quorumRelay {
+		guid, found := utils.GetUniqueIdentifier(ctx)
+		conflictsContext := context.Background()
+		if found {
+			conflictsContext = utils.WithUniqueIdentifier(conflictsContext, guid)
+		}
+		agreedProvider := ""
+		if err == nil && returnedResult != nil {
+			agreedProvider = returnedResult.ProviderInfo.ProviderAddress
+		}
+		go rpccs.reportQuorumConflicts(conflictsContext, chainMessage, agreedProvider, relayProcessor) // runs asynchronously
+	}
 	rpccs.appendHeadersToRelayResult(ctx, returnedResult, relayProcessor.ProtocolErrors())
+	if quorumRelay {
+		rpccs.appendQuorumHeadersToRelayResult(returnedResult, relayProcessor)
+	}
 	if err != nil {
 		return returnedResult, utils.LavaFormatError("failed processing responses from providers", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.LogAttr("endpoint", rpccs.listenEndpoint.Key()))
 	}
@@ -327,8 +375,20 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 	// make sure all of the child contexts are cancelled when we exit
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(directiveHeaders), rpccs.requiredResponses, chainMessage, rpccs.consumerConsistency, dappID, consumerIp)
-	err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+	requiredSuccesses, quorumSize, err := rpccs.getQuorumForMessage(chainMessage, directiveHeaders)
+	if err != nil {
+		return nil, err
+	}
+	relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(directiveHeaders), requiredSuccesses, chainMessage, rpccs.consumerConsistency, dappID, consumerIp)
+	relayProcessor.SetQuorumSize(quorumSize)
+	hedging := rpccs.hedgingAllowed(chainMessage, relayProcessor)
+	if hedging {
+		// must be set before the first relay is sent so it can be cancelled when the hedged relay wins
+		relayProcessor.EnableHedging()
+		// the losers of a hedged request are not needed once we return
+		defer relayProcessor.CancelHedgedRelays()
+	}
+	err = rpccs.sendRelayBatch(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
 	if err != nil && relayProcessor.usedProviders.CurrentlyUsed() == 0 {
 		// we failed to send a batch of relays, if there are no active sends we can terminate
 		return relayProcessor, err
@@ -336,6 +396,16 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 	// a channel to be notified processing was done, true means we have results and can return
 	gotResults := make(chan bool)
 	processingTimeout, relayTimeout := rpccs.getProcessingTimeout(chainMessage)
+	// a slow provider gets a duplicate of the relay sent to the next provider, the first response is returned.
+	// both relays are signed when they are sent so both providers may claim their cu, even the one that is cancelled
+	var hedgeTimer <-chan time.Time
+	if hedging {
+		if hedgeDelay, ok := rpccs.getHedgeDelay(chainMessage, relayProcessor, relayTimeout); ok {
+			hedgeDelayTimer := time.NewTimer(hedgeDelay)
+			defer hedgeDelayTimer.Stop()
+			hedgeTimer = hedgeDelayTimer.C
+		}
+	}
 
 	readResultsFromProcessor := func() {
 		processingCtx, cancel := context.WithTimeout(ctx, processingTimeout)
@@ -358,16 +428,23 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 			if success {
 				return relayProcessor, nil
 			}
-			err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+			err := rpccs.sendRelayBatch(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
 			if err != nil && relayProcessor.usedProviders.CurrentlyUsed() == 0 {
 				// we failed to send a batch of relays, if there are no active sends we can terminate
 				return relayProcessor, err
 			}
 			go readResultsFromProcessor()
+		case <-hedgeTimer:
+			hedgeTimer = nil // hedge only once
+			err := rpccs.sendRelayBatch(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+			if err != nil {
+				// the original relay is still in flight
+				utils.LavaFormatDebug("failed sending hedged relay", utils.LogAttr("GUID", ctx), utils.LogAttr("error", err))
+			}
 		case <-startNewBatchTicker.C:
 			// only trigger another batch for non BestResult relays
 			if relayProcessor.selection != BestResult {
-				err := rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+				err := rpccs.sendRelayBatch(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
 				if err != nil && relayProcessor.usedProviders.CurrentlyUsed() == 0 {
 					// we failed to send a batch of relays, if there are no active sends we can terminate
 					return relayProcessor, err
@@ -377,6 +454,93 @@ func (rpccs *RPCConsumerServer) ProcessRelaySend(ctx context.Context, directiveH
 	}
 }
 
+// returns how many providers a relay is sent to and how many of them need to agree on the response
+// deterministic relays need a quorum in secure mode or when the quorum directive is used, all other relays are answered by a single provider
+// the quorum directive can't lower the secure mode quorum, an invalid quorum directive fails the relay
+func (rpccs *RPCConsumerServer) getQuorumForMessage(chainMessage chainlib.ChainMessage, directiveHeaders map[string]string) (requiredSuccesses int, quorumSize int, err error) {
+	if !chainMessage.GetApi().Category.Deterministic || chainlib.GetStateful(chainMessage) == common.CONSISTENCY_SELECT_ALL_PROVIDERS {
+		return 1, 1, nil
+	}
+	requiredSuccesses = 1
+	if rpccs.requiredResponses > 1 {
+		requiredSuccesses = rpccs.requiredResponses
+	}
+	if rpccs.strategy == provideroptimizer.STRATEGY_ACCURACY && requiredSuccesses < AccuracyQuorumProviders {
+		requiredSuccesses = AccuracyQuorumProviders
+	}
+	quorumSize = requiredSuccesses/2 + 1
+	quorumStr, ok := directiveHeaders[common.QUORUM_HEADER_NAME]
+	if !ok {
+		return requiredSuccesses, quorumSize, nil
+	}
+	directiveQuorum, directiveProviders, err := parseQuorumDirective(quorumStr)
+	if err != nil {
+		return 0, 0, utils.LavaFormatWarning("invalid quorum directive", err, utils.LogAttr("value", quorumStr))
+	}
+	utils.LavaFormatDebug("User indicated to set the quorum using header", utils.LogAttr("quorum", quorumStr))
+	if directiveProviders > requiredSuccesses {
+		requiredSuccesses = directiveProviders
+	}
+	if directiveQuorum > quorumSize {
+		quorumSize = directiveQuorum
+	}
+	return requiredSuccesses, quorumSize, nil
+}
+
+// parses a quorum directive in the form of "N/M" for N matching responses out of M providers, or "M" for a majority of M providers.
+// N must be a majority of M, otherwise two different responses could both reach the quorum
+func parseQuorumDirective(quorumStr string) (quorumSize int, providers int, err error) {
+	quorumPart, providersPart, hasQuorum := strings.Cut(strings.TrimSpace(quorumStr), "/")
+	if !hasQuorum {
+		providersPart = quorumPart
+	}
+	providers, err = strconv.Atoi(strings.TrimSpace(providersPart))
+	if err != nil {
+		return 0, 0, err
+	}
+	quorumSize = providers/2 + 1
+	if hasQuorum {
+		quorumSize, err = strconv.Atoi(strings.TrimSpace(quorumPart))
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	if providers <= 0 || providers > MaxQuorumProviders || quorumSize > providers || 2*quorumSize <= providers {
+		return 0, 0, fmt.Errorf("quorum must be N/M with M/2 < N <= M <= %d", MaxQuorumProviders)
+	}
+	return quorumSize, providers, nil
+}
+
+// sends a batch of relays, when a quorum is required the batch is sent to as many providers as there are missing results
+func (rpccs *RPCConsumerServer) sendRelayBatch(
+	ctx context.Context,
+	chainMessage chainlib.ChainMessage,
+	relayRequestData *pairingtypes.RelayPrivateData,
+	dappID string,
+	consumerIp string,
+	relayProcessor *RelayProcessor,
+) error {
+	if relayProcessor.requiredSuccesses <= 1 {
+		return rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+	}
+	usedProviders := relayProcessor.GetUsedProviders()
+	usedProviders.StartBatch()
+	defer usedProviders.EndBatch()
+	missingResults := relayProcessor.MissingResults()
+	var err error
+	// every selection picks at least one new provider, providers that are already in flight count towards the missing results
+	for attempt := 0; attempt < missingResults && usedProviders.CurrentlyUsed() < missingResults; attempt++ {
+		err = rpccs.sendRelayToProvider(ctx, chainMessage, relayRequestData, dappID, consumerIp, relayProcessor)
+		if err != nil {
+			if usedProviders.CurrentlyUsed() > 0 {
+				utils.LavaFormatWarning("could not send relay to all of the quorum providers", err, utils.LogAttr("GUID", ctx), utils.LogAttr("missingResults", missingResults), utils.LogAttr("relayProcessor", relayProcessor))
+			}
+			break
+		}
+	}
+	return err
+}
+
 func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	ctx context.Context,
 	chainMessage chainlib.ChainMessage,
@@ -396,11 +560,9 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// if necessary send detection tx for hashes consensus mismatch
 	// handle QoS updates
 	// in case connection totally fails, update unresponsive providers in ConsumerSessionManager
-	isSubscription := chainlib.IsSubscription(chainMessage)
-	if isSubscription {
-		// temporarily disable subscriptions
-		// TODO: fix subscription and disable this case.
-		return utils.LavaFormatError("Subscriptions are disabled currently", nil)
+	if chainlib.IsSubscription(chainMessage) {
+		// subscriptions hold a stream to a single provider and are handled by sendSubscriptionRelay
+		return utils.LavaFormatError("subscription messages can't be sent as regular relays", nil, utils.LogAttr("GUID", ctx))
 	}
 
 	var sharedStateId string // defaults to "", if shared state is disabled then no shared state will be used.
@@ -415,16 +577,18 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 	// Get Session. we get session here so we can use the epoch in the callbacks
 	reqBlock, _ := chainMessage.RequestedBlock()
 
-	// try using cache before sending relay
+	// try using cache before sending relay, a relay that requires a quorum must be answered by providers
 	var cacheError error
-	if reqBlock != spectypes.NOT_APPLICABLE || !chainMessage.GetForceCacheRefresh() {
+	if relayProcessor.requiredSuccesses > 1 {
+		utils.LavaFormatDebug("skipping cache due to quorum relay", utils.LogAttr("api name", chainMessage.GetApi().Name))
+	} else if reqBlock != spectypes.NOT_APPLICABLE || !chainMessage.GetForceCacheRefresh() {
 		var cacheReply *pairingtypes.CacheRelayReply
 		hashKey, outputFormatter, err := chainlib.HashCacheRequest(relayRequestData, chainID)
 		if err != nil {
 			utils.LavaFormatError("sendRelayToProvider Failed getting Hash for cache request", err)
 		} else {
-			cacheCtx, cancel := context.WithTimeout(ctx, common.CacheTimeout)
-			cacheReply, cacheError = rpccs.cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
+			// the cache bounds the call by the cache timeout and the time left for the relay
+			cacheReply, cacheError = rpccs.cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{
 				RequestHash:    hashKey,
 				RequestedBlock: relayRequestData.RequestBlock,
 				ChainId:        chainID,
@@ -433,7 +597,6 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				SharedStateId:  sharedStateId,
 				SeenBlock:      relayRequestData.SeenBlock,
 			}) // caching in the portal doesn't care about hashes, and we don't have data on finalization yet
-			cancel()
 			reply := cacheReply.GetReply()
 
 			// read seen block from cache even if we had a miss we still want to get the seen block so we can use it to get the right provider.
@@ -507,7 +670,7 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				ConflictHandler: sessionInfo.Session.Parent,
 			}
 			var errResponse error
-			goroutineCtx, goroutineCtxCancel := context.WithCancel(context.Background())
+			goroutineCtx, goroutineCtxCancel := context.WithCancel(relayProcessor.RelaysContext())
 			guid, found := utils.GetUniqueIdentifier(ctx)
 			if found {
 				goroutineCtx = utils.WithUniqueIdentifier(goroutineCtx, guid)
@@ -536,21 +699,20 @@ func (rpccs *RPCConsumerServer) sendRelayToProvider(
 				return
 			}
 			localRelayResult.Request = relayRequest
-			endpointClient := *singleConsumerSession.Endpoint.Client
 
-			if isSubscription {
-				errResponse = rpccs.relaySubscriptionInner(goroutineCtx, endpointClient, singleConsumerSession, localRelayResult)
-				if errResponse != nil {
-					utils.LavaFormatError("Failed relaySubscriptionInner", errResponse, utils.LogAttr("Request data", localRelayRequestData))
-					return
-				}
+			// unique per dappId and ip, the privacy strategy doesn't let providers tell the users apart
+			consumerToken := ""
+			if rpccs.strategy != provideroptimizer.STRATEGY_PRIVACY {
+				consumerToken = common.GetUniqueToken(dappID, consumerIp)
 			}
-
-			// unique per dappId and ip
-			consumerToken := common.GetUniqueToken(dappID, consumerIp)
 			processingTimeout, relayTimeout := rpccs.getProcessingTimeout(chainMessage)
 			relayLatency, errResponse, backoff := rpccs.relayInner(goroutineCtx, singleConsumerSession, localRelayResult, processingTimeout, chainMessage, consumerToken)
 			if errResponse != nil {
+				if goroutineCtx.Err() != nil {
+					// another provider answered the hedged request first, this provider is not at fault
+					errResponse = lavasession.HedgedRelayCancelledError.Wrapf("provider: %s, %s", providerPublicAddress, errResponse.Error())
+					backoff = false
+				}
 				failRelaySession := func(origErr error, backoff_ bool) {
 					backOffDuration := 0 * time.Second
 					if backoff_ {
@@ -658,8 +820,10 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	callRelay := func() (reply *pairingtypes.RelayReply, relayLatency time.Duration, err error, backoff bool) {
 		relaySentTime := time.Now()
 		connectCtx, connectCtxCancel := context.WithTimeout(ctx, relayTimeout)
-		metadataAdd := metadata.New(map[string]string{common.IP_FORWARDING_HEADER_NAME: consumerToken})
-		connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
+		if consumerToken != "" {
+			metadataAdd := metadata.New(map[string]string{common.IP_FORWARDING_HEADER_NAME: consumerToken})
+			connectCtx = metadata.NewOutgoingContext(connectCtx, metadataAdd)
+		}
 		defer connectCtxCancel()
 		var trailer metadata.MD
 		reply, err = endpointClient.Relay(connectCtx, relayRequest, grpc.Trailer(&trailer))
@@ -709,7 +873,7 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	finalized := spectypes.IsFinalizedBlock(relayRequest.RelayData.RequestBlock, reply.LatestBlock, blockDistanceForFinalizedData)
 	filteredHeaders, _, ignoredHeaders := rpccs.chainParser.HandleHeaders(reply.Metadata, chainMessage.GetApiCollection(), spectypes.Header_pass_reply)
 	reply.Metadata = filteredHeaders
-	err = lavaprotocol.VerifyRelayReply(ctx, reply, relayRequest, providerPublicAddress)
+	err = lavaprotocol.VerifyRelayReply(ctx, reply, relayRequest, providerPublicAddress, singleConsumerSession.Parent.OperatorAddress)
 	if err != nil {
 		return 0, err, false
 	}
@@ -718,7 +882,7 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	enabled, _ := rpccs.chainParser.DataReliabilityParams()
 	if enabled {
 		// TODO: DETECTION instead of existingSessionLatestBlock, we need proof of last reply to send the previous reply and the current reply
-		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, rpccs.ConsumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
+		finalizedBlocks, finalizationConflict, err := lavaprotocol.VerifyFinalizationData(reply, relayRequest, providerPublicAddress, singleConsumerSession.Parent.OperatorAddress, rpccs.ConsumerAddress, existingSessionLatestBlock, blockDistanceForFinalizedData)
 		if err != nil {
 			if lavaprotocol.ProviderFinzalizationDataAccountabilityError.Is(err) && finalizationConflict != nil {
 				go rpccs.consumerTxSender.TxConflictDetection(ctx, finalizationConflict, nil, nil, singleConsumerSession.Parent)
@@ -736,10 +900,12 @@ func (rpccs *RPCConsumerServer) relayInner(ctx context.Context, singleConsumerSe
 	return relayLatency, nil, false
 }
 
-func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult) (err error) {
-	// relaySentTime := time.Now()
+func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endpointClient pairingtypes.RelayerClient, singleConsumerSession *lavasession.SingleConsumerSession, relayResult *common.RelayResult, chainMessage chainlib.ChainMessage) (err error) {
 	replyServer, err := endpointClient.RelaySubscribe(ctx, relayResult.Request)
-	// relayLatency := time.Since(relaySentTime) // TODO: use subscription QoS
+	if err == nil {
+		// the first reply contains the subscription id, or the node error if the provider failed subscribing
+		relayResult.Reply, err = replyServer.Recv()
+	}
 	if err != nil {
 		errReport := rpccs.consumerSessionManager.OnSessionFailure(singleConsumerSession, err)
 		if errReport != nil {
@@ -747,12 +913,16 @@ func (rpccs *RPCConsumerServer) relaySubscriptionInner(ctx context.Context, endp
 		}
 		return err
 	}
-	// TODO: need to check that if provider fails and returns error, this is reflected here and we run onSessionDone
-	// my thoughts are that this fails if the grpc fails not if the provider fails, and if the provider returns an error this is reflected by the Recv function on the chainListener calling us here
-	// and this is too late
-	relayResult.ReplyServer = &replyServer
-	err = rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
-	return err
+	relayResult.StatusCode = 200
+	if hasError, _ := chainMessage.CheckResponseError(relayResult.Reply.Data, relayResult.StatusCode); !hasError {
+		// when the node refused the subscription the provider closes the stream after returning the error
+		if rpccs.listenEndpoint.ApiInterface == spectypes.APIInterfaceGrpc {
+			// grpc streams are billed per message, the first message is paid by the relay
+			replyServer = &meteredSubscriptionStream{Relayer_RelaySubscribeClient: replyServer, rpccs: rpccs, singleConsumerSession: singleConsumerSession, cu: chainMessage.GetApi().ComputeUnits}
+		}
+		relayResult.ReplyServer = &replyServer
+	}
+	return rpccs.consumerSessionManager.OnSessionDoneIncreaseCUOnly(singleConsumerSession)
 }
 
 func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context.Context, dappID string, consumerIp string, chainMessage chainlib.ChainMessage, dataReliabilityThreshold uint32, relayProcessor *RelayProcessor) error {
@@ -818,26 +988,72 @@ func (rpccs *RPCConsumerServer) sendDataReliabilityRelayIfApplicable(ctx context
 	for i := 0; i < len(results)-1; i++ {
 		relayResult := results[i]
 		relayResultDataReliability := results[i+1]
-		conflict := lavaprotocol.VerifyReliabilityResults(ctx, &relayResult, &relayResultDataReliability, chainMessage.GetApiCollection(), rpccs.chainParser)
-		if conflict != nil {
-			// TODO: remove this check when we fix the missing extensions information on conflict detection transaction
-			if len(chainMessage.GetExtensions()) == 0 {
-				err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, relayResultDataReliability.ConflictHandler)
-				if err != nil {
-					utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
-				}
-				if rpccs.reporter != nil {
-					utils.LavaFormatDebug("sending conflict report to BE", utils.LogAttr("conflicting api", chainMessage.GetApi().Name))
-					rpccs.reporter.AppendConflict(metrics.NewConflictRequest(relayResult.Request, relayResult.Reply, relayResultDataReliability.Request, relayResultDataReliability.Reply))
-				}
-			}
-		} else {
+		if !rpccs.verifyResultsAndReportConflict(ctx, chainMessage, &relayResult, &relayResultDataReliability) {
 			utils.LavaFormatDebug("[+] verified relay successfully with data reliability", utils.LogAttr("api", chainMessage.GetApi().Name))
 		}
 	}
 	return nil
 }
 
+// compares two relay results, when they conflict a detection transaction is sent and the conflict is reported, returns true on conflict
+func (rpccs *RPCConsumerServer) verifyResultsAndReportConflict(ctx context.Context, chainMessage chainlib.ChainMessage, relayResult *common.RelayResult, otherRelayResult *common.RelayResult) bool {
+	conflict := lavaprotocol.VerifyReliabilityResults(ctx, relayResult, otherRelayResult, chainMessage.GetApiCollection(), rpccs.chainParser)
+	if conflict == nil {
+		return false
+	}
+	// TODO: remove this check when we fix the missing extensions information on conflict detection transaction
+	if len(chainMessage.GetExtensions()) == 0 {
+		err := rpccs.consumerTxSender.TxConflictDetection(ctx, nil, conflict, nil, otherRelayResult.ConflictHandler)
+		if err != nil {
+			utils.LavaFormatError("could not send detection Transaction", err, utils.Attribute{Key: "GUID", Value: ctx}, utils.Attribute{Key: "conflict", Value: conflict})
+		}
+		if rpccs.reporter != nil {
+			utils.LavaFormatDebug("sending conflict report to BE", utils.LogAttr("conflicting api", chainMessage.GetApi().Name))
+			rpccs.reporter.AppendConflict(metrics.NewConflictRequest(relayResult.Request, relayResult.Reply, otherRelayResult.Request, otherRelayResult.Reply))
+		}
+	}
+	return true
+}
+
+// compares every provider result of a quorum relay with the result of the agreed provider and reports the providers that disagree
+// when there is no agreed result the results are compared with the first one
+func (rpccs *RPCConsumerServer) reportQuorumConflicts(ctx context.Context, chainMessage chainlib.ChainMessage, agreedProvider string, relayProcessor *RelayProcessor) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	results := []common.RelayResult{}
+	agreedIdx := 0
+	for _, result := range relayProcessor.NodeResults() {
+		if result.Reply != nil && result.Request != nil {
+			if result.ProviderInfo.ProviderAddress == agreedProvider {
+				agreedIdx = len(results)
+			}
+			results = append(results, result)
+		}
+	}
+	if len(results) < 2 {
+		return
+	}
+	agreedResult := &results[agreedIdx]
+	conflicts := 0
+	for idx := range results {
+		result := results[idx]
+		if result.ProviderInfo.ProviderAddress == agreedResult.ProviderInfo.ProviderAddress || bytes.Equal(result.Reply.Data, agreedResult.Reply.Data) {
+			continue
+		}
+		if !result.Finalized || !agreedResult.Finalized {
+			// detection transactions can only be sent on finalized data
+			utils.LavaFormatWarning("quorum providers disagree on a non finalized response", nil, utils.LogAttr("GUID", ctx), utils.LogAttr("provider", result.ProviderInfo.ProviderAddress), utils.LogAttr("agreedProvider", agreedResult.ProviderInfo.ProviderAddress), utils.LogAttr("api", chainMessage.GetApi().Name))
+			continue
+		}
+		if rpccs.verifyResultsAndReportConflict(ctx, chainMessage, agreedResult, &result) {
+			conflicts++
+		}
+	}
+	if conflicts == 0 {
+		utils.LavaFormatDebug("[+] verified relay successfully with quorum", utils.LogAttr("api", chainMessage.GetApi().Name), utils.LogAttr("results", len(results)))
+	}
+}
+
 func (rpccs *RPCConsumerServer) getProcessingTimeout(chainMessage chainlib.ChainMessage) (processingTimeout time.Duration, relayTimeout time.Duration) {
 	_, averageBlockTime, _, _ := rpccs.chainParser.ChainBlockStats()
 	relayTimeout = chainlib.GetRelayTimeout(chainMessage, averageBlockTime)
@@ -859,6 +1075,8 @@ func (rpccs *RPCConsumerServer) LavaDirectiveHeaders(metadata []pairingtypes.Met
 			headerDirectives[name] = metaElement.Value
 		case common.FORCE_CACHE_REFRESH_HEADER_NAME:
 			headerDirectives[name] = metaElement.Value
+		case common.QUORUM_HEADER_NAME:
+			headerDirectives[name] = metaElement.Value
 		default:
 			metadataRet = append(metadataRet, metaElement)
 		}
@@ -866,6 +1084,47 @@ func (rpccs *RPCConsumerServer) LavaDirectiveHeaders(metadata []pairingtypes.Met
 	return metadataRet, headerDirectives
 }
 
+// removes the headers that identify the user behind a relay
+func stripIdentifyingMetadata(metadata []pairingtypes.Metadata) []pairingtypes.Metadata {
+	metadataRet := []pairingtypes.Metadata{}
+	for _, metaElement := range metadata {
+		if lavaslices.Contains(common.IDENTIFYING_HEADER_NAMES, strings.ToLower(metaElement.Name)) {
+			continue
+		}
+		metadataRet = append(metadataRet, metaElement)
+	}
+	return metadataRet
+}
+
+// only deterministic relays answered by a single provider are hedged, quorum relays already wait for several providers
+// and best result relays would count the cancelled relays as errors
+func (rpccs *RPCConsumerServer) hedgingAllowed(chainMessage chainlib.ChainMessage, relayProcessor *RelayProcessor) bool {
+	if rpccs.hedgePercentile <= 0 || rpccs.hedgePercentile >= 1 || !chainMessage.GetApi().Category.Deterministic {
+		return false
+	}
+	return relayProcessor.requiredSuccesses == 1 && relayProcessor.selection == Quorum
+}
+
+// returns how long to wait for the provider before sending a duplicate of a deterministic relay to the next provider.
+// latency is modeled as exponentially distributed so the hedge percentile of it is -ln(1-p) times the expected latency
+func (rpccs *RPCConsumerServer) getHedgeDelay(chainMessage chainlib.ChainMessage, relayProcessor *RelayProcessor, relayTimeout time.Duration) (time.Duration, bool) {
+	usedAddresses := relayProcessor.GetUsedProviders().CurrentlyUsedAddresses()
+	if len(usedAddresses) == 0 {
+		return 0, false
+	}
+	expectedLatency := rpccs.consumerSessionManager.ExpectedLatency(usedAddresses[0], chainMessage.GetApi().ComputeUnits)
+	hedgeDelay := time.Duration(float64(expectedLatency) * -math.Log(1-rpccs.hedgePercentile))
+	if hedgeDelay <= 0 || hedgeDelay >= relayTimeout {
+		return 0, false
+	}
+	return hedgeDelay, true
+}
+
+// data reliability sends the relay to another provider, the cost strategy saves it and the privacy strategy keeps to its pinned providers
+func (rpccs *RPCConsumerServer) dataReliabilityAllowed() bool {
+	return rpccs.strategy != provideroptimizer.STRATEGY_COST && rpccs.strategy != provideroptimizer.STRATEGY_PRIVACY
+}
+
 func (rpccs *RPCConsumerServer) getExtensionsFromDirectiveHeaders(directiveHeaders map[string]string) extensionslib.ExtensionInfo {
 	extensionsStr, ok := directiveHeaders[common.EXTENSION_OVERRIDE_HEADER_NAME]
 	if ok {
@@ -940,6 +1199,22 @@ func (rpccs *RPCConsumerServer) appendHeadersToRelayResult(ctx context.Context,
 	relayResult.Reply.Metadata = append(relayResult.Reply.Metadata, metadataReply...)
 }
 
+// reports how many providers agreed on the returned response and how many providers responded
+func (rpccs *RPCConsumerServer) appendQuorumHeadersToRelayResult(relayResult *common.RelayResult, relayProcessor *RelayProcessor) {
+	if relayResult == nil || relayResult.Reply == nil {
+		return
+	}
+	relayResult.Reply.Metadata = append(relayResult.Reply.Metadata,
+		pairingtypes.Metadata{
+			Name:  common.QUORUM_AGREED_HEADER_NAME,
+			Value: strconv.Itoa(relayResult.Quorum),
+		},
+		pairingtypes.Metadata{
+			Name:  common.QUORUM_RESPONSES_HEADER_NAME,
+			Value: strconv.Itoa(len(relayProcessor.NodeResults())),
+		})
+}
+
 func (rpccs *RPCConsumerServer) IsHealthy() bool {
 	return rpccs.relaysMonitor.IsHealthy()
 }
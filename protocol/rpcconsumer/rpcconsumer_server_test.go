@@ -0,0 +1,160 @@
+package rpcconsumer
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/lavanet/lava/protocol/chainlib"
+	"github.com/lavanet/lava/protocol/chainlib/extensionslib"
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/lavanet/lava/protocol/provideroptimizer"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	spectypes "github.com/lavanet/lava/x/spec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuorumDirective(t *testing.T) {
+	playbook := []struct {
+		name      string
+		value     string
+		quorum    int
+		providers int
+		valid     bool
+	}{
+		{name: "n of m", value: "2/3", quorum: 2, providers: 3, valid: true},
+		{name: "all of m", value: "3/3", quorum: 3, providers: 3, valid: true},
+		{name: "majority of m", value: "5", quorum: 3, providers: 5, valid: true},
+		{name: "spaces", value: " 3 / 4 ", quorum: 3, providers: 4, valid: true},
+		{name: "tie", value: "2/4", valid: false},
+		{name: "minority", value: "1/3", valid: false},
+		{name: "quorum bigger than providers", value: "4/3", valid: false},
+		{name: "too many providers", value: "2/50", valid: false},
+		{name: "zero", value: "0/3", valid: false},
+		{name: "not a number", value: "two/three", valid: false},
+		{name: "empty", value: "", valid: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			quorum, providers, err := parseQuorumDirective(play.value)
+			if !play.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.quorum, quorum)
+			require.Equal(t, play.providers, providers)
+		})
+	}
+}
+
+func TestQuorumDirectiveHeader(t *testing.T) {
+	rpccs := &RPCConsumerServer{}
+	metadata, directiveHeaders := rpccs.LavaDirectiveHeaders([]pairingtypes.Metadata{{Name: "Lava-Quorum", Value: "2/3"}, {Name: "other", Value: "value"}})
+	require.Len(t, metadata, 1)
+	require.Equal(t, "2/3", directiveHeaders[common.QUORUM_HEADER_NAME])
+}
+
+func TestStripIdentifyingMetadata(t *testing.T) {
+	metadata := stripIdentifyingMetadata([]pairingtypes.Metadata{{Name: "User-Agent", Value: "curl"}, {Name: "X-Forwarded-For", Value: "1.2.3.4"}, {Name: "Content-Type", Value: "application/json"}})
+	require.Equal(t, []pairingtypes.Metadata{{Name: "Content-Type", Value: "application/json"}}, metadata)
+}
+
+func TestGetQuorumForMessage(t *testing.T) {
+	ctx := context.Background()
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, "LAV1", spectypes.APIInterfaceRest, serverHandler, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	chainMsg, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	require.True(t, chainMsg.GetApi().Category.Deterministic)
+
+	playbook := []struct {
+		name              string
+		secureQuorum      int
+		strategy          provideroptimizer.Strategy
+		directive         string
+		requiredSuccesses int
+		quorumSize        int
+	}{
+		{name: "single provider", secureQuorum: 1, requiredSuccesses: 1, quorumSize: 1},
+		{name: "secure mode", secureQuorum: 3, requiredSuccesses: 3, quorumSize: 2},
+		{name: "directive", secureQuorum: 1, directive: "2/3", requiredSuccesses: 3, quorumSize: 2},
+		{name: "directive all agree", secureQuorum: 1, directive: "4/4", requiredSuccesses: 4, quorumSize: 4},
+		{name: "directive can't lower secure mode", secureQuorum: 5, directive: "2/3", requiredSuccesses: 5, quorumSize: 3},
+		{name: "invalid directive", secureQuorum: 1, directive: "bad"},
+		{name: "tie directive", secureQuorum: 1, directive: "2/4"},
+		{name: "accuracy strategy", secureQuorum: 1, strategy: provideroptimizer.STRATEGY_ACCURACY, requiredSuccesses: AccuracyQuorumProviders, quorumSize: 2},
+		{name: "accuracy strategy in secure mode", secureQuorum: 5, strategy: provideroptimizer.STRATEGY_ACCURACY, requiredSuccesses: 5, quorumSize: 3},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			rpccs := &RPCConsumerServer{requiredResponses: play.secureQuorum, strategy: play.strategy}
+			directiveHeaders := map[string]string{}
+			if play.directive != "" {
+				directiveHeaders[common.QUORUM_HEADER_NAME] = play.directive
+			}
+			requiredSuccesses, quorumSize, err := rpccs.getQuorumForMessage(chainMsg, directiveHeaders)
+			if play.requiredSuccesses == 0 {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, play.requiredSuccesses, requiredSuccesses)
+			require.Equal(t, play.quorumSize, quorumSize)
+		})
+	}
+}
+
+func TestHedgingAllowed(t *testing.T) {
+	ctx := context.Background()
+	serverHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	chainParser, _, _, closeServer, _, err := chainlib.CreateChainLibMocks(ctx, "LAV1", spectypes.APIInterfaceRest, serverHandler, "../../", nil)
+	if closeServer != nil {
+		defer closeServer()
+	}
+	require.NoError(t, err)
+	deterministicMsg, err := chainParser.ParseMsg("/cosmos/base/tendermint/v1beta1/blocks/17", nil, http.MethodGet, nil, extensionslib.ExtensionInfo{LatestBlock: 0})
+	require.NoError(t, err)
+	require.True(t, deterministicMsg.GetApi().Category.Deterministic)
+
+	playbook := []struct {
+		name              string
+		hedgePercentile   float64
+		requiredSuccesses int
+		allowed           bool
+	}{
+		{name: "disabled", hedgePercentile: 0, requiredSuccesses: 1, allowed: false},
+		{name: "single provider", hedgePercentile: 0.95, requiredSuccesses: 1, allowed: true},
+		{name: "quorum", hedgePercentile: 0.95, requiredSuccesses: 3, allowed: false},
+		{name: "invalid percentile", hedgePercentile: 1, requiredSuccesses: 1, allowed: false},
+	}
+	for _, play := range playbook {
+		t.Run(play.name, func(t *testing.T) {
+			rpccs := &RPCConsumerServer{hedgePercentile: play.hedgePercentile}
+			relayProcessor := NewRelayProcessor(ctx, lavasession.NewUsedProviders(nil), play.requiredSuccesses, deterministicMsg, nil, "", "")
+			require.Equal(t, play.allowed, rpccs.hedgingAllowed(deterministicMsg, relayProcessor))
+		})
+	}
+}
+
+func TestRelayProcessorCancelHedgedRelays(t *testing.T) {
+	relayProcessor := &RelayProcessor{}
+	// relays outlive the request unless it is hedged
+	relayProcessor.CancelHedgedRelays()
+	require.NoError(t, relayProcessor.RelaysContext().Err())
+
+	relayProcessor.EnableHedging()
+	relaysCtx := relayProcessor.RelaysContext()
+	require.NoError(t, relaysCtx.Err())
+	relayProcessor.CancelHedgedRelays()
+	require.Error(t, relaysCtx.Err())
+}
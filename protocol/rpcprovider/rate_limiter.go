@@ -0,0 +1,136 @@
+package rpcprovider
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lavanet/lava/protocol/common"
+	"github.com/lavanet/lava/protocol/lavasession"
+)
+
+const (
+	RateLimitConsumerRequestsFlagName = "rate-limit-consumer-requests"
+	RateLimitConsumerCuFlagName       = "rate-limit-consumer-cu"
+	RateLimitProjectRequestsFlagName  = "rate-limit-project-requests"
+	RateLimitProjectCuFlagName        = "rate-limit-project-cu"
+
+	// buckets that were not used for this long are full again and can be dropped
+	RateLimitBucketIdleTimeout = time.Minute
+	RateLimitCleanupInterval   = time.Minute
+)
+
+// per second limits, 0 disables a limit
+type RateLimits struct {
+	ConsumerRequestsPerSecond float64
+	ConsumerCuPerSecond       float64
+	ProjectRequestsPerSecond  float64
+	ProjectCuPerSecond        float64
+}
+
+func (rl RateLimits) Enabled() bool {
+	return rl.ConsumerRequestsPerSecond > 0 || rl.ConsumerCuPerSecond > 0 || rl.ProjectRequestsPerSecond > 0 || rl.ProjectCuPerSecond > 0
+}
+
+type rateLimitedKey struct {
+	requests *common.TokenBucket
+	cu       *common.TokenBucket
+}
+
+// RelayRateLimiter applies token bucket limits on the relays of each consumer and each project
+type RelayRateLimiter struct {
+	limits      RateLimits
+	lock        sync.Mutex
+	consumers   map[string]*rateLimitedKey
+	projects    map[string]*rateLimitedKey
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func NewRelayRateLimiter(limits RateLimits) *RelayRateLimiter {
+	if !limits.Enabled() {
+		return nil
+	}
+	return &RelayRateLimiter{
+		limits:      limits,
+		consumers:   map[string]*rateLimitedKey{},
+		projects:    map[string]*rateLimitedKey{},
+		lastCleanup: time.Now(),
+		now:         time.Now,
+	}
+}
+
+func (rrl *RelayRateLimiter) getKey(keys map[string]*rateLimitedKey, key string, requestsRate, cuRate float64, now time.Time) *rateLimitedKey {
+	limited, ok := keys[key]
+	if !ok {
+		limited = &rateLimitedKey{}
+		if requestsRate > 0 {
+			limited.requests = common.NewTokenBucket(requestsRate, now)
+		}
+		if cuRate > 0 {
+			limited.cu = common.NewTokenBucket(cuRate, now)
+		}
+		keys[key] = limited
+	}
+	if limited.requests != nil {
+		limited.requests.Refill(now)
+	}
+	if limited.cu != nil {
+		limited.cu.Refill(now)
+	}
+	return limited
+}
+
+func (rrl *RelayRateLimiter) cleanup(now time.Time) {
+	if now.Sub(rrl.lastCleanup) < RateLimitCleanupInterval {
+		return
+	}
+	rrl.lastCleanup = now
+	for _, keys := range []map[string]*rateLimitedKey{rrl.consumers, rrl.projects} {
+		for key, limited := range keys {
+			idle := true
+			for _, bucket := range []*common.TokenBucket{limited.requests, limited.cu} {
+				if bucket != nil && now.Sub(bucket.LastRefill()) < RateLimitBucketIdleTimeout {
+					idle = false
+				}
+			}
+			if idle {
+				delete(keys, key)
+			}
+		}
+	}
+}
+
+// Allow consumes a request and its cu from the consumer's and project's buckets, nothing is consumed when one of them is exhausted
+func (rrl *RelayRateLimiter) Allow(consumer, project string, cu uint64) error {
+	if rrl == nil {
+		return nil
+	}
+	rrl.lock.Lock()
+	defer rrl.lock.Unlock()
+	now := rrl.now()
+	rrl.cleanup(now)
+
+	limitedKeys := []*rateLimitedKey{}
+	if rrl.limits.ConsumerRequestsPerSecond > 0 || rrl.limits.ConsumerCuPerSecond > 0 {
+		limitedKeys = append(limitedKeys, rrl.getKey(rrl.consumers, consumer, rrl.limits.ConsumerRequestsPerSecond, rrl.limits.ConsumerCuPerSecond, now))
+	}
+	if project != "" && (rrl.limits.ProjectRequestsPerSecond > 0 || rrl.limits.ProjectCuPerSecond > 0) {
+		limitedKeys = append(limitedKeys, rrl.getKey(rrl.projects, project, rrl.limits.ProjectRequestsPerSecond, rrl.limits.ProjectCuPerSecond, now))
+	}
+
+	for _, limited := range limitedKeys {
+		if (limited.requests != nil && !limited.requests.Allows(1)) || (limited.cu != nil && !limited.cu.Allows(float64(cu))) {
+			return lavasession.ConsumerRateLimitedError.Wrapf("consumer: %s, project: %s, cu: %d", consumer, project, cu)
+		}
+	}
+	for _, limited := range limitedKeys {
+		if limited.requests != nil {
+			limited.requests.Take(1)
+		}
+		if limited.cu != nil {
+			// a relay costing more than the bucket empties it, it doesn't leave it in debt
+			limited.cu.Take(limited.cu.Cost(float64(cu)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package rpcprovider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lavanet/lava/protocol/lavasession"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayRateLimiterDisabled(t *testing.T) {
+	rateLimiter := NewRelayRateLimiter(RateLimits{})
+	require.Nil(t, rateLimiter)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, rateLimiter.Allow("consumer", "project", 100))
+	}
+}
+
+func TestRelayRateLimiterRequests(t *testing.T) {
+	now := time.Now()
+	rateLimiter := NewRelayRateLimiter(RateLimits{ConsumerRequestsPerSecond: 10})
+	rateLimiter.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, rateLimiter.Allow("consumer", "", 10))
+	}
+	err := rateLimiter.Allow("consumer", "", 10)
+	require.ErrorIs(t, err, lavasession.ConsumerRateLimitedError)
+	// other consumers have their own bucket
+	require.NoError(t, rateLimiter.Allow("consumer2", "", 10))
+
+	// tokens refill over time
+	now = now.Add(200 * time.Millisecond)
+	require.NoError(t, rateLimiter.Allow("consumer", "", 10))
+	require.NoError(t, rateLimiter.Allow("consumer", "", 10))
+	require.Error(t, rateLimiter.Allow("consumer", "", 10))
+}
+
+func TestRelayRateLimiterCu(t *testing.T) {
+	now := time.Now()
+	rateLimiter := NewRelayRateLimiter(RateLimits{ConsumerCuPerSecond: 100, ProjectCuPerSecond: 150})
+	rateLimiter.now = func() time.Time { return now }
+
+	require.NoError(t, rateLimiter.Allow("consumer", "project", 60))
+	// the consumer's bucket is exhausted, the project's bucket is not consumed
+	require.Error(t, rateLimiter.Allow("consumer", "project", 60))
+	// the project is shared by its consumers
+	require.NoError(t, rateLimiter.Allow("consumer2", "project", 60))
+	require.Error(t, rateLimiter.Allow("consumer3", "project", 60))
+
+	// a relay costing more than the limit passes only on a full bucket
+	now = now.Add(time.Second)
+	require.NoError(t, rateLimiter.Allow("consumer", "", 500))
+	require.Error(t, rateLimiter.Allow("consumer", "", 500))
+
+	// it empties the bucket without leaving it in debt, a second refills it
+	now = now.Add(time.Second)
+	require.NoError(t, rateLimiter.Allow("consumer", "", 500))
+	now = now.Add(time.Second)
+	require.NoError(t, rateLimiter.Allow("consumer", "", 100))
+}
+
+func TestRelayRateLimiterCleanup(t *testing.T) {
+	now := time.Now()
+	rateLimiter := NewRelayRateLimiter(RateLimits{ConsumerRequestsPerSecond: 1, ProjectRequestsPerSecond: 1})
+	rateLimiter.now = func() time.Time { return now }
+
+	require.NoError(t, rateLimiter.Allow("consumer", "project", 10))
+	require.Len(t, rateLimiter.consumers, 1)
+	require.Len(t, rateLimiter.projects, 1)
+
+	now = now.Add(RateLimitBucketIdleTimeout + RateLimitCleanupInterval)
+	require.NoError(t, rateLimiter.Allow("consumer2", "", 10))
+	require.Len(t, rateLimiter.consumers, 1)
+	require.Empty(t, rateLimiter.projects)
+}
@@ -91,9 +91,9 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		reply.LatestBlock = latestBlock
 		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
 		require.NoError(t, err)
-		err = lavaprotocol.VerifyRelayReply(ctx, reply, relay, provider_address.String())
+		err = lavaprotocol.VerifyRelayReply(ctx, reply, relay, provider_address.String(), "")
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), "", consumer_address, int64(0), 0)
 		require.NoError(t, err)
 
 		relayResult := &common.RelayResult{
@@ -124,9 +124,9 @@ func TestFullFlowReliabilityCompare(t *testing.T) {
 		replyDR.LatestBlock = latestBlock
 		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, providerDR_sk, replyDR, true)
 		require.NoError(t, err)
-		err = lavaprotocol.VerifyRelayReply(ctx, replyDR, relayDR, providerDR_address.String())
+		err = lavaprotocol.VerifyRelayReply(ctx, replyDR, relayDR, providerDR_address.String(), "")
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
+		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), "", consumer_address, int64(0), 0)
 		require.NoError(t, err)
 		relayResultDR := &common.RelayResult{
 			Request:      relayDR,
@@ -246,9 +246,9 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		reply.LatestBlock = latestBlock
 		reply, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relay, provider_sk, reply, true)
 		require.NoError(t, err)
-		err = lavaprotocol.VerifyRelayReply(ts.Ctx, reply, relay, provider_address.String())
+		err = lavaprotocol.VerifyRelayReply(ts.Ctx, reply, relay, provider_address.String(), "")
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), consumer_address, int64(0), 0)
+		_, _, err = lavaprotocol.VerifyFinalizationData(reply, relay, provider_address.String(), "", consumer_address, int64(0), 0)
 		require.NoError(t, err)
 
 		relayResult := &common.RelayResult{
@@ -284,9 +284,9 @@ func TestFullFlowReliabilityConflict(t *testing.T) {
 		replyDR.LatestBlock = latestBlock
 		replyDR, err = lavaprotocol.SignRelayResponse(extractedConsumerAddress, *relayDR, providerDR_sk, replyDR, true)
 		require.NoError(t, err)
-		err = lavaprotocol.VerifyRelayReply(ts.Ctx, replyDR, relayDR, providerDR_address.String())
+		err = lavaprotocol.VerifyRelayReply(ts.Ctx, replyDR, relayDR, providerDR_address.String(), "")
 		require.NoError(t, err)
-		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), consumer_address, int64(0), 0)
+		_, _, err = lavaprotocol.VerifyFinalizationData(replyDR, relayDR, providerDR_address.String(), "", consumer_address, int64(0), 0)
 		require.NoError(t, err)
 		relayResultDR := &common.RelayResult{
 			Request:      relayDR,
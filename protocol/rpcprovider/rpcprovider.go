@@ -43,9 +43,10 @@ const (
 	ChainTrackerDefaultMemory  = 100
 	DEFAULT_ALLOWED_MISSING_CU = 0.2
 
-	ShardIDFlagName           = "shard-id"
-	StickinessHeaderName      = "sticky-header"
-	DefaultShardID       uint = 0
+	ShardIDFlagName            = "shard-id"
+	ProviderVaultFlagName      = "provider-vault"
+	StickinessHeaderName       = "sticky-header"
+	DefaultShardID        uint = 0
 )
 
 var (
@@ -107,6 +108,8 @@ type rpcProviderStartOptions struct {
 	rewardsSnapshotThreshold  uint
 	rewardsSnapshotTimeoutSec uint
 	healthCheckMetricsOptions *rpcProviderHealthCheckMetricsOptions
+	vaultAddress              string // when set, the provider address that owns the stake, the signing key is its operator
+	rateLimits                RateLimits
 }
 
 type rpcProviderHealthCheckMetricsOptions struct {
@@ -135,6 +138,7 @@ type RPCProvider struct {
 	relaysHealthCheckEnabled  bool
 	relaysHealthCheckInterval time.Duration
 	grpcHealthCheckEndpoint   string
+	rateLimits                RateLimits
 }
 
 func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
@@ -153,6 +157,7 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 	rpcp.rpcProviderListeners = make(map[string]*ProviderListener)
 	rpcp.shardID = options.shardID
 	rpcp.relaysHealthCheckEnabled = options.healthCheckMetricsOptions.relaysHealthEnableFlag
+	rpcp.rateLimits = options.rateLimits
 	rpcp.relaysHealthCheckInterval = options.healthCheckMetricsOptions.relaysHealthIntervalFlag
 	rpcp.relaysMonitorAggregator = metrics.NewRelaysMonitorAggregator(rpcp.relaysHealthCheckInterval, rpcp.providerMetricsManager)
 	rpcp.grpcHealthCheckEndpoint = options.healthCheckMetricsOptions.grpcHealthCheckEndpoint
@@ -199,6 +204,15 @@ func (rpcp *RPCProvider) Start(options *rpcProviderStartOptions) (err error) {
 		utils.LavaFormatFatal("failed unmarshaling public address", err, utils.Attribute{Key: "keyName", Value: keyName}, utils.Attribute{Key: "pubkey", Value: pubKey.Address()})
 	}
 	utils.LavaFormatInfo("RPCProvider pubkey: " + rpcp.addr.String())
+	if options.vaultAddress != "" {
+		// the key only signs relays, payments and votes as the operator, the provider is the vault
+		operator := rpcp.addr
+		rpcp.addr, err = sdk.AccAddressFromBech32(options.vaultAddress)
+		if err != nil {
+			utils.LavaFormatFatal("failed parsing provider vault address", err, utils.Attribute{Key: "vault", Value: options.vaultAddress})
+		}
+		utils.LavaFormatInfo("RPCProvider running as operator", utils.Attribute{Key: "provider", Value: rpcp.addr.String()}, utils.Attribute{Key: "operator", Value: operator.String()})
+	}
 	utils.LavaFormatInfo("RPCProvider setting up endpoints", utils.Attribute{Key: "count", Value: strconv.Itoa(len(options.rpcProviderEndpoints))})
 	blockMemorySize, err := rpcp.providerStateTracker.GetEpochSizeMultipliedByRecommendedEpochNumToCollectPayment(ctx) // get the number of blocks to keep in PSM.
 	if err != nil {
@@ -399,6 +413,22 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 					utils.Attribute{Key: "apiInterface", Value: apiInterface},
 				)
 			}
+			// a fork can only change non finalized blocks, the cached responses of these blocks might belong to orphaned blocks
+			invalidateCacheOnFork := func(latestBlock int64) {
+				fromBlock := latestBlock - int64(blocksToFinalization)
+				utils.LavaFormatInfo("fork detected, invalidating non finalized cache entries",
+					utils.Attribute{Key: "Chain", Value: rpcProviderEndpoint.ChainID},
+					utils.Attribute{Key: "latestBlock", Value: latestBlock},
+					utils.Attribute{Key: "fromBlock", Value: fromBlock},
+				)
+				if !rpcp.cache.CacheActive() {
+					return
+				}
+				err := rpcp.cache.InvalidateEntries(ctx, chainID, fromBlock)
+				if err != nil {
+					utils.LavaFormatWarning("failed invalidating cache entries on fork", err, utils.Attribute{Key: "Chain", Value: rpcProviderEndpoint.ChainID})
+				}
+			}
 			blocksToSaveChainTracker := uint64(blocksToFinalization + blocksInFinalizationData)
 			chainTrackerConfig := chaintracker.ChainTrackerConfig{
 				BlocksToSave:        blocksToSaveChainTracker,
@@ -406,6 +436,7 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 				ServerBlockMemory:   ChainTrackerDefaultMemory + blocksToSaveChainTracker,
 				NewLatestCallback:   recordMetricsOnNewBlock,
 				ConsistencyCallback: consistencyErrorCallback,
+				ForkCallback:        invalidateCacheOnFork,
 				Pmetrics:            rpcp.providerMetricsManager,
 			}
 
@@ -432,6 +463,8 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	if err != nil {
 		return err
 	}
+	// nodes lagging behind the chain tracker stop receiving relays until they catch up
+	chainRouter.SetLatestBlockTracker(chainTracker)
 
 	// Add the chain fetcher to the spec validator
 	err = specValidator.AddChainFetcher(ctx, &chainFetcher, chainID)
@@ -455,7 +488,9 @@ func (rpcp *RPCProvider) SetupEndpoint(ctx context.Context, rpcProviderEndpoint
 	}
 
 	rpcProviderServer := &RPCProviderServer{}
-	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor)
+	// each endpoint limits the consumers separately, as each is served by its own nodes
+	rateLimiter := NewRelayRateLimiter(rpcp.rateLimits)
+	rpcProviderServer.ServeRPCRequests(ctx, rpcProviderEndpoint, chainParser, rpcp.rewardServer, providerSessionManager, reliabilityManager, rpcp.privKey, rpcp.cache, chainRouter, rpcp.providerStateTracker, rpcp.addr, rpcp.lavaChainID, DEFAULT_ALLOWED_MISSING_CU, providerMetrics, relaysMonitor, rateLimiter)
 	// set up grpc listener
 	var listener *ProviderListener
 	func() {
@@ -644,7 +679,7 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 			if cacheAddr != "" {
 				cache, err = performance.InitCache(ctx, cacheAddr)
 				if err != nil {
-					utils.LavaFormatError("Failed To Connect to cache at address", err, utils.Attribute{Key: "address", Value: cacheAddr})
+					utils.LavaFormatError("Failed To Connect to cache at address, reconnecting in the background", err, utils.Attribute{Key: "address", Value: cacheAddr})
 				} else {
 					utils.LavaFormatInfo("cache service connected", utils.Attribute{Key: "address", Value: cacheAddr})
 				}
@@ -669,6 +704,13 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 			enableRelaysHealth := viper.GetBool(common.RelaysHealthEnableFlag)
 			relaysHealthInterval := viper.GetDuration(common.RelayHealthIntervalFlag)
 			healthCheckURLPath := viper.GetString(HealthCheckURLPathFlagName)
+			vaultAddress := viper.GetString(ProviderVaultFlagName)
+			rateLimits := RateLimits{
+				ConsumerRequestsPerSecond: viper.GetFloat64(RateLimitConsumerRequestsFlagName),
+				ConsumerCuPerSecond:       viper.GetFloat64(RateLimitConsumerCuFlagName),
+				ProjectRequestsPerSecond:  viper.GetFloat64(RateLimitProjectRequestsFlagName),
+				ProjectCuPerSecond:        viper.GetFloat64(RateLimitProjectCuFlagName),
+			}
 
 			rpcProviderHealthCheckMetricsOptions := rpcProviderHealthCheckMetricsOptions{
 				enableRelaysHealth,
@@ -690,6 +732,8 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 				rewardsSnapshotThreshold,
 				rewardsSnapshotTimeoutSec,
 				&rpcProviderHealthCheckMetricsOptions,
+				vaultAddress,
+				rateLimits,
 			}
 
 			rpcProvider := RPCProvider{}
@@ -712,6 +756,11 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 	cmdRPCProvider.Flags().String(rewardserver.RewardServerStorageFlagName, rewardserver.DefaultRewardServerStorage, "the path to store reward server data")
 	cmdRPCProvider.Flags().Duration(rewardserver.RewardTTLFlagName, rewardserver.DefaultRewardTTL, "reward time to live")
 	cmdRPCProvider.Flags().Uint(ShardIDFlagName, DefaultShardID, "shard id")
+	cmdRPCProvider.Flags().String(ProviderVaultFlagName, "", "the provider address that owns the stake, when the --from key is its operator (see tx pairing set-operator)")
+	cmdRPCProvider.Flags().Float64(RateLimitConsumerRequestsFlagName, 0, "the relays per second allowed for a single consumer on an endpoint, 0 disables the limit")
+	cmdRPCProvider.Flags().Float64(RateLimitConsumerCuFlagName, 0, "the compute units per second allowed for a single consumer on an endpoint, 0 disables the limit")
+	cmdRPCProvider.Flags().Float64(RateLimitProjectRequestsFlagName, 0, "the relays per second allowed for a single project on an endpoint, 0 disables the limit")
+	cmdRPCProvider.Flags().Float64(RateLimitProjectCuFlagName, 0, "the compute units per second allowed for a single project on an endpoint, 0 disables the limit")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotThresholdFlagName, rewardserver.DefaultRewardsSnapshotThreshold, "the number of rewards to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().Uint(rewardserver.RewardsSnapshotTimeoutSecFlagName, rewardserver.DefaultRewardsSnapshotTimeoutSec, "the seconds to wait until making snapshot of the rewards memory")
 	cmdRPCProvider.Flags().String(StickinessHeaderName, RPCProviderStickinessHeaderName, "the name of the header to be attacked to requests for stickiness by consumer, used for consistency")
@@ -722,6 +771,7 @@ rpcprovider 127.0.0.1:3333 OSMOSIS tendermintrpc "wss://www.node-path.com:80,htt
 	cmdRPCProvider.Flags().Duration(common.RelayHealthIntervalFlag, RelayHealthIntervalFlagDefault, "interval between relay health checks")
 	cmdRPCProvider.Flags().String(HealthCheckURLPathFlagName, HealthCheckURLPathFlagDefault, "the url path for the provider's grpc health check")
 	cmdRPCProvider.Flags().DurationVar(&updaters.TimeOutForFetchingLavaBlocks, common.TimeOutForFetchingLavaBlocksFlag, time.Second*5, "setting the timeout for fetching lava blocks")
+	cmdRPCProvider.Flags().BoolVar(&updaters.EventStreamEnabled, common.LavaEventStreamFlag, false, "read the lava events from the node's websocket event stream instead of polling the results of every block, missed blocks are still polled")
 
 	common.AddRollingLogConfig(cmdRPCProvider)
 	return cmdRPCProvider
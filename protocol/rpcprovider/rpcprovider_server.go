@@ -57,6 +57,7 @@ type RPCProviderServer struct {
 	allowedMissingCUThreshold float64
 	metrics                   *metrics.ProviderMetrics
 	relaysMonitor             *metrics.RelaysMonitor
+	rateLimiter               *RelayRateLimiter
 }
 
 type ReliabilityManagerInf interface {
@@ -92,6 +93,7 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	allowedMissingCUThreshold float64,
 	providerMetrics *metrics.ProviderMetrics,
 	relaysMonitor *metrics.RelaysMonitor,
+	rateLimiter *RelayRateLimiter,
 ) {
 	rpcps.cache = cache
 	rpcps.chainRouter = chainRouter
@@ -107,6 +109,7 @@ func (rpcps *RPCProviderServer) ServeRPCRequests(
 	rpcps.allowedMissingCUThreshold = allowedMissingCUThreshold
 	rpcps.metrics = providerMetrics
 	rpcps.relaysMonitor = relaysMonitor
+	rpcps.rateLimiter = rateLimiter
 
 	rpcps.initRelaysMonitor(ctx)
 }
@@ -264,6 +267,11 @@ func (rpcps *RPCProviderServer) initRelay(ctx context.Context, request *pairingt
 		return nil, nil, nil, err
 	}
 	relayCU := chainMessage.GetApi().ComputeUnits
+	// rate limiting happens before the session is used so the consumer can retry it with another provider
+	err = rpcps.rateLimiter.Allow(consumerAddress.String(), relaySession.GetProjectId(), relayCU)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(uint64(request.RelaySession.Epoch))
 	err = relaySession.PrepareSessionForUsage(ctx, relayCU, request.RelaySession.CuSum, rpcps.allowedMissingCUThreshold, virtualEpoch)
 	if err != nil {
@@ -408,6 +416,13 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 		return false, err
 	}
 	rpcps.rewardServer.SubscribeStarted(consumerAddress.String(), requestBlockHeight, subscriptionID)
+	// grpc streams are billed per message, the first message is paid by the relay
+	streamedMessageCU := uint64(0)
+	if chainMessage.GetApiCollection().CollectionData.ApiInterface == spectypes.APIInterfaceGrpc {
+		streamedMessageCU = chainMessage.GetApi().ComputeUnits
+	}
+	streamedCU := uint64(0)
+	virtualEpoch := rpcps.stateTracker.GetVirtualEpoch(requestBlockHeight)
 	processSubscribeMessages := func() (subscribed bool, errRet error) {
 		err = srv.Send(reply) // this reply contains the RPC ID
 		if err != nil {
@@ -424,16 +439,28 @@ func (rpcps *RPCProviderServer) TryRelaySubscribe(ctx context.Context, requestBl
 
 				return subscribed, err
 			case subscribeReply := <-subscribeRepliesChan:
-				data, err := json.Marshal(subscribeReply)
-				if err != nil {
-					return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+				// grpc streams forward the node's messages as they are
+				data, isRaw := subscribeReply.([]byte)
+				if !isRaw {
+					data, err = json.Marshal(subscribeReply)
+					if err != nil {
+						return subscribed, utils.LavaFormatError("client sub unmarshal", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
 				}
 
-				err = srv.Send(
-					&pairingtypes.RelayReply{
-						Data: data,
-					},
-				)
+				streamedReply := &pairingtypes.RelayReply{
+					Data: data,
+				}
+				if streamedMessageCU > 0 {
+					// charge the message before sending it, and tell the consumer the total so both sides bill the same cu
+					err = relaySession.AddStreamedCU(streamedMessageCU, virtualEpoch)
+					if err != nil {
+						return subscribed, utils.LavaFormatWarning("failed adding streamed message cu, closing the stream", err, utils.Attribute{Key: "GUID", Value: ctx})
+					}
+					streamedCU += streamedMessageCU
+					streamedReply.Metadata = []pairingtypes.Metadata{{Name: common.STREAMED_CU_HEADER_NAME, Value: strconv.FormatUint(streamedCU, 10)}}
+				}
+				err = srv.Send(streamedReply)
 				if err != nil {
 					// usually triggered when client closes connection
 					if strings.Contains(err.Error(), "Canceled desc = context canceled") {
@@ -643,6 +670,8 @@ func (rpcps *RPCProviderServer) handleRelayErrorStatus(err error) error {
 		err = status.Error(codes.Code(lavasession.SessionOutOfSyncError.ABCICode()), err.Error())
 	} else if lavasession.EpochMismatchError.Is(err) {
 		err = status.Error(codes.Code(lavasession.EpochMismatchError.ABCICode()), err.Error())
+	} else if lavasession.ConsumerRateLimitedError.Is(err) {
+		err = status.Error(codes.Code(lavasession.ConsumerRateLimitedError.ABCICode()), err.Error())
 	}
 	return err
 }
@@ -717,7 +746,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		}
 	}
 	cache := rpcps.cache
-	// TODO: handle cache on fork for dataReliability = false
+	// without data reliability we have no block hashes nor finalization info, so nothing is cached and forks can't serve stale responses
 	var reply *pairingtypes.RelayReply = nil
 	var err error = nil
 	ignoredMetadata := []pairingtypes.Metadata{}
@@ -728,8 +757,8 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		if hashErr != nil {
 			utils.LavaFormatError("TryRelay Failed computing hash for cache request", hashErr)
 		} else {
-			cacheCtx, cancel := context.WithTimeout(ctx, common.CacheTimeout)
-			cacheReply, err = cache.GetEntry(cacheCtx, &pairingtypes.RelayCacheGet{
+			// the cache bounds the call by the cache timeout and the time left for the relay
+			cacheReply, err = cache.GetEntry(ctx, &pairingtypes.RelayCacheGet{
 				RequestHash:    hashKey,
 				RequestedBlock: request.RelayData.RequestBlock,
 				ChainId:        rpcps.rpcProviderEndpoint.ChainID,
@@ -737,7 +766,6 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 				Finalized:      finalized,
 				SeenBlock:      request.RelayData.SeenBlock,
 			})
-			cancel()
 			reply = cacheReply.GetReply()
 			if reply != nil {
 				reply.Data = outPutFormatter(reply.Data) // setting request id back to reply.
@@ -769,7 +797,7 @@ func (rpcps *RPCProviderServer) TryRelay(ctx context.Context, request *pairingty
 		}
 		reply.Metadata, _, ignoredMetadata = rpcps.chainParser.HandleHeaders(reply.Metadata, chainMsg.GetApiCollection(), spectypes.Header_pass_reply)
 		// TODO: use overwriteReqBlock on the reply metadata to set the correct latest block
-		if cache.CacheActive() && (requestedBlockHash != nil || finalized) {
+		if cache.CacheActive() && (requestedBlockHash != nil || finalized) && rpcps.blockHashUnchanged(request.RelayData.RequestBlock, requestedBlockHash, finalized) {
 			// copy request and reply as they change later on and we call SetEntry in a routine.
 			requestedBlock := request.RelayData.RequestBlock                                                       // get requested block before removing it from the data
 			hashKey, _, hashErr := chainlib.HashCacheRequest(request.RelayData, rpcps.rpcProviderEndpoint.ChainID) // get the hash (this changes the data)
@@ -1008,6 +1036,19 @@ func (rpcps *RPCProviderServer) GetLatestBlockData(ctx context.Context, blockDis
 	return
 }
 
+// the reply is cached under the hash we read before sending the relay, if the chain tracker saw a fork since then
+// the node might have answered from either branch so the reply isn't cached
+func (rpcps *RPCProviderServer) blockHashUnchanged(requestedBlock int64, requestedBlockHash []byte, finalized bool) bool {
+	if finalized || requestedBlockHash == nil {
+		return true
+	}
+	_, requestedHashes, _, err := rpcps.reliabilityManager.GetLatestBlockData(spectypes.NOT_APPLICABLE, spectypes.NOT_APPLICABLE, requestedBlock)
+	if err != nil || len(requestedHashes) != 1 {
+		return false
+	}
+	return bytes.Equal([]byte(requestedHashes[0].Hash), requestedBlockHash)
+}
+
 func (rpcps *RPCProviderServer) processUnsubscribe(ctx context.Context, apiName string, consumerAddr sdk.AccAddress, reqParams interface{}, epoch uint64) error {
 	var subscriptionID string
 	switch reqParamsCasted := reqParams.(type) {
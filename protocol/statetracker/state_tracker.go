@@ -56,6 +56,13 @@ func NewStateTracker(ctx context.Context, txFactory tx.Factory, clientCtx client
 	if err != nil {
 		return nil, utils.LavaFormatError("failed getting blockResults after retries", err)
 	}
+	if updaters.EventStreamEnabled {
+		err = eventTracker.StartEventStream(ctx)
+		if err != nil {
+			utils.LavaFormatWarning("failed starting the lava event stream, polling block results instead", err)
+			updaters.EventStreamEnabled = false
+		}
+	}
 	specQueryClient := spectypes.NewQueryClient(clientCtx)
 	var specResponse *spectypes.QueryGetSpecResponse
 	for i := 0; i < updaters.BlockResultRetry; i++ {
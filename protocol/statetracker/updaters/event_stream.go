@@ -0,0 +1,302 @@
+package updaters
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/lavaslices"
+)
+
+const (
+	EventStreamSubscriber = "lava-state-tracker"
+	// how long UpdateBlockResults waits for a block to arrive on the stream before polling it
+	EventStreamBlockWait      = time.Second
+	EventStreamPollInterval   = 10 * time.Millisecond
+	EventStreamBlocksToKeep   = 50
+	EventStreamOutCapacity    = 100
+	EventStreamResubscribeIn  = 5 * time.Second
+	newBlockHeaderEventsQuery = "tm.event='NewBlockHeader'"
+)
+
+// when enabled the event tracker reads the lava events from the websocket event stream instead of polling the block results
+var EventStreamEnabled = false
+
+// the node only streams the txs of the msgs that emit the tracked tx events
+func txEventsQueries() []string {
+	msgTypes := []string{}
+	for _, msgType := range trackedTxEvents {
+		if !lavaslices.Contains(msgTypes, msgType) {
+			msgTypes = append(msgTypes, msgType)
+		}
+	}
+	sort.Strings(msgTypes)
+	queries := make([]string, 0, len(msgTypes))
+	for _, msgType := range msgTypes {
+		queries = append(queries, fmt.Sprintf("tm.event='Tx' AND message.action='%s'", msgType))
+	}
+	return queries
+}
+
+type eventsClient interface {
+	Start() error
+	IsRunning() bool
+	Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (out <-chan ctypes.ResultEvent, err error)
+	UnsubscribeAll(ctx context.Context, subscriber string) error
+}
+
+func TryIntoEventsClient(cl client.TendermintRPC) (eventsClient, error) {
+	ec, ok := cl.(eventsClient)
+	if !ok {
+		return nil, fmt.Errorf("client does not implement eventsClient: %T", cl)
+	}
+	return ec, nil
+}
+
+// the events of a block collected from the stream. only the txs of the tracked msgs are streamed so their count is unknown,
+// the block is complete once its header arrived and either it has no txs or the header of a later block arrived,
+// the node publishes the txs of a block before the header of the next one
+type streamedBlock struct {
+	blockResults *ctypes.ResultBlockResults
+	headerSeen   bool
+	numTxs       int64
+	nextSeen     bool
+}
+
+func (sb *streamedBlock) complete() bool {
+	return sb.headerSeen && (sb.numTxs == 0 || sb.nextSeen)
+}
+
+func filterStreamedEvents(events []abci.Event, eventTypes []string) []abci.Event {
+	filtered := []abci.Event{}
+	for _, event := range events {
+		if lavaslices.Contains(eventTypes, event.Type) {
+			filtered = append(filtered, event)
+		}
+	}
+	return filtered
+}
+
+func trackedTxEventTypes() []string {
+	eventTypes := make([]string, 0, len(trackedTxEvents))
+	for eventType := range trackedTxEvents {
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes
+}
+
+// subscribes to the lava node's event stream, blocks that arrive on it are not polled by UpdateBlockResults
+func (et *EventTracker) StartEventStream(ctx context.Context) error {
+	ec, err := TryIntoEventsClient(et.ClientCtx.Client)
+	if err != nil {
+		return utils.LavaFormatWarning("lava client doesn't support event streaming", err)
+	}
+	if !ec.IsRunning() {
+		err = ec.Start()
+		if err != nil {
+			return utils.LavaFormatWarning("failed starting lava event stream", err)
+		}
+	}
+	stream, err := et.subscribeEventStream(ctx, ec)
+	if err != nil {
+		return err
+	}
+	go et.readEventStream(ctx, ec, stream)
+	return nil
+}
+
+// the subscriptions of the event stream, the tx subscriptions are merged into one channel
+type eventStream struct {
+	headers <-chan ctypes.ResultEvent
+	txs     chan ctypes.ResultEvent
+	closed  chan struct{}
+	cancel  context.CancelFunc
+}
+
+func (et *EventTracker) subscribeEventStream(ctx context.Context, ec eventsClient) (*eventStream, error) {
+	headers, err := ec.Subscribe(ctx, EventStreamSubscriber, newBlockHeaderEventsQuery, EventStreamOutCapacity)
+	if err != nil {
+		return nil, utils.LavaFormatWarning("failed subscribing to lava new block header events", err)
+	}
+	streamCtx, cancel := context.WithCancel(ctx)
+	stream := &eventStream{
+		headers: headers,
+		txs:     make(chan ctypes.ResultEvent, EventStreamOutCapacity),
+		closed:  make(chan struct{}),
+		cancel:  cancel,
+	}
+	var closeOnce sync.Once
+	for _, query := range txEventsQueries() {
+		txs, err := ec.Subscribe(ctx, EventStreamSubscriber, query, EventStreamOutCapacity)
+		if err != nil {
+			cancel()
+			_ = ec.UnsubscribeAll(ctx, EventStreamSubscriber)
+			return nil, utils.LavaFormatWarning("failed subscribing to lava tx events", err, utils.LogAttr("query", query))
+		}
+		go func() {
+			for {
+				select {
+				case <-streamCtx.Done():
+					return
+				case event, ok := <-txs:
+					if !ok {
+						closeOnce.Do(func() { close(stream.closed) })
+						return
+					}
+					select {
+					case stream.txs <- event:
+					case <-streamCtx.Done():
+						return
+					}
+				}
+			}
+		}()
+	}
+	utils.LavaFormatInfo("subscribed to lava event stream")
+	return stream, nil
+}
+
+func (et *EventTracker) readEventStream(ctx context.Context, ec eventsClient, stream *eventStream) {
+	for {
+		select {
+		case <-ctx.Done():
+			stream.cancel()
+			_ = ec.UnsubscribeAll(context.Background(), EventStreamSubscriber)
+			return
+		case event, ok := <-stream.headers:
+			if ok {
+				// the txs of the previous block are published before this header, read the ones already received first
+				et.drainStreamedTxs(stream.txs)
+				if data, isHeader := event.Data.(tmtypes.EventDataNewBlockHeader); isHeader {
+					et.addStreamedBlockHeader(data)
+				}
+				continue
+			}
+		case event := <-stream.txs:
+			if data, isTx := event.Data.(tmtypes.EventDataTx); isTx {
+				et.addStreamedTx(data)
+			}
+			continue
+		case <-stream.closed:
+		}
+		// a subscription was closed, blocks are polled until we resubscribe
+		utils.LavaFormatWarning("lava event stream closed, falling back to polling block results", nil)
+		stream.cancel()
+		_ = ec.UnsubscribeAll(ctx, EventStreamSubscriber)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(EventStreamResubscribeIn):
+			}
+			newStream, err := et.subscribeEventStream(ctx, ec)
+			if err == nil {
+				stream = newStream
+				break
+			}
+		}
+	}
+}
+
+func (et *EventTracker) drainStreamedTxs(txs <-chan ctypes.ResultEvent) {
+	for {
+		select {
+		case event := <-txs:
+			if data, isTx := event.Data.(tmtypes.EventDataTx); isTx {
+				et.addStreamedTx(data)
+			}
+		default:
+			return
+		}
+	}
+}
+
+// must be called with the stream lock held
+func (et *EventTracker) getStreamedBlockUnsafe(height int64) *streamedBlock {
+	if et.streamedBlocks == nil {
+		et.streamedBlocks = map[int64]*streamedBlock{}
+	}
+	block, ok := et.streamedBlocks[height]
+	if !ok {
+		block = &streamedBlock{blockResults: &ctypes.ResultBlockResults{Height: height}}
+		et.streamedBlocks[height] = block
+	}
+	return block
+}
+
+func (et *EventTracker) addStreamedBlockHeader(data tmtypes.EventDataNewBlockHeader) {
+	height := data.Header.Height
+	et.streamLock.Lock()
+	defer et.streamLock.Unlock()
+	block := et.getStreamedBlockUnsafe(height)
+	block.headerSeen = true
+	block.numTxs = data.NumTxs
+	block.blockResults.BeginBlockEvents = filterStreamedEvents(data.ResultBeginBlock.Events, trackedBlockEvents)
+	block.blockResults.EndBlockEvents = filterStreamedEvents(data.ResultEndBlock.Events, trackedBlockEvents)
+	for streamedHeight, streamed := range et.streamedBlocks {
+		if streamedHeight < height {
+			streamed.nextSeen = true
+		}
+	}
+	if height > et.latestStreamedBlock {
+		et.latestStreamedBlock = height
+	}
+	for streamedHeight := range et.streamedBlocks {
+		if streamedHeight <= height-EventStreamBlocksToKeep {
+			delete(et.streamedBlocks, streamedHeight)
+		}
+	}
+}
+
+func (et *EventTracker) addStreamedTx(data tmtypes.EventDataTx) {
+	et.streamLock.Lock()
+	defer et.streamLock.Unlock()
+	if data.Height <= et.latestStreamedBlock-EventStreamBlocksToKeep {
+		return
+	}
+	block := et.getStreamedBlockUnsafe(data.Height)
+	events := filterStreamedEvents(data.Result.Events, trackedTxEventTypes())
+	if len(events) > 0 {
+		block.blockResults.TxsResults = append(block.blockResults.TxsResults, &abci.ResponseDeliverTx{Events: events})
+	}
+}
+
+// returns the results of the block if it is complete on the stream. if the stream already moved past the block, or its header
+// arrived but its txs may still be streaming, it is polled. otherwise we wait a bit for the header
+func (et *EventTracker) getStreamedBlockResults(height int64) (*ctypes.ResultBlockResults, bool) {
+	if !EventStreamEnabled {
+		return nil, false
+	}
+	deadline := time.Now().Add(EventStreamBlockWait)
+	for {
+		et.streamLock.Lock()
+		block, ok := et.streamedBlocks[height]
+		if ok && block.complete() {
+			delete(et.streamedBlocks, height)
+			et.streamLagging = false
+			et.streamLock.Unlock()
+			return block.blockResults, true
+		}
+		missed := (ok && block.headerSeen) || et.latestStreamedBlock > height || et.latestStreamedBlock == 0 || et.streamLagging
+		et.streamLock.Unlock()
+		if missed {
+			return nil, false
+		}
+		if time.Now().After(deadline) {
+			// the stream is behind, don't wait for it on the next blocks until it catches up
+			et.streamLock.Lock()
+			et.streamLagging = true
+			et.streamLock.Unlock()
+			utils.LavaFormatDebug("block did not arrive on the lava event stream, polling it", utils.LogAttr("block", height))
+			return nil, false
+		}
+		time.Sleep(EventStreamPollInterval)
+	}
+}
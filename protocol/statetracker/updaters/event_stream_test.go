@@ -0,0 +1,74 @@
+package updaters
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func streamedHeader(height, numTxs int64, endBlockEvents ...abci.Event) tmtypes.EventDataNewBlockHeader {
+	header := tmtypes.Header{Height: height}
+	return tmtypes.EventDataNewBlockHeader{Header: header, NumTxs: numTxs, ResultEndBlock: abci.ResponseEndBlock{Events: endBlockEvents}}
+}
+
+func streamedTx(height int64, events ...abci.Event) tmtypes.EventDataTx {
+	return tmtypes.EventDataTx{TxResult: abci.TxResult{Height: height, Result: abci.ResponseDeliverTx{Events: events}}}
+}
+
+func TestEventStream(t *testing.T) {
+	EventStreamEnabled = true
+	defer func() { EventStreamEnabled = false }()
+	et := &EventTracker{}
+	specEvent := abci.Event{Type: specModifyEventType}
+	paymentEvent := abci.Event{Type: relayPaymentEventType}
+	otherEvent := abci.Event{Type: "transfer"}
+
+	// block 10 has a tx, it is polled until the header of a later block arrives after its txs
+	et.addStreamedBlockHeader(streamedHeader(10, 1, specEvent, otherEvent))
+	et.addStreamedTx(streamedTx(10, paymentEvent, otherEvent))
+	_, ok := et.getStreamedBlockResults(10)
+	require.False(t, ok)
+	require.False(t, et.streamLagging)
+	et.addStreamedBlockHeader(streamedHeader(11, 0, specEvent))
+	blockResults, ok := et.getStreamedBlockResults(10)
+	require.True(t, ok)
+	// only the events the updaters read are kept
+	require.Equal(t, []abci.Event{specEvent}, blockResults.EndBlockEvents)
+	require.Len(t, blockResults.TxsResults, 1)
+	require.Equal(t, []abci.Event{paymentEvent}, blockResults.TxsResults[0].Events)
+
+	// the updaters read the streamed block without polling it
+	require.NoError(t, et.UpdateBlockResults(11))
+	updated, err := et.getLatestSpecModifyEvents(11)
+	require.NoError(t, err)
+	require.True(t, updated)
+
+	// the header of block 12 was missed and the stream moved on, it has to be polled
+	et.addStreamedBlockHeader(streamedHeader(13, 0))
+	_, ok = et.getStreamedBlockResults(12)
+	require.False(t, ok)
+	_, ok = et.getStreamedBlockResults(13)
+	require.True(t, ok)
+
+	// the stream is behind, after waiting for it once we poll without waiting until it catches up
+	_, ok = et.getStreamedBlockResults(14)
+	require.False(t, ok)
+	require.True(t, et.streamLagging)
+	et.addStreamedBlockHeader(streamedHeader(15, 0))
+	_, ok = et.getStreamedBlockResults(15)
+	require.True(t, ok)
+	require.False(t, et.streamLagging)
+
+	// old blocks are dropped
+	et.addStreamedBlockHeader(streamedHeader(15+EventStreamBlocksToKeep, 0))
+	require.Len(t, et.streamedBlocks, 1)
+}
+
+func TestEventStreamQueries(t *testing.T) {
+	require.Equal(t, []string{
+		"tm.event='Tx' AND message.action='/lavanet.lava.conflict.MsgDetection'",
+		"tm.event='Tx' AND message.action='/lavanet.lava.pairing.MsgRelayPayment'",
+	}, txEventsQueries())
+}
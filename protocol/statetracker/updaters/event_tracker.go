@@ -6,10 +6,9 @@ import (
 	"sync"
 	"time"
 
-	"golang.org/x/exp/slices"
-
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/protocol/rpcprovider/reliabilitymanager"
 	"github.com/lavanet/lava/protocol/rpcprovider/rewardserver"
 	"github.com/lavanet/lava/utils"
@@ -25,11 +24,41 @@ const (
 
 var TimeOutForFetchingLavaBlocks = time.Second * 5
 
+// the lava events read by the updaters, the event stream drops all other events
+var (
+	relayPaymentEventType          = utils.EventPrefix + pairingtypes.RelayPaymentEventName
+	conflictVoteDetectionEventType = utils.EventPrefix + conflicttypes.ConflictVoteDetectionEventName
+	conflictVoteRevealEventType    = utils.EventPrefix + conflicttypes.ConflictVoteRevealEventName
+	conflictVoteResolvedEventType  = utils.EventPrefix + conflicttypes.ConflictVoteResolvedEventName
+	specModifyEventType            = utils.EventPrefix + spectypes.SpecModifyEventName
+	specRefreshEventType           = utils.EventPrefix + spectypes.SpecRefreshEventName
+	paramChangeEventType           = utils.EventPrefix + "param_change"
+
+	// tx events, by the type url of the msg that emits them
+	trackedTxEvents = map[string]string{
+		relayPaymentEventType:          sdk.MsgTypeURL(&pairingtypes.MsgRelayPayment{}),
+		conflictVoteDetectionEventType: sdk.MsgTypeURL(&conflicttypes.MsgDetection{}),
+	}
+	// begin block and end block events
+	trackedBlockEvents = []string{
+		conflictVoteRevealEventType,
+		conflictVoteResolvedEventType,
+		specModifyEventType,
+		specRefreshEventType,
+		paramChangeEventType,
+	}
+)
+
 type EventTracker struct {
 	lock               sync.RWMutex
 	ClientCtx          client.Context
 	blockResults       *ctypes.ResultBlockResults
 	latestUpdatedBlock int64
+	// blocks received on the event stream, see event_stream.go
+	streamLock          sync.Mutex
+	streamedBlocks      map[int64]*streamedBlock
+	latestStreamedBlock int64
+	streamLagging       bool
 }
 
 func (et *EventTracker) UpdateBlockResults(latestBlock int64) (err error) {
@@ -51,11 +80,16 @@ func (et *EventTracker) UpdateBlockResults(latestBlock int64) (err error) {
 		latestBlock = res.SyncInfo.LatestBlockHeight
 	}
 
+	blockResults, streamed := et.getStreamedBlockResults(latestBlock)
+	if streamed {
+		et.setBlockResults(latestBlock, blockResults)
+		return nil
+	}
+
 	brp, err := TryIntoTendermintRPC(et.ClientCtx.Client)
 	if err != nil {
 		return utils.LavaFormatError("could not get block result provider", err)
 	}
-	var blockResults *ctypes.ResultBlockResults
 	for i := 0; i < BlockResultRetry; i++ {
 		timeoutCtx, cancel := context.WithTimeout(ctx, TimeOutForFetchingLavaBlocks)
 		blockResults, err = brp.BlockResults(timeoutCtx, &latestBlock)
@@ -68,6 +102,11 @@ func (et *EventTracker) UpdateBlockResults(latestBlock int64) (err error) {
 	if err != nil {
 		return utils.LavaFormatError("could not get block result", err)
 	}
+	et.setBlockResults(latestBlock, blockResults)
+	return nil
+}
+
+func (et *EventTracker) setBlockResults(latestBlock int64, blockResults *ctypes.ResultBlockResults) {
 	// lock for update after successful block result query
 	et.lock.Lock()
 	defer et.lock.Unlock()
@@ -77,7 +116,6 @@ func (et *EventTracker) UpdateBlockResults(latestBlock int64) (err error) {
 	} else {
 		utils.LavaFormatDebug("event tracker got an outdated block", utils.Attribute{Key: "block", Value: latestBlock}, utils.Attribute{Key: "latestUpdatedBlock", Value: et.latestUpdatedBlock})
 	}
-	return nil
 }
 
 func (et *EventTracker) getLatestPaymentEvents() (payments []*rewardserver.PaymentRequest, err error) {
@@ -87,7 +125,7 @@ func (et *EventTracker) getLatestPaymentEvents() (payments []*rewardserver.Payme
 	for _, tx := range transactionResults {
 		events := tx.Events
 		for _, event := range events {
-			if event.Type == utils.EventPrefix+pairingtypes.RelayPaymentEventName {
+			if event.Type == relayPaymentEventType {
 				paymentList, err := rewardserver.BuildPaymentFromRelayPaymentEvent(event, et.latestUpdatedBlock)
 				if err != nil {
 					return nil, utils.LavaFormatError("failed relay_payment_event parsing", err, utils.Attribute{Key: "event", Value: event})
@@ -109,7 +147,7 @@ func (et *EventTracker) getLatestVersionEvents(latestBlock int64) (updated bool,
 		return false, utils.LavaFormatWarning("event results are different than expected", nil, utils.Attribute{Key: "requested latestBlock", Value: latestBlock}, utils.Attribute{Key: "current latestBlock", Value: et.latestUpdatedBlock})
 	}
 	for _, event := range et.blockResults.EndBlockEvents {
-		if event.Type == utils.EventPrefix+"param_change" {
+		if event.Type == paramChangeEventType {
 			for _, attribute := range event.Attributes {
 				if attribute.Key == "param" && attribute.Value == "Version" {
 					return true, nil
@@ -128,7 +166,7 @@ func (et *EventTracker) getLatestDowntimeParamsUpdateEvents(latestBlock int64) (
 		return false, utils.LavaFormatWarning("event results are different than expected", nil, utils.Attribute{Key: "requested latestBlock", Value: latestBlock}, utils.Attribute{Key: "current latestBlock", Value: et.latestUpdatedBlock})
 	}
 	for _, event := range et.blockResults.EndBlockEvents {
-		if event.Type == utils.EventPrefix+"param_change" {
+		if event.Type == paramChangeEventType {
 			for _, attribute := range event.Attributes {
 				if attribute.Key == "param" && (attribute.Value == "DowntimeDuration" || attribute.Value == "EpochDuration") {
 					return true, nil
@@ -146,12 +184,8 @@ func (et *EventTracker) getLatestSpecModifyEvents(latestBlock int64) (updated bo
 	if et.latestUpdatedBlock != latestBlock {
 		return false, utils.LavaFormatWarning("event results are different than expected", nil, utils.Attribute{Key: "requested latestBlock", Value: latestBlock}, utils.Attribute{Key: "current latestBlock", Value: et.latestUpdatedBlock})
 	}
-	eventsListToListenTo := []string{
-		utils.EventPrefix + spectypes.SpecModifyEventName,
-		utils.EventPrefix + spectypes.SpecRefreshEventName,
-	}
 	for _, event := range et.blockResults.EndBlockEvents {
-		if slices.Contains(eventsListToListenTo, event.Type) {
+		if event.Type == specModifyEventType || event.Type == specRefreshEventType {
 			utils.LavaFormatInfo("Spec update event identified", utils.LogAttr("Event", event.Type))
 			return true, nil
 		}
@@ -169,7 +203,7 @@ func (et *EventTracker) getLatestVoteEvents(latestBlock int64) (votes []*reliabi
 	for _, tx := range transactionResults {
 		events := tx.Events
 		for _, event := range events {
-			if event.Type == utils.EventPrefix+conflicttypes.ConflictVoteDetectionEventName {
+			if event.Type == conflictVoteDetectionEventType {
 				vote, err := reliabilitymanager.BuildVoteParamsFromDetectionEvent(event)
 				if err != nil {
 					return nil, utils.LavaFormatError("failed conflict_vote_detection_event parsing", err, utils.Attribute{Key: "event", Value: event})
@@ -182,7 +216,7 @@ func (et *EventTracker) getLatestVoteEvents(latestBlock int64) (votes []*reliabi
 
 	beginBlockEvents := et.blockResults.BeginBlockEvents
 	for _, event := range beginBlockEvents {
-		if event.Type == utils.EventPrefix+conflicttypes.ConflictVoteRevealEventName {
+		if event.Type == conflictVoteRevealEventType {
 			voteID, voteDeadline, err := reliabilitymanager.BuildBaseVoteDataFromEvent(event)
 			if err != nil {
 				return nil, utils.LavaFormatError("failed conflict_vote_reveal_event parsing", err, utils.Attribute{Key: "event", Value: event})
@@ -191,7 +225,7 @@ func (et *EventTracker) getLatestVoteEvents(latestBlock int64) (votes []*reliabi
 			utils.LavaFormatDebug("conflict_vote_reveal_event", utils.Attribute{Key: "voteID", Value: voteID})
 			votes = append(votes, vote_reveal)
 		}
-		if event.Type == utils.EventPrefix+conflicttypes.ConflictVoteResolvedEventName {
+		if event.Type == conflictVoteResolvedEventType {
 			voteID, _, err := reliabilitymanager.BuildBaseVoteDataFromEvent(event)
 			if err != nil {
 				if !reliabilitymanager.NoVoteDeadline.Is(err) {
@@ -505,6 +505,18 @@ func (ts *Tester) TxSubscriptionAutoRenewal(creator, consumer, planIndex string,
 	return err
 }
 
+func (ts *Tester) TxSubscriptionTopUpCu(creator, consumer string, cu uint64) error {
+	msg := subscriptiontypes.NewMsgTopUpCu(creator, consumer, cu)
+	_, err := ts.Servers.SubscriptionServer.TopUpCu(ts.GoCtx, msg)
+	return err
+}
+
+func (ts *Tester) TxSubscriptionTransfer(creator, newConsumer string) error {
+	msg := subscriptiontypes.NewMsgTransferSubscription(creator, newConsumer)
+	_, err := ts.Servers.SubscriptionServer.TransferSubscription(ts.GoCtx, msg)
+	return err
+}
+
 // TxProjectAddKeys: implement 'tx project add-keys'
 func (ts *Tester) TxProjectAddKeys(projectID, creator string, projectKeys ...projectstypes.ProjectKey) error {
 	msg := projectstypes.MsgAddKeys{
@@ -654,6 +666,26 @@ func (ts *Tester) TxPairingFreezeProvider(addr, chainID string) (*pairingtypes.M
 	return ts.Servers.PairingServer.FreezeProvider(ts.GoCtx, msg)
 }
 
+// TxPairingBailProvider: implement 'tx pairing bail'
+func (ts *Tester) TxPairingBailProvider(addr, chainID string, bail sdk.Coin) (*pairingtypes.MsgBailProviderResponse, error) {
+	msg := &pairingtypes.MsgBailProvider{
+		Creator: addr,
+		ChainID: chainID,
+		Bail:    bail,
+	}
+	return ts.Servers.PairingServer.BailProvider(ts.GoCtx, msg)
+}
+
+// TxPairingSetProviderOperator: implement 'tx pairing set-operator'
+func (ts *Tester) TxPairingSetProviderOperator(addr string, chainIDs []string, operator string) (*pairingtypes.MsgSetProviderOperatorResponse, error) {
+	msg := &pairingtypes.MsgSetProviderOperator{
+		Creator:  addr,
+		ChainIds: chainIDs,
+		Operator: operator,
+	}
+	return ts.Servers.PairingServer.SetProviderOperator(ts.GoCtx, msg)
+}
+
 // TxPairingUnfreezeProvider: implement 'tx pairing unfreeze'
 func (ts *Tester) TxPairingUnfreezeProvider(addr, chainID string) (*pairingtypes.MsgUnfreezeProviderResponse, error) {
 	msg := &pairingtypes.MsgUnfreezeProvider{
@@ -33,6 +33,9 @@ func (k mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
 func (k mockAccountKeeper) SetModuleAccount(sdk.Context, authtypes.ModuleAccountI) {
 }
 
+func (k mockAccountKeeper) SetAccount(sdk.Context, authtypes.AccountI) {
+}
+
 // mock bank keeper
 var balance map[string]sdk.Coins = make(map[string]sdk.Coins)
 
@@ -22,6 +22,13 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		FinalizationFraudList: []types.FinalizationFraud{
+			{
+				ChainID:  "LAV1",
+				Provider: "provider",
+				Block:    10,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -34,5 +41,6 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.ConflictVoteList, got.ConflictVoteList)
+	require.ElementsMatch(t, genesisState.FinalizationFraudList, got.FinalizationFraudList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
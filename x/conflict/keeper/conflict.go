@@ -2,16 +2,55 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/sigs"
 	"github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
-func (k Keeper) ValidateFinalizationConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
-	return nil
+// ValidateFinalizationConflict validates the signed finalization data of the replies. it returns the fraud of the
+// provider when its signatures prove it, a reply that claims a non finalized block as finalized or two replies of the
+// same provider with different hashes for a finalized block. when two providers disagree on a finalized block the
+// conflict is valid but the offender can't be determined from the signatures alone, so no fraud is returned
+func (k Keeper) ValidateFinalizationConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (*types.FinalizationFraud, error) {
+	provider0, finalizedBlocks0, err := k.validateFinalizationReply(ctx, conflictData.RelayReply0, conflictData.RelaySession0, clientAddr)
+	if err != nil {
+		return nil, fmt.Errorf("finalization data 0: %w", err)
+	}
+	chainID := conflictData.RelaySession0.SpecId
+
+	// the provider signed a block that isn't finalized yet as finalized
+	for _, block := range sortedBlocks(finalizedBlocks0) {
+		if !k.specKeeper.IsFinalizedBlock(ctx, chainID, block, conflictData.RelayReply0.LatestBlock) {
+			return newFinalizationFraud(ctx, chainID, provider0, block, clientAddr), nil
+		}
+	}
+
+	if conflictData.RelayReply1 == nil {
+		return nil, fmt.Errorf("no conflict in the finalization data of provider %s", provider0)
+	}
+	provider1, finalizedBlocks1, err := k.validateFinalizationReply(ctx, conflictData.RelayReply1, conflictData.RelaySession1, clientAddr)
+	if err != nil {
+		return nil, fmt.Errorf("finalization data 1: %w", err)
+	}
+	if chainID != conflictData.RelaySession1.SpecId {
+		return nil, fmt.Errorf("mismatching chainID between finalization data %s, %s", chainID, conflictData.RelaySession1.SpecId)
+	}
+	block, found := k.conflictingFinalizedBlock(ctx, chainID, conflictData.RelayReply0.LatestBlock, finalizedBlocks0, conflictData.RelayReply1.LatestBlock, finalizedBlocks1)
+	if !found {
+		return nil, fmt.Errorf("no conflict between providers finalization data")
+	}
+	if provider0 != provider1 {
+		return nil, nil
+	}
+	return newFinalizationFraud(ctx, chainID, provider0, block, clientAddr), nil
 }
 
 func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.ResponseConflict, clientAddr sdk.AccAddress) error {
@@ -90,7 +129,7 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 		return fmt.Errorf("conflict data 1: %s", err)
 	}
 	// 3. validate providers signatures and stakeEntry for that epoch
-	providerAddressFromRelayReplyAndVerifyStakeEntry := func(requestData *pairingtypes.RelayPrivateData, reply *types.ReplyMetadata, first bool) (providerAddress sdk.AccAddress, err error) {
+	providerAddressFromRelayReplyAndVerifyStakeEntry := func(request *pairingtypes.RelayRequest, reply *types.ReplyMetadata, first bool) (providerAddress sdk.AccAddress, err error) {
 		print_st := "first"
 		if !first {
 			print_st = "second"
@@ -105,15 +144,23 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 		}
 		_, err = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, providerAddress, epochStart)
 		if err != nil {
-			return nil, fmt.Errorf("did not find a stake entry for %s provider %s on epoch %d, chainID %s error: %s", print_st, providerAddress, epochStart, chainID, err.Error())
+			// the reply can be signed by the operator of the relay's provider
+			relayProvider, addrErr := sdk.AccAddressFromBech32(request.RelaySession.Provider)
+			if addrErr != nil {
+				return nil, fmt.Errorf("did not find a stake entry for %s provider %s on epoch %d, chainID %s error: %s", print_st, providerAddress, epochStart, chainID, err.Error())
+			}
+			stakeEntry, entryErr := k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, relayProvider, epochStart)
+			if entryErr != nil || !stakeEntry.IsOperator(providerAddress.String()) {
+				return nil, fmt.Errorf("did not find a stake entry for %s provider %s on epoch %d, chainID %s error: %s", print_st, providerAddress, epochStart, chainID, err.Error())
+			}
 		}
 		return providerAddress, nil
 	}
-	providerAccAddress0, err := providerAddressFromRelayReplyAndVerifyStakeEntry(conflictData.ConflictRelayData0.Request.RelayData, conflictData.ConflictRelayData0.Reply, true)
+	providerAccAddress0, err := providerAddressFromRelayReplyAndVerifyStakeEntry(conflictData.ConflictRelayData0.Request, conflictData.ConflictRelayData0.Reply, true)
 	if err != nil {
 		return err
 	}
-	providerAccAddress1, err := providerAddressFromRelayReplyAndVerifyStakeEntry(conflictData.ConflictRelayData1.Request.RelayData, conflictData.ConflictRelayData1.Reply, false)
+	providerAccAddress1, err := providerAddressFromRelayReplyAndVerifyStakeEntry(conflictData.ConflictRelayData1.Request, conflictData.ConflictRelayData1.Reply, false)
 	if err != nil {
 		return err
 	}
@@ -157,6 +204,196 @@ func (k Keeper) ValidateResponseConflict(ctx sdk.Context, conflictData *types.Re
 	return nil
 }
 
-func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) error {
+// ValidateSameProviderConflict validates two replies of the same provider that have different hashes for a finalized
+// block, and returns the fraud of the provider
+func (k Keeper) ValidateSameProviderConflict(ctx sdk.Context, conflictData *types.FinalizationConflict, clientAddr sdk.AccAddress) (*types.FinalizationFraud, error) {
+	provider0, finalizedBlocks0, err := k.validateFinalizationReply(ctx, conflictData.RelayReply0, conflictData.RelaySession0, clientAddr)
+	if err != nil {
+		return nil, fmt.Errorf("finalization data 0: %w", err)
+	}
+	provider1, finalizedBlocks1, err := k.validateFinalizationReply(ctx, conflictData.RelayReply1, conflictData.RelaySession1, clientAddr)
+	if err != nil {
+		return nil, fmt.Errorf("finalization data 1: %w", err)
+	}
+	chainID := conflictData.RelaySession0.SpecId
+	if chainID != conflictData.RelaySession1.SpecId {
+		return nil, fmt.Errorf("mismatching chainID between finalization data %s, %s", chainID, conflictData.RelaySession1.SpecId)
+	}
+	if provider0 != provider1 {
+		return nil, fmt.Errorf("finalization data was signed by different providers %s, %s", provider0, provider1)
+	}
+	block, found := k.conflictingFinalizedBlock(ctx, chainID, conflictData.RelayReply0.LatestBlock, finalizedBlocks0, conflictData.RelayReply1.LatestBlock, finalizedBlocks1)
+	if !found {
+		return nil, fmt.Errorf("no conflict in the finalization data of provider %s", provider0)
+	}
+	return newFinalizationFraud(ctx, chainID, provider0, block, clientAddr), nil
+}
+
+// validateFinalizationReply verifies the consumer signed the relay session and a provider that was staked on the
+// session's chain (or its operator) signed the reply's finalization data for it. it returns the provider's address
+// and the finalized blocks hashes of the reply
+func (k Keeper) validateFinalizationReply(ctx sdk.Context, reply *pairingtypes.RelayReply, relaySession *pairingtypes.RelaySession, clientAddr sdk.AccAddress) (provider string, finalizedBlocks map[int64]string, err error) {
+	if reply == nil || relaySession == nil {
+		return "", nil, fmt.Errorf("missing relay reply or relay session")
+	}
+	chainID := relaySession.SpecId
+	epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(relaySession.Epoch))
+	if err != nil {
+		return "", nil, fmt.Errorf("could not find epoch for block %d", relaySession.Epoch)
+	}
+	epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(relaySession.Epoch))
+	if err != nil {
+		return "", nil, fmt.Errorf("could not get EpochBlocks param")
+	}
+	span := k.VoteStartSpan(ctx) * epochBlocks
+	if uint64(ctx.BlockHeight())-epochStart >= span {
+		return "", nil, fmt.Errorf("conflict was received outside of the allowed span, current: %d, span %d - %d", ctx.BlockHeight(), epochStart, epochStart+span)
+	}
+
+	_, _, err = k.pairingKeeper.VerifyPairingData(ctx, chainID, epochStart)
+	if err != nil {
+		return "", nil, err
+	}
+
+	_, err = k.pairingKeeper.GetProjectData(ctx, clientAddr, chainID, epochStart)
+	if err != nil {
+		return "", nil, fmt.Errorf("did not find a project for %s on epoch %d, chainID %s error: %s", clientAddr, epochStart, chainID, err.Error())
+	}
+
+	pubKey, err := sigs.RecoverPubKey(*relaySession)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid consumer signature in relay session, error: %s", err.Error())
+	}
+	derived_clientAddr, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid consumer address from signature in relay session, error: %s", err.Error())
+	}
+	if !derived_clientAddr.Equals(clientAddr) {
+		return "", nil, fmt.Errorf("mismatching consumer address signature and msg.Creator in relay session %s , %s", derived_clientAddr, clientAddr)
+	}
+
+	relayFinalization := pairingtypes.NewRelayFinalization(pairingtypes.NewRelayExchange(pairingtypes.RelayRequest{RelaySession: relaySession}, *reply), clientAddr)
+	pubKey, err = sigs.RecoverPubKey(relayFinalization)
+	if err != nil {
+		return "", nil, fmt.Errorf("RecoverPubKey provider finalization data: %w", err)
+	}
+	signerAddress, err := sdk.AccAddressFromHexUnsafe(pubKey.Address().String())
+	if err != nil {
+		return "", nil, fmt.Errorf("AccAddressFromHex provider finalization data: %w", err)
+	}
+	stakeEntry, err := k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, signerAddress, epochStart)
+	if err != nil {
+		// the reply can be signed by the operator of the relay's provider
+		relayProvider, addrErr := sdk.AccAddressFromBech32(relaySession.Provider)
+		if addrErr != nil {
+			return "", nil, fmt.Errorf("did not find a stake entry for provider %s on epoch %d, chainID %s error: %s", signerAddress, epochStart, chainID, err.Error())
+		}
+		var entryErr error
+		stakeEntry, entryErr = k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, relayProvider, epochStart)
+		if entryErr != nil || !stakeEntry.IsOperator(signerAddress.String()) {
+			return "", nil, fmt.Errorf("did not find a stake entry for provider %s on epoch %d, chainID %s error: %s", signerAddress, epochStart, chainID, err.Error())
+		}
+	}
+
+	finalizedBlocks = map[int64]string{}
+	err = json.Unmarshal(reply.FinalizedBlocksHashes, &finalizedBlocks)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed unmarshalling finalized blocks hashes: %w", err)
+	}
+	return stakeEntry.Address, finalizedBlocks, nil
+}
+
+// conflictingFinalizedBlock returns the lowest block that is finalized in both replies and has different hashes
+func (k Keeper) conflictingFinalizedBlock(ctx sdk.Context, chainID string, latestBlock0 int64, finalizedBlocks0 map[int64]string, latestBlock1 int64, finalizedBlocks1 map[int64]string) (int64, bool) {
+	for _, block := range sortedBlocks(finalizedBlocks0) {
+		hash1, ok := finalizedBlocks1[block]
+		if !ok || hash1 == finalizedBlocks0[block] {
+			continue
+		}
+		if k.specKeeper.IsFinalizedBlock(ctx, chainID, block, latestBlock0) && k.specKeeper.IsFinalizedBlock(ctx, chainID, block, latestBlock1) {
+			return block, true
+		}
+	}
+	return 0, false
+}
+
+// the map iteration order is random, the blocks must be checked in a deterministic order
+func sortedBlocks(finalizedBlocks map[int64]string) []int64 {
+	blocks := maps.Keys(finalizedBlocks)
+	slices.Sort(blocks)
+	return blocks
+}
+
+func newFinalizationFraud(ctx sdk.Context, chainID, provider string, block int64, clientAddr sdk.AccAddress) *types.FinalizationFraud {
+	return &types.FinalizationFraud{
+		ChainID:     chainID,
+		Provider:    provider,
+		Block:       block,
+		ReportBlock: uint64(ctx.BlockHeight()),
+		Client:      clientAddr.String(),
+	}
+}
+
+// PenalizeFinalizationFraud slashes, freezes and jails the stake entry of a provider that was proven to sign conflicting
+// finalization data, the jail keeps the provider from unfreezing itself until the jail ends or the bail is paid.
+// the reporting consumer is rewarded with a part of the stake that was burned. a fraud is penalized once for each
+// provider and block
+func (k Keeper) PenalizeFinalizationFraud(ctx sdk.Context, fraud types.FinalizationFraud) error {
+	attrs := []utils.Attribute{
+		{Key: "provider", Value: fraud.Provider},
+		{Key: "chainID", Value: fraud.ChainID},
+		{Key: "block", Value: fraud.Block},
+		{Key: "client", Value: fraud.Client},
+	}
+	if _, found := k.GetFinalizationFraud(ctx, fraud.ChainID, fraud.Provider, fraud.Block); found {
+		return utils.LavaFormatWarning("finalization fraud was already reported", fmt.Errorf("duplicate finalization fraud"), attrs...)
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(fraud.Provider)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid provider address", err, attrs...)
+	}
+	clientAddr, err := sdk.AccAddressFromBech32(fraud.Client)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid client address", err, attrs...)
+	}
+
+	// the client's reward is paid from the slashed stake, the rest of it is burned
+	slashed, clientReward, err := k.pairingKeeper.SlashEntry(ctx, providerAddr, fraud.ChainID, FinalizationFraudSlashPercent, clientAddr, k.Rewards(ctx).ClientRewardPercent)
+	if err != nil {
+		if slashed.IsZero() {
+			return utils.LavaFormatWarning("failed to slash the provider of the finalization fraud", err, attrs...)
+		}
+		// some of the delegations were slashed, the penalty goes on with the amount that was slashed
+		utils.LavaFormatWarning("finalization fraud provider was partially slashed", err, append(attrs, utils.Attribute{Key: "slashed", Value: slashed})...)
+	}
+	err = k.pairingKeeper.FreezeProvider(ctx, fraud.Provider, []string{fraud.ChainID}, types.FreezeReasonFinalizationFraud)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to freeze the provider of the finalization fraud", err, attrs...)
+	}
+	stakeEntry, found, _ := k.epochstorageKeeper.GetStakeEntryByAddressCurrent(ctx, fraud.ChainID, providerAddr)
+	if !found {
+		return utils.LavaFormatError("finalization fraud provider stake entry not found after slash", fmt.Errorf("stake entry not found"), attrs...)
+	}
+	blocksToSave, err := k.epochstorageKeeper.BlocksToSave(ctx, uint64(ctx.BlockHeight()))
+	if err != nil {
+		return utils.LavaFormatError("failed to get the jail period of the finalization fraud", err, attrs...)
+	}
+	bail := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), stakeEntry.EffectiveStake().Quo(sdk.NewIntFromUint64(BailStakeDiv)))
+	err = k.pairingKeeper.JailEntry(ctx, providerAddr, fraud.ChainID, uint64(ctx.BlockHeight()), blocksToSave, bail)
+	if err != nil {
+		return utils.LavaFormatWarning("failed to jail the provider of the finalization fraud", err, attrs...)
+	}
+
+	k.SetFinalizationFraud(ctx, fraud)
+
+	details := map[string]string{
+		"provider":     fraud.Provider,
+		"chainID":      fraud.ChainID,
+		"block":        strconv.FormatInt(fraud.Block, 10),
+		"client":       fraud.Client,
+		"slashed":      slashed.String(),
+		"clientReward": clientReward.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ConflictFinalizationFraudEventName, details, "provider was penalized for conflicting finalization data")
 	return nil
 }
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/conflict/types"
+)
+
+// SetFinalizationFraud set a specific finalizationFraud in the store from its index
+func (k Keeper) SetFinalizationFraud(ctx sdk.Context, fraud types.FinalizationFraud) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizationFraudKeyPrefix))
+	b := k.cdc.MustMarshal(&fraud)
+	store.Set(types.FinalizationFraudKey(
+		fraud.ChainID,
+		fraud.Provider,
+		fraud.Block,
+	), b)
+}
+
+// GetFinalizationFraud returns a finalizationFraud from its index
+func (k Keeper) GetFinalizationFraud(
+	ctx sdk.Context,
+	chainID string,
+	provider string,
+	block int64,
+) (val types.FinalizationFraud, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizationFraudKeyPrefix))
+
+	b := store.Get(types.FinalizationFraudKey(
+		chainID,
+		provider,
+		block,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveFinalizationFraud removes a finalizationFraud from the store
+func (k Keeper) RemoveFinalizationFraud(
+	ctx sdk.Context,
+	chainID string,
+	provider string,
+	block int64,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizationFraudKeyPrefix))
+	store.Delete(types.FinalizationFraudKey(
+		chainID,
+		provider,
+		block,
+	))
+}
+
+// GetAllFinalizationFraud returns all finalizationFraud
+func (k Keeper) GetAllFinalizationFraud(ctx sdk.Context) (list []types.FinalizationFraud) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizationFraudKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FinalizationFraud
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveOldFinalizationFrauds removes the frauds that were reported before the earliest saved epoch.
+// the evidence of these frauds can no longer be verified, so they can't be reported again
+func (k Keeper) RemoveOldFinalizationFrauds(ctx sdk.Context) {
+	if !k.IsEpochStart(ctx) {
+		return
+	}
+	earliestEpoch := k.epochstorageKeeper.GetEarliestEpochStart(ctx)
+	for _, fraud := range k.GetAllFinalizationFraud(ctx) {
+		if fraud.ReportBlock < earliestEpoch {
+			k.RemoveFinalizationFraud(ctx, fraud.ChainID, fraud.Provider, fraud.Block)
+		}
+	}
+}
@@ -76,4 +76,5 @@ func (k Keeper) PushFixations(ctx sdk.Context) {
 
 func (k Keeper) BeginBlock(ctx sdk.Context) {
 	k.CheckAndHandleAllVotes(ctx)
+	k.RemoveOldFinalizationFrauds(ctx)
 }
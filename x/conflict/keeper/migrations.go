@@ -2,7 +2,9 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	v5 "github.com/lavanet/lava/x/conflict/migrations/v5"
+	"github.com/lavanet/lava/x/conflict/types"
 )
 
 type Migrator struct {
@@ -16,3 +18,15 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) MigrateToV5(ctx sdk.Context) error {
 	return v5.DeleteOpenConflicts(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// MigrateVersion2To3 migrates to the version with the FinalizationFraud store and genesis list, which
+// start empty (no fraud was penalized before). The module account loses the minter permission since
+// the finalization fraud reward is paid from the slashed stake
+func (m Migrator) MigrateVersion2To3(ctx sdk.Context) error {
+	moduleAcc, ok := m.keeper.accountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(types.ModuleName)).(*authtypes.ModuleAccount)
+	if ok {
+		moduleAcc.Permissions = nil
+		m.keeper.accountKeeper.SetAccount(ctx, moduleAcc)
+	}
+	return nil
+}
@@ -32,7 +32,7 @@ func (k msgServer) ConflictVoteCommit(goCtx context.Context, msg *types.MsgConfl
 			utils.Attribute{Key: "voteID", Value: msg.VoteID},
 		)
 	}
-	index, ok := FindVote(&conflictVote.Votes, msg.Creator)
+	index, ok := k.FindVoteForSigner(ctx, conflictVote, msg.Creator)
 	if !ok {
 		return nil, utils.LavaFormatWarning("provider is not in the voters list", legacyerrors.ErrKeyNotFound,
 			utils.Attribute{Key: "provider", Value: msg.Creator},
@@ -34,7 +34,7 @@ func (k msgServer) ConflictVoteReveal(goCtx context.Context, msg *types.MsgConfl
 			utils.Attribute{Key: "voteID", Value: msg.VoteID},
 		)
 	}
-	index, ok := FindVote(&conflictVote.Votes, msg.Creator)
+	index, ok := k.FindVoteForSigner(ctx, conflictVote, msg.Creator)
 	if !ok {
 		return nil, utils.LavaFormatWarning("Simulation: provider is not in the voters list", legacyerrors.ErrKeyNotFound,
 			utils.Attribute{Key: "provider", Value: msg.Creator},
@@ -54,7 +54,7 @@ func (k msgServer) ConflictVoteReveal(goCtx context.Context, msg *types.MsgConfl
 		)
 	}
 
-	commitHash := types.CommitVoteData(msg.Nonce, msg.Hash, msg.Creator)
+	commitHash := types.CommitVoteData(msg.Nonce, msg.Hash, conflictVote.Votes[index].Address)
 	if !bytes.Equal(commitHash, conflictVote.Votes[index].Hash) {
 		return nil, utils.LavaFormatWarning("Simulation: provider reveal does not match the commit", legacyerrors.ErrInvalidRequest,
 			utils.Attribute{Key: "provider", Value: msg.Creator},
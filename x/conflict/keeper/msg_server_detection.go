@@ -27,23 +27,36 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		)
 	}
 	if msg.FinalizationConflict != nil && msg.ResponseConflict == nil && msg.SameProviderConflict == nil {
-		err := k.Keeper.ValidateFinalizationConflict(ctx, msg.FinalizationConflict, clientAddr)
+		fraud, err := k.Keeper.ValidateFinalizationConflict(ctx, msg.FinalizationConflict, clientAddr)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid finalization conflict detection", err,
+			return nil, utils.LavaFormatWarning("invalid finalization conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
+		// the signatures prove which provider is at fault, penalize it without a vote
+		if fraud != nil {
+			err = k.Keeper.PenalizeFinalizationFraud(ctx, *fraud)
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgDetectionResponse{}, nil
+		}
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict == nil && msg.SameProviderConflict != nil {
-		err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
+		fraud, err := k.Keeper.ValidateSameProviderConflict(ctx, msg.SameProviderConflict, clientAddr)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid same provider conflict detection", err,
+			return nil, utils.LavaFormatWarning("invalid same provider conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
+		err = k.Keeper.PenalizeFinalizationFraud(ctx, *fraud)
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgDetectionResponse{}, nil
 	} else if msg.FinalizationConflict == nil && msg.ResponseConflict != nil && msg.SameProviderConflict == nil {
 		err := k.Keeper.ValidateResponseConflict(ctx, msg.ResponseConflict, clientAddr)
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: invalid response conflict detection", err,
+			return nil, utils.LavaFormatWarning("invalid response conflict detection", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 			)
 		}
@@ -56,7 +69,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		// 5. majority wins, minority gets penalised
 		epochStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, uint64(msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Epoch))
 		if err != nil {
-			return nil, utils.LavaFormatWarning("Simulation: could not get EpochStart for specific block", err,
+			return nil, utils.LavaFormatWarning("could not get EpochStart for specific block", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
 				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
@@ -65,7 +78,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		index := DetectionIndex(msg, epochStart)
 		found := k.Keeper.AllocateNewConflictVote(ctx, index)
 		if found {
-			return nil, utils.LavaFormatWarning("Simulation: conflict with is already open for this client and providers in this epoch", err,
+			return nil, utils.LavaFormatWarning("conflict with is already open for this client and providers in this epoch", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
 				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
@@ -77,7 +90,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		conflictVote.VoteStartBlock = uint64(msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Epoch)
 		epochBlocks, err := k.epochstorageKeeper.EpochBlocks(ctx, uint64(ctx.BlockHeight()))
 		if err != nil {
-			return nil, utils.LavaFormatError("Simulation: could not get epochblocks", err,
+			return nil, utils.LavaFormatError("could not get epochblocks", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
 				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
@@ -86,7 +99,7 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 
 		voteDeadline, err := k.Keeper.epochstorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight())+k.VotePeriod(ctx)*epochBlocks)
 		if err != nil {
-			return nil, utils.LavaFormatError("Simulation: could not get NextEpoch", err,
+			return nil, utils.LavaFormatError("could not get NextEpoch", err,
 				utils.Attribute{Key: "client", Value: msg.Creator},
 				utils.Attribute{Key: "provider0", Value: msg.ResponseConflict.ConflictRelayData0.Request.RelaySession.Provider},
 				utils.Attribute{Key: "provider1", Value: msg.ResponseConflict.ConflictRelayData1.Request.RelaySession.Provider},
@@ -131,12 +144,12 @@ func (k msgServer) Detection(goCtx context.Context, msg *types.MsgDetection) (*t
 		eventData["apiInterface"] = msg.ResponseConflict.ConflictRelayData0.Request.RelayData.ApiInterface
 		eventData["metadata"] = string(metadataBytes)
 
-		utils.LogLavaEvent(ctx, logger, types.ConflictVoteDetectionEventName, eventData, "Simulation: Got a new valid conflict detection from consumer, starting new vote")
+		utils.LogLavaEvent(ctx, logger, types.ConflictVoteDetectionEventName, eventData, "Got a new valid conflict detection from consumer, starting new vote")
 		return &types.MsgDetectionResponse{}, nil
 	}
 
 	eventData := map[string]string{"client": msg.Creator}
-	utils.LogLavaEvent(ctx, logger, types.ConflictDetectionRecievedEventName, eventData, "Simulation: Got a new valid conflict detection from consumer")
+	utils.LogLavaEvent(ctx, logger, types.ConflictDetectionRecievedEventName, eventData, "Got a new valid conflict detection from consumer")
 	return &types.MsgDetectionResponse{}, nil
 }
 
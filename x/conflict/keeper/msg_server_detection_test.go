@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	"cosmossdk.io/math"
@@ -9,6 +10,7 @@ import (
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/lavaslices"
 	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/conflict/keeper"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	conflictconstruct "github.com/lavanet/lava/x/conflict/types/construct"
 	"github.com/lavanet/lava/x/pairing/types"
@@ -184,3 +186,170 @@ func TestFrozenProviderDetection(t *testing.T) {
 	// the frozen provider should not be part of the voters list
 	require.False(t, lavaslices.Contains(votersList, frozenProvider))
 }
+
+// signedFinalizationReply creates a relay session signed by the consumer and a reply with the finalized blocks
+// hashes signed by the provider
+func (ts *tester) signedFinalizationReply(provider sigs.Account, sessionID uint64, latestBlock int64, finalizedBlocks map[int64]string) (*types.RelayReply, *types.RelaySession) {
+	relaySession := &types.RelaySession{
+		Provider:  provider.Addr.String(),
+		SessionId: sessionID,
+		SpecId:    ts.spec.Index,
+		Epoch:     int64(ts.EpochStart()),
+		RelayNum:  1,
+	}
+	sig, err := sigs.Sign(ts.consumer.SK, *relaySession)
+	require.NoError(ts.T, err)
+	relaySession.Sig = sig
+
+	finalizedBlocksHashes, err := json.Marshal(finalizedBlocks)
+	require.NoError(ts.T, err)
+	reply := &types.RelayReply{
+		Data:                  []byte("DUMMYREPLY"),
+		LatestBlock:           latestBlock,
+		FinalizedBlocksHashes: finalizedBlocksHashes,
+	}
+	relayFinalization := types.NewRelayFinalization(types.NewRelayExchange(types.RelayRequest{RelaySession: relaySession}, *reply), ts.consumer.Addr)
+	reply.SigBlocks, err = sigs.Sign(provider.SK, relayFinalization)
+	require.NoError(ts.T, err)
+	return reply, relaySession
+}
+
+func (ts *tester) requireFinalizationFraud(provider sigs.Account, block int64, balanceBefore int64, stakeBefore math.Int) {
+	_, found := ts.Keepers.Conflict.GetFinalizationFraud(ts.Ctx, ts.spec.Index, provider.Addr.String(), block)
+	require.True(ts.T, found)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(ts.T, found)
+	require.True(ts.T, stakeEntry.IsFrozen())
+	require.True(ts.T, stakeEntry.IsJailed(uint64(ts.Ctx.BlockHeight())))
+	slashed := stakeBefore.Sub(stakeEntry.Stake.Amount)
+	require.Equal(ts.T, keeper.FinalizationFraudSlashPercent.MulInt(stakeBefore).TruncateInt(), slashed)
+
+	clientReward := ts.Keepers.Conflict.Rewards(ts.Ctx).ClientRewardPercent.MulInt(slashed).TruncateInt()
+	require.True(ts.T, clientReward.IsPositive())
+	require.Equal(ts.T, balanceBefore+clientReward.Int64(), ts.GetBalance(ts.consumer.Addr))
+
+	events := ts.Ctx.EventManager().Events()
+	require.Equal(ts.T, utils.EventPrefix+conflicttypes.ConflictFinalizationFraudEventName, events[len(events)-1].Type)
+
+	// the jailed provider can't unfreeze itself
+	_, err := ts.Servers.PairingServer.UnfreezeProvider(ts.GoCtx, &types.MsgUnfreezeProvider{Creator: provider.Addr.String(), ChainIds: []string{ts.spec.Index}})
+	require.ErrorIs(ts.T, err, types.UnFreezeJailedProviderError)
+	stakeEntry, _, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(ts.T, stakeEntry.IsFrozen())
+}
+
+func TestSameProviderConflictPenalty(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	provider := ts.providers[0]
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(t, found)
+	stakeBefore := stakeEntry.Stake.Amount
+	balanceBefore := ts.GetBalance(ts.consumer.Addr)
+
+	// the provider signed two different hashes for block 9
+	reply0, session0 := ts.signedFinalizationReply(provider, 1, 10, map[int64]string{8: "a", 9: "b"})
+	reply1, session1 := ts.signedFinalizationReply(provider, 2, 10, map[int64]string{8: "a", 9: "c"})
+	msg := conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, &conflicttypes.FinalizationConflict{
+		RelayReply0: reply0, RelaySession0: session0, RelayReply1: reply1, RelaySession1: session1,
+	})
+	_, err := ts.txConflictDetection(msg)
+	require.NoError(t, err)
+	ts.requireFinalizationFraud(provider, 9, balanceBefore, stakeBefore)
+
+	// the same fraud can't be reported twice
+	reply2, session2 := ts.signedFinalizationReply(provider, 3, 11, map[int64]string{9: "d", 10: "e"})
+	msg.SameProviderConflict.RelayReply1 = reply2
+	msg.SameProviderConflict.RelaySession1 = session2
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// same hashes are not a conflict
+	reply3, session3 := ts.signedFinalizationReply(ts.providers[1], 4, 10, map[int64]string{9: "b"})
+	reply4, session4 := ts.signedFinalizationReply(ts.providers[1], 5, 12, map[int64]string{9: "b", 10: "c"})
+	msg = conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, &conflicttypes.FinalizationConflict{
+		RelayReply0: reply3, RelaySession0: session3, RelayReply1: reply4, RelaySession1: session4,
+	})
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// replies of different providers are not a same provider conflict
+	reply5, session5 := ts.signedFinalizationReply(ts.providers[2], 6, 10, map[int64]string{9: "c"})
+	msg.SameProviderConflict.RelayReply1 = reply5
+	msg.SameProviderConflict.RelaySession1 = session5
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// the relay session must be signed by the reporting consumer
+	reply6, session6 := ts.signedFinalizationReply(ts.providers[1], 7, 12, map[int64]string{9: "c"})
+	session6.Sig = []byte{}
+	session6.Sig, err = sigs.Sign(ts.providers[4].SK, *session6)
+	require.NoError(t, err)
+	msg = conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), nil, nil, &conflicttypes.FinalizationConflict{
+		RelayReply0: reply3, RelaySession0: session3, RelayReply1: reply6, RelaySession1: session6,
+	})
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// the finalization data must be signed by the provider
+	reply7, session7 := ts.signedFinalizationReply(ts.providers[1], 8, 12, map[int64]string{9: "c"})
+	reply7.LatestBlock++
+	msg.SameProviderConflict.RelayReply1 = reply7
+	msg.SameProviderConflict.RelaySession1 = session7
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// the same evidence is valid when it is signed correctly
+	reply7.LatestBlock--
+	_, err = ts.txConflictDetection(msg)
+	require.NoError(t, err)
+}
+
+func TestFinalizationConflictPenalty(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	// a provider that signed a non finalized block as finalized is penalized
+	provider := ts.providers[0]
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, provider.Addr)
+	require.True(t, found)
+	stakeBefore := stakeEntry.Stake.Amount
+	balanceBefore := ts.GetBalance(ts.consumer.Addr)
+
+	reply0, session0 := ts.signedFinalizationReply(provider, 1, 10, map[int64]string{10: "a", 11: "b"})
+	msg := conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), &conflicttypes.FinalizationConflict{
+		RelayReply0: reply0, RelaySession0: session0,
+	}, nil, nil)
+	_, err := ts.txConflictDetection(msg)
+	require.NoError(t, err)
+	ts.requireFinalizationFraud(provider, 11, balanceBefore, stakeBefore)
+
+	// two providers that disagree on a finalized block can't be told apart, no one is penalized
+	reply1, session1 := ts.signedFinalizationReply(ts.providers[1], 2, 10, map[int64]string{9: "a"})
+	reply2, session2 := ts.signedFinalizationReply(ts.providers[2], 3, 10, map[int64]string{9: "b"})
+	msg = conflicttypes.NewMsgDetection(ts.consumer.Addr.String(), &conflicttypes.FinalizationConflict{
+		RelayReply0: reply1, RelaySession0: session1, RelayReply1: reply2, RelaySession1: session2,
+	}, nil, nil)
+	_, err = ts.txConflictDetection(msg)
+	require.NoError(t, err)
+	require.Empty(t, ts.Keepers.Conflict.GetAllFinalizationFraud(ts.Ctx)[1:])
+	for _, p := range ts.providers[1:3] {
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, p.Addr)
+		require.True(t, found)
+		require.False(t, stakeEntry.IsFrozen())
+	}
+	events := ts.Ctx.EventManager().Events()
+	require.Equal(t, utils.EventPrefix+conflicttypes.ConflictDetectionRecievedEventName, events[len(events)-1].Type)
+
+	// valid finalization data of a single provider is not a conflict
+	msg.FinalizationConflict.RelayReply1 = nil
+	msg.FinalizationConflict.RelaySession1 = nil
+	_, err = ts.txConflictDetection(msg)
+	require.Error(t, err)
+
+	// the fraud records are removed once the evidence can't be verified anymore
+	ts.AdvanceEpochs(ts.EpochsToSave() + 1)
+	require.Empty(t, ts.Keepers.Conflict.GetAllFinalizationFraud(ts.Ctx))
+}
@@ -6,6 +6,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/x/conflict/types"
 	"golang.org/x/exp/slices"
@@ -18,6 +19,9 @@ const (
 
 var SlashStakePercent = sdk.NewDecWithPrec(5, 2) // 0.05
 
+// the part of the stake slashed from a provider that signed conflicting finalization data
+var FinalizationFraudSlashPercent = sdk.NewDecWithPrec(5, 2) // 0.05
+
 func (k Keeper) AllocateNewConflictVote(ctx sdk.Context, key string) bool {
 	_, found := k.GetConflictVote(ctx, key)
 
@@ -50,7 +54,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	// punish providers that didnt vote - discipline/jail + bail = 20%stake + slash 5%stake
 	// (dont add jailed providers to voters)
 	// if strong majority punish wrong providers - jail from start of memory to end + slash 100%stake
-	// reward pool is the slashed amount from all punished providers
+	// reward pool is the slashed amount from all punished providers, it is held by the conflict module account
 	// reward to stake - client 50%, the original provider 10%, 20% the voters
 	totalVotes := sdk.ZeroInt()
 	firstProviderVotes := sdk.ZeroInt()
@@ -58,6 +62,7 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	noneProviderVotes := sdk.ZeroInt()
 	var providersWithoutVote []string
 	rewardPool := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	poolAddress := authtypes.NewModuleAddress(types.ModuleName)
 	rewardCount := math.ZeroInt()
 	votersStake := map[string]math.Int{} // this is needed in order to give rewards for each voter according to their stake(so we dont take this data twice from the keeper)
 	ConsensusVote := true
@@ -121,14 +126,14 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 			// punish providers that didnt vote
 			providersWithoutVote = append(providersWithoutVote, vote.Address)
 			bail := stake
-			bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
+			bail = bail.Quo(sdk.NewIntFromUint64(BailStakeDiv))
 			err = k.pairingKeeper.JailEntry(ctx, accAddress, conflictVote.ChainID, conflictVote.VoteStartBlock, blocksToSave, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), bail))
 			if err != nil {
 				utils.LavaFormatWarning("jailing failed at vote conflict", err)
 				// not skipping to continue to slash
 			}
-			slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent)
-			rewardPool = rewardPool.Add(slashed)
+			_, pooled, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, SlashStakePercent, poolAddress, sdk.OneDec())
+			rewardPool = rewardPool.Add(pooled)
 			if err != nil {
 				utils.LavaFormatWarning("slashing failed at vote conflict", err)
 				continue
@@ -184,8 +189,8 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 						)
 						continue
 					}
-					slashed, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0))
-					rewardPool = rewardPool.Add(slashed)
+					_, pooled, err := k.pairingKeeper.SlashEntry(ctx, accAddress, conflictVote.ChainID, sdk.NewDecWithPrec(1, 0), poolAddress, sdk.OneDec())
+					rewardPool = rewardPool.Add(pooled)
 					if err != nil {
 						utils.LavaFormatWarning("slashing failed at vote conflict", err)
 					}
@@ -233,9 +238,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					utils.Attribute{Key: "voteAddress", Value: winnersAddr},
 				)
 			} else {
-				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accWinnerAddress, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), winnerReward.TruncateInt()))
-				if !ok {
-					utils.LavaFormatWarning("failed to credit client", err)
+				err = k.creditReward(ctx, conflictVote.ChainID, accWinnerAddress, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), winnerReward.TruncateInt()))
+				if err != nil {
+					utils.LavaFormatWarning("failed to reward the winner provider", err)
 				}
 			}
 		}
@@ -269,13 +274,9 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 					)
 					continue
 				}
-				ok, err := k.pairingKeeper.CreditStakeEntry(ctx, conflictVote.ChainID, accAddress, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), rewardVoter.TruncateInt()))
-				if !ok {
-					details := map[string]string{}
-					if err != nil {
-						details["error"] = err.Error()
-					}
-					utils.LavaFormatWarning("failed to credit client", err)
+				err = k.creditReward(ctx, conflictVote.ChainID, accAddress, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), rewardVoter.TruncateInt()))
+				if err != nil {
+					utils.LavaFormatWarning("failed to reward the voter", err)
 					continue
 				}
 			}
@@ -293,6 +294,32 @@ func (k Keeper) HandleAndCloseVote(ctx sdk.Context, conflictVote types.ConflictV
 	utils.LogLavaEvent(ctx, logger, eventName, eventDataMap, "conflict detection resolved")
 }
 
+// creditReward pays the reward from the conflict reward pool to the provider and adds it to the
+// provider's stake. If the stake can't be credited, the reward is kept in the provider's balance.
+func (k Keeper) creditReward(ctx sdk.Context, chainID string, provider sdk.AccAddress, reward sdk.Coin) error {
+	if !reward.IsPositive() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, sdk.NewCoins(reward))
+	if err != nil {
+		return utils.LavaFormatError("failed to pay reward from the conflict reward pool", err,
+			utils.Attribute{Key: "provider", Value: provider.String()},
+			utils.Attribute{Key: "reward", Value: reward},
+		)
+	}
+
+	ok, err := k.pairingKeeper.CreditStakeEntry(ctx, chainID, provider, reward)
+	if !ok {
+		utils.LavaFormatWarning("failed to credit the reward to the provider's stake, it is kept in its balance", err,
+			utils.Attribute{Key: "provider", Value: provider.String()},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "reward", Value: reward},
+		)
+	}
+	return nil
+}
+
 func (k Keeper) TransitionVoteToReveal(ctx sdk.Context, conflictVote types.ConflictVote) {
 	logger := k.Logger(ctx)
 	conflictVote.VoteState = types.StateReveal
@@ -322,3 +349,26 @@ func FindVote(votes *[]types.Vote, address string) (int, bool) {
 	}
 	return -1, false
 }
+
+// FindVoteForSigner finds the vote of a voter, the signer can be the voter or its operator in the
+// epoch the vote started at (the epoch the jury was picked from)
+func (k Keeper) FindVoteForSigner(ctx sdk.Context, conflictVote types.ConflictVote, signer string) (int, bool) {
+	if index, ok := FindVote(&conflictVote.Votes, signer); ok {
+		return index, true
+	}
+	epochVoteStart, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, conflictVote.VoteStartBlock)
+	if err != nil {
+		return -1, false
+	}
+	for index, vote := range conflictVote.Votes {
+		voterAddr, err := sdk.AccAddressFromBech32(vote.Address)
+		if err != nil {
+			continue
+		}
+		stakeEntry, err := k.epochstorageKeeper.GetStakeEntryForProviderEpoch(ctx, conflictVote.ChainID, voterAddr, epochVoteStart)
+		if err == nil && stakeEntry.IsOperator(signer) {
+			return index, true
+		}
+	}
+	return -1, false
+}
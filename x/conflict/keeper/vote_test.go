@@ -3,10 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils"
 	"github.com/lavanet/lava/utils/rand"
 	"github.com/lavanet/lava/utils/sigs"
+	conflictkeeper "github.com/lavanet/lava/x/conflict/keeper"
 	conflicttypes "github.com/lavanet/lava/x/conflict/types"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 	"github.com/stretchr/testify/require"
@@ -26,7 +30,10 @@ func (ts *tester) txConflictDetection(msg *conflicttypes.MsgDetection) (*conflic
 
 func (ts *tester) setupForCommit() (string, conflicttypes.MsgDetection, *pairingtypes.RelayReply, *pairingtypes.RelayReply) {
 	ts.setupForConflict(ProvidersCount)
+	return ts.detectConflict()
+}
 
+func (ts *tester) detectConflict() (string, conflicttypes.MsgDetection, *pairingtypes.RelayReply, *pairingtypes.RelayReply) {
 	msg, reply1, reply2, err := common.CreateMsgDetectionTest(ts.GoCtx, ts.consumer, ts.providers[0], ts.providers[1], ts.spec)
 	require.Nil(ts.T, err)
 
@@ -84,6 +91,48 @@ func TestCommit(t *testing.T) {
 	}
 }
 
+// Test that a voter's operator can vote for it only if it was the operator in the epoch the vote started
+func TestOperatorCommit(t *testing.T) {
+	rand.InitRandomSeed()
+	ts := newTester(t)
+	ts.setupForConflict(ProvidersCount)
+
+	_, operatorAddr := ts.AddAccount("operator", 0, 10000)
+	_, lateOperatorAddr := ts.AddAccount("operator", 1, 10000)
+	_, err := ts.TxPairingSetProviderOperator(ts.providers[2].Addr.String(), []string{ts.spec.Index}, operatorAddr)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	// the second operator is set after the vote's epoch started
+	voteID, detection, relay0, _ := ts.detectConflict()
+	_, err = ts.TxPairingSetProviderOperator(ts.providers[3].Addr.String(), []string{ts.spec.Index}, lateOperatorAddr)
+	require.NoError(t, err)
+
+	replyDataHash := sigs.HashMsg(pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0).DataToSign())
+	for _, tt := range []struct {
+		creator string
+		valid   bool
+	}{
+		{operatorAddr, true},
+		{lateOperatorAddr, false},
+	} {
+		msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID, Creator: tt.creator}
+		msg.Hash = conflicttypes.CommitVoteData(rand.Int63(), replyDataHash, msg.Creator)
+		_, err := ts.txConflictVoteCommit(&msg)
+		if tt.valid {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+
+	vote, found := ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+	require.True(t, found)
+	index, found := conflictkeeper.FindVote(&vote.Votes, ts.providers[2].Addr.String())
+	require.True(t, found)
+	require.Equal(t, int64(conflicttypes.Commit), vote.Votes[index].Result)
+}
+
 func TestDoubleCommit(t *testing.T) {
 	ts := newTester(t)
 	voteID, detection, relay0, _ := ts.setupForCommit()
@@ -412,6 +461,76 @@ func TestFullStrongMajorityVote(t *testing.T) {
 	require.Equal(t, LastEvent.Type, utils.EventPrefix+conflicttypes.ConflictVoteResolvedEventName)
 }
 
+// Test that the stake slashed from a wrong voter fills the conflict reward pool and that the winner
+// provider and the winning voters are rewarded from it to their stake
+func TestMajorityVoteRewards(t *testing.T) {
+	rand.InitRandomSeed()
+	ts := newTester(t)
+	voteID, detection, relay0, relay1 := ts.setupForCommit()
+
+	params := ts.Keepers.Conflict.GetParams(ts.Ctx)
+	params.MajorityPercent = sdk.NewDecWithPrec(5, 1)
+	ts.Keepers.Conflict.SetParams(ts.Ctx, params)
+
+	// the last voter votes for the second provider, the rest vote for the first
+	nonce := rand.Int63()
+	replyDataHash := func(voter int) []byte {
+		if voter == ProvidersCount-1 {
+			return sigs.HashMsg(pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData1.Request, *relay1).DataToSign())
+		}
+		return sigs.HashMsg(pairingtypes.NewRelayExchange(*detection.ResponseConflict.ConflictRelayData0.Request, *relay0).DataToSign())
+	}
+	for i := 2; i < ProvidersCount; i++ {
+		msg := conflicttypes.MsgConflictVoteCommit{VoteID: voteID, Creator: ts.providers[i].Addr.String()}
+		msg.Hash = conflicttypes.CommitVoteData(nonce, replyDataHash(i), msg.Creator)
+		_, err := ts.txConflictVoteCommit(&msg)
+		require.NoError(t, err)
+	}
+
+	ts.AdvanceEpochs(ts.VotePeriod() + 1)
+
+	for i := 2; i < ProvidersCount; i++ {
+		msg := conflicttypes.MsgConflictVoteReveal{VoteID: voteID, Creator: ts.providers[i].Addr.String(), Nonce: nonce, Hash: replyDataHash(i)}
+		_, err := ts.txConflictVoteReveal(&msg)
+		require.NoError(t, err)
+	}
+
+	stakes := map[int]math.Int{}
+	for _, i := range []int{0, 2, 3} {
+		entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[i].Addr)
+		require.True(t, found)
+		stakes[i] = entry.Stake.Amount
+	}
+	loser, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[ProvidersCount-1].Addr)
+	require.True(t, found)
+	poolAddr := authtypes.NewModuleAddress(conflicttypes.ModuleName)
+	poolBalance := ts.GetBalance(poolAddr)
+	supply := ts.Keepers.BankKeeper.GetSupply(ts.Ctx, ts.TokenDenom()).Amount
+
+	ts.AdvanceEpochs(ts.VotePeriod())
+
+	_, found = ts.Keepers.Conflict.GetConflictVote(ts.Ctx, voteID)
+	require.False(t, found)
+
+	// the wrong voter is fully slashed into the reward pool, nothing is burned
+	rewardPool := loser.Stake.Amount
+	rewards := ts.Keepers.Conflict.Rewards(ts.Ctx)
+	winnerReward := rewards.WinnerRewardPercent.MulInt(rewardPool).TruncateInt()
+	voterReward := rewards.VotersRewardPercent.MulInt(rewardPool).QuoInt64(2).TruncateInt()
+	require.Equal(t, supply, ts.Keepers.BankKeeper.GetSupply(ts.Ctx, ts.TokenDenom()).Amount)
+
+	// the rewards are added to the stakes
+	for i, reward := range map[int]math.Int{0: winnerReward, 2: voterReward, 3: voterReward} {
+		entry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, ts.providers[i].Addr)
+		require.True(t, found)
+		require.Equal(t, stakes[i].Add(reward), entry.Stake.Amount)
+	}
+
+	// the rest of the slashed stake is kept in the reward pool
+	paid := winnerReward.Add(voterReward.MulRaw(2))
+	require.Equal(t, poolBalance+rewardPool.Sub(paid).Int64(), ts.GetBalance(poolAddr))
+}
+
 func TestNoVotersConflict(t *testing.T) {
 	ts := newTester(t)
 	voteID, _, _, _ := ts.setupForCommit()
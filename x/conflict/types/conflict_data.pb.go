@@ -205,8 +205,10 @@ func (m *ReplyMetadata) GetSigBlocks() []byte {
 }
 
 type FinalizationConflict struct {
-	RelayReply0 *types.RelayReply `protobuf:"bytes,1,opt,name=relayReply0,proto3" json:"relayReply0,omitempty"`
-	RelayReply1 *types.RelayReply `protobuf:"bytes,2,opt,name=relayReply1,proto3" json:"relayReply1,omitempty"`
+	RelayReply0   *types.RelayReply   `protobuf:"bytes,1,opt,name=relayReply0,proto3" json:"relayReply0,omitempty"`
+	RelayReply1   *types.RelayReply   `protobuf:"bytes,2,opt,name=relayReply1,proto3" json:"relayReply1,omitempty"`
+	RelaySession0 *types.RelaySession `protobuf:"bytes,3,opt,name=relaySession0,proto3" json:"relaySession0,omitempty"`
+	RelaySession1 *types.RelaySession `protobuf:"bytes,4,opt,name=relaySession1,proto3" json:"relaySession1,omitempty"`
 }
 
 func (m *FinalizationConflict) Reset()         { *m = FinalizationConflict{} }
@@ -256,6 +258,20 @@ func (m *FinalizationConflict) GetRelayReply1() *types.RelayReply {
 	return nil
 }
 
+func (m *FinalizationConflict) GetRelaySession0() *types.RelaySession {
+	if m != nil {
+		return m.RelaySession0
+	}
+	return nil
+}
+
+func (m *FinalizationConflict) GetRelaySession1() *types.RelaySession {
+	if m != nil {
+		return m.RelaySession1
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ResponseConflict)(nil), "lavanet.lava.conflict.ResponseConflict")
 	proto.RegisterType((*ConflictRelayData)(nil), "lavanet.lava.conflict.ConflictRelayData")
@@ -268,35 +284,37 @@ func init() {
 }
 
 var fileDescriptor_db493e54bcd78171 = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0xcb, 0xd3, 0x30,
-	0x1c, 0xc7, 0x97, 0xa7, 0xcf, 0xfc, 0x93, 0x4d, 0x9c, 0x61, 0xc3, 0x32, 0xb0, 0xcc, 0xe2, 0x61,
-	0x22, 0xb4, 0x4e, 0xc1, 0x83, 0x78, 0xb1, 0x13, 0x19, 0x82, 0x97, 0x9c, 0xc4, 0x4b, 0xc9, 0xba,
-	0xac, 0x0d, 0xc6, 0xa6, 0x36, 0x99, 0x58, 0x5f, 0x85, 0xe0, 0xdd, 0x97, 0x23, 0x3b, 0xee, 0xe8,
-	0x51, 0xb6, 0x37, 0x22, 0x49, 0xda, 0x69, 0x75, 0x0a, 0xf2, 0x9c, 0xfa, 0x6b, 0xf2, 0xf9, 0x7e,
-	0xf3, 0xe5, 0x97, 0xfc, 0xe0, 0x5d, 0x4e, 0xde, 0x93, 0x9c, 0xaa, 0x50, 0x7f, 0xc3, 0x44, 0xe4,
-	0x6b, 0xce, 0x12, 0x75, 0x2c, 0xe2, 0x15, 0x51, 0x24, 0x28, 0x4a, 0xa1, 0x04, 0x1a, 0xd5, 0x68,
-	0xa0, 0xbf, 0x41, 0x43, 0x8c, 0x87, 0xa9, 0x48, 0x85, 0x21, 0x42, 0x5d, 0x59, 0x78, 0x3c, 0x69,
-	0xf9, 0x16, 0x84, 0x95, 0x2c, 0x4f, 0xc3, 0x92, 0x72, 0x52, 0x59, 0xc2, 0xff, 0x0a, 0xe0, 0x00,
-	0x53, 0x59, 0x88, 0x5c, 0xd2, 0x79, 0x6d, 0x86, 0x5e, 0x41, 0xd4, 0x18, 0x63, 0xcd, 0x3e, 0x23,
-	0x8a, 0xdc, 0x77, 0xc1, 0x04, 0x4c, 0x7b, 0x0f, 0xa6, 0xc1, 0xc9, 0x00, 0xc1, 0xfc, 0x77, 0x01,
-	0x3e, 0xe1, 0x71, 0xd2, 0x79, 0xe6, 0x9e, 0x5d, 0xd8, 0x79, 0xe6, 0x7f, 0x06, 0xf0, 0xc6, 0x1f,
-	0x24, 0x7a, 0x02, 0x2f, 0x97, 0xf4, 0xdd, 0x86, 0x4a, 0x55, 0xc7, 0xf7, 0xdb, 0x87, 0xd4, 0x2d,
-	0x09, 0x8c, 0x02, 0x5b, 0x12, 0x37, 0x12, 0xf4, 0x18, 0x76, 0x4b, 0x5a, 0xf0, 0xca, 0x75, 0x8c,
-	0xf6, 0xce, 0x5f, 0x02, 0x62, 0xcd, 0xbc, 0xa4, 0x8a, 0xe8, 0x6b, 0xc2, 0x56, 0xf2, 0xe2, 0xfc,
-	0xca, 0xd9, 0xc0, 0xf1, 0xb7, 0x00, 0x5e, 0x6b, 0x6d, 0xa3, 0x7b, 0x10, 0x65, 0x44, 0x66, 0x31,
-	0xe1, 0xdc, 0x5c, 0x6b, 0xac, 0xff, 0x4c, 0xb8, 0x3e, 0xbe, 0xae, 0xeb, 0xa7, 0x9c, 0xeb, 0xe8,
-	0x0b, 0x22, 0x33, 0x34, 0x80, 0x8e, 0x64, 0xa9, 0xe9, 0x4f, 0x1f, 0xeb, 0x12, 0xdd, 0x86, 0x7d,
-	0x4e, 0x14, 0x95, 0x2a, 0x5e, 0x72, 0x91, 0xbc, 0x31, 0xc9, 0x1c, 0xdc, 0xb3, 0x6b, 0x91, 0x5e,
-	0x42, 0x8f, 0xe0, 0xcd, 0x35, 0xcb, 0x09, 0x67, 0x1f, 0xe9, 0xca, 0x52, 0xd2, 0x1c, 0x42, 0xa5,
-	0x7b, 0x6e, 0x8c, 0x46, 0xc7, 0x6d, 0x23, 0x90, 0x0b, 0xb3, 0x89, 0x6e, 0x41, 0x28, 0x59, 0x5a,
-	0x2b, 0xdc, 0xae, 0x41, 0xaf, 0x4a, 0x96, 0x5a, 0xc8, 0xff, 0x02, 0xe0, 0xf0, 0xb9, 0x15, 0x12,
-	0xc5, 0x44, 0x7e, 0x7c, 0x2d, 0x11, 0xec, 0x95, 0xb6, 0x7d, 0x05, 0xaf, 0x9a, 0x67, 0x32, 0xf9,
-	0x67, 0x9f, 0x0b, 0x5e, 0xe1, 0x5f, 0x45, 0x6d, 0x8f, 0xe6, 0x41, 0xfc, 0x97, 0xc7, 0x2c, 0x8a,
-	0xb6, 0x7b, 0x0f, 0xec, 0xf6, 0x1e, 0xf8, 0xbe, 0xf7, 0xc0, 0xa7, 0x83, 0xd7, 0xd9, 0x1d, 0xbc,
-	0xce, 0xb7, 0x83, 0xd7, 0x79, 0x3d, 0x4d, 0x99, 0xca, 0x36, 0xcb, 0x20, 0x11, 0x6f, 0xc3, 0xd6,
-	0x44, 0x7c, 0xf8, 0x39, 0x6b, 0xaa, 0x2a, 0xa8, 0x5c, 0x5e, 0x32, 0x53, 0xf1, 0xf0, 0x47, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x7d, 0x98, 0xa8, 0x91, 0x03, 0x00, 0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x9b, 0x4e, 0xd7, 0x3f, 0x6f, 0xbb, 0x58, 0xc3, 0x2e, 0x0e, 0x0b, 0x0e, 0x75, 0xf0,
+	0x50, 0x11, 0x66, 0x76, 0x14, 0x3c, 0x88, 0x17, 0xbb, 0x22, 0x45, 0xf0, 0x12, 0x2f, 0xe2, 0xa5,
+	0xa4, 0xdd, 0xec, 0x4c, 0x30, 0x4e, 0xc6, 0x49, 0x56, 0x1c, 0x3f, 0x85, 0xe0, 0x37, 0xf1, 0x43,
+	0xc8, 0x1e, 0xf7, 0xe8, 0x51, 0xda, 0x2f, 0x22, 0x49, 0x66, 0xaa, 0x53, 0xab, 0xa2, 0x7b, 0xca,
+	0x9b, 0xe4, 0xf7, 0x3c, 0x79, 0x78, 0x93, 0xc0, 0x1d, 0x41, 0xdf, 0xd1, 0x9c, 0xe9, 0xd8, 0x8c,
+	0xf1, 0x42, 0xe6, 0x27, 0x82, 0x2f, 0xf4, 0xba, 0x98, 0x1d, 0x53, 0x4d, 0xa3, 0xa2, 0x94, 0x5a,
+	0xe2, 0xfd, 0x1a, 0x8d, 0xcc, 0x18, 0x35, 0xc4, 0xc1, 0x5e, 0x2a, 0x53, 0x69, 0x89, 0xd8, 0x54,
+	0x0e, 0x3e, 0x18, 0xb5, 0x7c, 0x0b, 0xca, 0x4b, 0x9e, 0xa7, 0x71, 0xc9, 0x04, 0xad, 0x1c, 0x11,
+	0x7e, 0x41, 0x30, 0x24, 0x4c, 0x15, 0x32, 0x57, 0xec, 0xa8, 0x36, 0xc3, 0x2f, 0x01, 0x37, 0xc6,
+	0xc4, 0xb0, 0x4f, 0xa8, 0xa6, 0x87, 0x3e, 0x1a, 0xa1, 0x71, 0xff, 0xde, 0x38, 0xda, 0x1a, 0x20,
+	0x3a, 0xda, 0x14, 0x90, 0x2d, 0x1e, 0x5b, 0x9d, 0x13, 0xbf, 0x7b, 0x61, 0xe7, 0x24, 0xfc, 0x84,
+	0xe0, 0xfa, 0x2f, 0x24, 0x7e, 0x04, 0x97, 0x4b, 0xf6, 0xf6, 0x94, 0x29, 0x5d, 0xc7, 0x0f, 0xdb,
+	0x87, 0xd4, 0x2d, 0x89, 0xac, 0x82, 0x38, 0x92, 0x34, 0x12, 0xfc, 0x10, 0x76, 0x4a, 0x56, 0x88,
+	0xca, 0xf7, 0xac, 0xf6, 0xf6, 0x6f, 0x02, 0x12, 0xc3, 0x3c, 0x67, 0x9a, 0x9a, 0x6b, 0x22, 0x4e,
+	0xf2, 0xac, 0x77, 0xa5, 0x3b, 0xf4, 0xc2, 0x33, 0x04, 0xbb, 0xad, 0x6d, 0x7c, 0x17, 0x70, 0x46,
+	0x55, 0x36, 0xa3, 0x42, 0xd8, 0x6b, 0x9d, 0x99, 0x99, 0x0d, 0x37, 0x20, 0xd7, 0x4c, 0xfd, 0x58,
+	0x08, 0x13, 0x7d, 0x4a, 0x55, 0x86, 0x87, 0xe0, 0x29, 0x9e, 0xda, 0xfe, 0x0c, 0x88, 0x29, 0xf1,
+	0x2d, 0x18, 0x08, 0xaa, 0x99, 0xd2, 0xb3, 0xb9, 0x90, 0x8b, 0xd7, 0x36, 0x99, 0x47, 0xfa, 0x6e,
+	0x6d, 0x62, 0x96, 0xf0, 0x03, 0xb8, 0x71, 0xc2, 0x73, 0x2a, 0xf8, 0x07, 0x76, 0xec, 0x28, 0x65,
+	0x0f, 0x61, 0xca, 0xef, 0x59, 0xa3, 0xfd, 0xf5, 0xb6, 0x15, 0xa8, 0xa9, 0xdd, 0xc4, 0x37, 0x01,
+	0x14, 0x4f, 0x6b, 0x85, 0xbf, 0x63, 0xd1, 0xab, 0x8a, 0xa7, 0x0e, 0x0a, 0x3f, 0x77, 0x61, 0xef,
+	0xa9, 0x13, 0x52, 0xcd, 0x65, 0xbe, 0x7e, 0x2d, 0x13, 0xe8, 0x97, 0xae, 0x7d, 0x85, 0xa8, 0x9a,
+	0x67, 0x32, 0xfa, 0x63, 0x9f, 0x0b, 0x51, 0x91, 0x9f, 0x45, 0x6d, 0x8f, 0xe6, 0x41, 0xfc, 0x93,
+	0x47, 0x82, 0xa7, 0xb0, 0x6b, 0xa7, 0x2f, 0x98, 0x52, 0x5c, 0xe6, 0x87, 0xbe, 0xf7, 0xd7, 0x1b,
+	0xaf, 0x51, 0xd2, 0x16, 0x6e, 0x3a, 0x25, 0x7e, 0xef, 0xff, 0x9c, 0x92, 0xc9, 0xe4, 0x6c, 0x19,
+	0xa0, 0xf3, 0x65, 0x80, 0xbe, 0x2d, 0x03, 0xf4, 0x71, 0x15, 0x74, 0xce, 0x57, 0x41, 0xe7, 0xeb,
+	0x2a, 0xe8, 0xbc, 0x1a, 0xa7, 0x5c, 0x67, 0xa7, 0xf3, 0x68, 0x21, 0xdf, 0xc4, 0xad, 0x5f, 0xfa,
+	0xfe, 0xc7, 0xff, 0xd7, 0x55, 0xc1, 0xd4, 0xfc, 0x92, 0xfd, 0xa9, 0xf7, 0xbf, 0x0f, 0x00, 0x26,
+	0x6c, 0xf5, 0x58, 0x25, 0x04, 0x00, 0x00,
 }
 
 func (m *ResponseConflict) Marshal() (dAtA []byte, err error) {
@@ -469,6 +487,30 @@ func (m *FinalizationConflict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RelaySession1 != nil {
+		{
+			size, err := m.RelaySession1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RelaySession0 != nil {
+		{
+			size, err := m.RelaySession0.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConflictData(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.RelayReply1 != nil {
 		{
 			size, err := m.RelayReply1.MarshalToSizedBuffer(dAtA[:i])
@@ -583,6 +625,14 @@ func (m *FinalizationConflict) Size() (n int) {
 		l = m.RelayReply1.Size()
 		n += 1 + l + sovConflictData(uint64(l))
 	}
+	if m.RelaySession0 != nil {
+		l = m.RelaySession0.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
+	if m.RelaySession1 != nil {
+		l = m.RelaySession1.Size()
+		n += 1 + l + sovConflictData(uint64(l))
+	}
 	return n
 }
 
@@ -1142,6 +1192,78 @@ func (m *FinalizationConflict) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySession0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelaySession0 == nil {
+				m.RelaySession0 = &types.RelaySession{}
+			}
+			if err := m.RelaySession0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelaySession1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConflictData
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConflictData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelaySession1 == nil {
+				m.RelaySession1 = &types.RelaySession{}
+			}
+			if err := m.RelaySession1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConflictData(dAtA[iNdEx:])
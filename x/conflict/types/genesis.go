@@ -10,7 +10,8 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		ConflictVoteList: []ConflictVote{},
+		ConflictVoteList:      []ConflictVote{},
+		FinalizationFraudList: []FinalizationFraud{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -29,6 +30,16 @@ func (gs GenesisState) Validate() error {
 		}
 		conflictVoteIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in finalizationFraud
+	finalizationFraudIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.FinalizationFraudList {
+		index := string(FinalizationFraudKey(elem.ChainID, elem.Provider, elem.Block))
+		if _, ok := finalizationFraudIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for finalizationFraud")
+		}
+		finalizationFraudIndexMap[index] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
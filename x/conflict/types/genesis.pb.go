@@ -25,8 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the conflict module's genesis state.
 type GenesisState struct {
-	Params           Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ConflictVoteList []ConflictVote `protobuf:"bytes,2,rep,name=conflictVoteList,proto3" json:"conflictVoteList"`
+	Params                Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ConflictVoteList      []ConflictVote      `protobuf:"bytes,2,rep,name=conflictVoteList,proto3" json:"conflictVoteList"`
+	FinalizationFraudList []FinalizationFraud `protobuf:"bytes,3,rep,name=finalizationFraudList,proto3" json:"finalizationFraudList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +77,13 @@ func (m *GenesisState) GetConflictVoteList() []ConflictVote {
 	return nil
 }
 
+func (m *GenesisState) GetFinalizationFraudList() []FinalizationFraud {
+	if m != nil {
+		return m.FinalizationFraudList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.conflict.GenesisState")
 }
@@ -85,22 +93,25 @@ func init() {
 }
 
 var fileDescriptor_71a0ca73fa4559da = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
+	// 284 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x49, 0x2c, 0x4b,
 	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xc9, 0xf9, 0x79, 0x69, 0x39, 0x99, 0xc9, 0x25, 0xfa,
 	0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50,
 	0x45, 0x7a, 0x20, 0x5a, 0x0f, 0xa6, 0x48, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x42, 0x1f,
 	0xc4, 0x82, 0x28, 0x96, 0x52, 0xc2, 0x6e, 0x62, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x40, 0x29,
-	0x4d, 0xec, 0x6a, 0x60, 0x8c, 0xf8, 0xb2, 0xfc, 0x92, 0x54, 0x88, 0x52, 0xa5, 0x45, 0x8c, 0x5c,
-	0x3c, 0xee, 0x10, 0xd7, 0x04, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0xcc, 0x92,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0xea, 0x3a, 0xbd, 0x00, 0xb0, 0x22, 0x27,
-	0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x5a, 0x84, 0x42, 0xb9, 0x04, 0x60, 0x0a, 0xc2, 0xf2,
-	0x4b, 0x52, 0x7d, 0x32, 0x8b, 0x4b, 0x24, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x94, 0x71, 0x18,
-	0xe3, 0x8c, 0xa4, 0x1c, 0x6a, 0x18, 0x86, 0x11, 0x4e, 0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x8f, 0xe2, 0xe9, 0x0a, 0x84, 0xb7, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xfe, 0x35,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x24, 0xd4, 0x8c, 0x92, 0x01, 0x00, 0x00,
+	0x4d, 0xec, 0x6a, 0x60, 0x8c, 0xf8, 0xb2, 0xfc, 0x92, 0x54, 0xa8, 0x52, 0x3d, 0xec, 0x4a, 0xd3,
+	0x32, 0xf3, 0x12, 0x73, 0x32, 0xab, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0xe2, 0xd3, 0x8a, 0x12, 0x4b,
+	0x53, 0x20, 0xea, 0x95, 0x3a, 0x98, 0xb8, 0x78, 0xdc, 0x21, 0xae, 0x0f, 0x2e, 0x49, 0x2c, 0x49,
+	0x15, 0xb2, 0xe6, 0x62, 0x83, 0xd8, 0x2d, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xab, 0x87,
+	0xd5, 0x37, 0x7a, 0x01, 0x60, 0x45, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x08,
+	0x85, 0x72, 0x09, 0xc0, 0x14, 0x84, 0xe5, 0x97, 0xa4, 0xfa, 0x64, 0x16, 0x97, 0x48, 0x30, 0x29,
+	0x30, 0x6b, 0x70, 0x1b, 0x29, 0xe3, 0x30, 0xc6, 0x19, 0x49, 0x39, 0xd4, 0x30, 0x0c, 0x23, 0x84,
+	0x52, 0xb8, 0x44, 0x91, 0x3d, 0xe0, 0x06, 0x72, 0x3f, 0xd8, 0x6c, 0x66, 0xb0, 0xd9, 0x1a, 0x38,
+	0xcc, 0x76, 0x43, 0xd7, 0x03, 0xb5, 0x00, 0xbb, 0x61, 0x4e, 0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e,
+	0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31,
+	0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f,
+	0xab, 0x8f, 0x12, 0xbe, 0x15, 0x88, 0x10, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87,
+	0xaa, 0x31, 0x60, 0x00, 0xc4, 0x28, 0x55, 0xe8, 0x28, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +134,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationFraudList) > 0 {
+		for iNdEx := len(m.FinalizationFraudList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationFraudList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ConflictVoteList) > 0 {
 		for iNdEx := len(m.ConflictVoteList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -175,6 +200,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FinalizationFraudList) > 0 {
+		for _, e := range m.FinalizationFraudList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -280,6 +311,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationFraudList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationFraudList = append(m.FinalizationFraudList, FinalizationFraud{})
+			if err := m.FinalizationFraudList[len(m.FinalizationFraudList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
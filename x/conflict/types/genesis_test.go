@@ -30,6 +30,18 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				FinalizationFraudList: []types.FinalizationFraud{
+					{
+						ChainID:  "LAV1",
+						Provider: "provider",
+						Block:    10,
+					},
+					{
+						ChainID:  "LAV1",
+						Provider: "provider",
+						Block:    11,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -49,6 +61,26 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated finalizationFraud",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FinalizationFraudList: []types.FinalizationFraud{
+					{
+						ChainID:  "LAV1",
+						Provider: "provider",
+						Block:    10,
+					},
+					{
+						ChainID:     "LAV1",
+						Provider:    "provider",
+						Block:       10,
+						ReportBlock: 5,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
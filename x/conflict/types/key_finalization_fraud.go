@@ -0,0 +1,26 @@
+package types
+
+import "strconv"
+
+const (
+	// FinalizationFraudKeyPrefix is the prefix to retrieve all FinalizationFraud
+	FinalizationFraudKeyPrefix = "FinalizationFraud/value/"
+)
+
+// FinalizationFraudKey returns the store key to retrieve a FinalizationFraud from the index fields
+func FinalizationFraudKey(
+	chainID string,
+	provider string,
+	block int64,
+) []byte {
+	var key []byte
+
+	key = append(key, []byte(chainID)...)
+	key = append(key, []byte(" ")...)
+	key = append(key, []byte(provider)...)
+	key = append(key, []byte(" ")...)
+	key = append(key, []byte(strconv.FormatInt(block, 10))...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
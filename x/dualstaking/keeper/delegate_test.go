@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	commontypes "github.com/lavanet/lava/common/types"
 	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/x/dualstaking/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -650,3 +651,38 @@ func TestStakingUnbondStakeIsLowerThanMinStakeCausesFreeze(t *testing.T) {
 	require.True(t, stakeEntry.Stake.Amount.Equal(amountToUnbond.Amount))
 	require.True(t, stakeEntry.IsFrozen())
 }
+
+// TestSlashDelegatorPartial checks that slashing more than the delegator has burns only what
+// the delegator has, returns the burned amount and reports the partial slash
+func TestSlashDelegatorPartial(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForDelegation(1, 1, 0, 0)
+
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, provider1Addr := ts.GetAccount(common.PROVIDER, 0)
+
+	amount := sdk.NewCoin(commontypes.TokenDenom, sdk.NewInt(10000))
+	_, err := ts.TxDualstakingDelegate(client1Addr, provider1Addr, ts.spec.Name, amount)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	supply := ts.Keepers.BankKeeper.GetSupply(ts.Ctx, commontypes.TokenDenom).Amount
+
+	burned, rewarded, err := ts.Keepers.Dualstaking.SlashDelegator(ts.Ctx, client1Addr, provider1Addr, ts.spec.Name, amount.Add(amount), nil, sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPartialSlash)
+	require.Equal(t, amount, burned)
+	require.True(t, rewarded.IsZero())
+	require.Equal(t, supply.Sub(burned.Amount), ts.Keepers.BankKeeper.GetSupply(ts.Ctx, commontypes.TokenDenom).Amount)
+
+	res, err := ts.QueryDualstakingDelegatorProviders(client1Addr, true)
+	require.NoError(t, err)
+	for _, delegation := range res.Delegations {
+		require.NotEqual(t, provider1Addr, delegation.Provider)
+	}
+
+	// nothing is left to slash, nothing is burned
+	burned, _, err = ts.Keepers.Dualstaking.SlashDelegator(ts.Ctx, client1Addr, provider1Addr, ts.spec.Name, amount, nil, sdk.ZeroDec())
+	require.Error(t, err)
+	require.True(t, burned.IsZero())
+	require.Equal(t, supply.Sub(amount.Amount), ts.Keepers.BankKeeper.GetSupply(ts.Ctx, commontypes.TokenDenom).Amount)
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/dualstaking/types"
+)
+
+// SlashDelegator slashes a delegator's delegation to a provider. The amount is removed from the
+// delegator's validators delegations and burned, except for the reward percentage of it which is
+// sent to the reward account (if given). The delegation is decreased by the slashed amount (effective
+// on next epoch). The slashed and rewarded amounts are returned, if the slashed amount is less than
+// the requested amount the partial slash is kept and ErrPartialSlash is returned. On any other error
+// nothing is slashed.
+func (k Keeper) SlashDelegator(ctx sdk.Context, delegator, provider, chainID string, amount sdk.Coin, rewardAcct sdk.AccAddress, rewardPercent sdk.Dec) (slashed sdk.Coin, rewarded sdk.Coin, err error) {
+	zero := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	slashed, rewarded = zero, zero
+	if amount.IsZero() {
+		return slashed, rewarded, nil
+	}
+
+	delegatorAddr, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return zero, zero, utils.LavaFormatWarning("invalid delegator address", err,
+			utils.Attribute{Key: "delegator", Value: delegator},
+		)
+	}
+
+	// the slash is written only if all the burns and the unbond succeeded
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	// the providers delegations are decreased below by the burned amount, the staking hooks must not decrease them
+	disableHooks := k.GetDisableDualstakingHook(cacheCtx)
+	k.SetDisableDualstakingHook(cacheCtx, true)
+
+	remaining := amount.Amount
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(cacheCtx, delegatorAddr) {
+		if !remaining.IsPositive() {
+			break
+		}
+		validator, found := k.stakingKeeper.GetValidator(cacheCtx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		tokens := math.MinInt(validator.TokensFromShares(delegation.Shares).TruncateInt(), remaining)
+		if !tokens.IsPositive() {
+			continue
+		}
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(cacheCtx, delegatorAddr, validator.GetOperator(), tokens)
+		if err != nil {
+			return zero, zero, err
+		}
+		unbonded, err := k.stakingKeeper.Unbond(cacheCtx, delegatorAddr, validator.GetOperator(), shares)
+		if err != nil {
+			return zero, zero, err
+		}
+
+		// the unbonded tokens are still in the validator's pool
+		pool := stakingtypes.NotBondedPoolName
+		if validator.IsBonded() {
+			pool = stakingtypes.BondedPoolName
+		}
+		reward := math.ZeroInt()
+		if rewardAcct != nil {
+			reward = rewardPercent.MulInt(unbonded).TruncateInt()
+		}
+		if reward.IsPositive() {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, pool, rewardAcct, sdk.NewCoins(sdk.NewCoin(amount.Denom, reward)))
+			if err != nil {
+				return zero, zero, utils.LavaFormatError("failed to send the reward of slashed tokens", err,
+					utils.Attribute{Key: "delegator", Value: delegator},
+					utils.Attribute{Key: "validator", Value: validator.GetOperator().String()},
+					utils.Attribute{Key: "reward", Value: reward.String()},
+				)
+			}
+		}
+		toBurn := unbonded.Sub(reward)
+		if toBurn.IsPositive() {
+			err = k.bankKeeper.BurnCoins(cacheCtx, pool, sdk.NewCoins(sdk.NewCoin(amount.Denom, toBurn)))
+			if err != nil {
+				return zero, zero, utils.LavaFormatError("failed to burn slashed tokens", err,
+					utils.Attribute{Key: "delegator", Value: delegator},
+					utils.Attribute{Key: "validator", Value: validator.GetOperator().String()},
+					utils.Attribute{Key: "amount", Value: toBurn.String()},
+				)
+			}
+		}
+		slashed = slashed.AddAmount(unbonded)
+		rewarded = rewarded.AddAmount(reward)
+		remaining = remaining.Sub(tokens)
+	}
+	k.SetDisableDualstakingHook(cacheCtx, disableHooks)
+
+	if slashed.IsZero() {
+		return zero, zero, utils.LavaFormatWarning("delegator has no stake to slash", types.ErrPartialSlash,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "amount", Value: amount.String()},
+		)
+	}
+
+	err = k.unbond(cacheCtx, delegator, provider, chainID, slashed)
+	if err != nil {
+		return zero, zero, err
+	}
+	writeCache()
+
+	details := map[string]string{
+		"delegator": delegator,
+		"provider":  provider,
+		"chainID":   chainID,
+		"amount":    amount.String(),
+		"slashed":   slashed.String(),
+		"rewarded":  rewarded.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.DelegatorSlashEventName, details, "Delegator slashed")
+
+	if slashed.IsLT(amount) {
+		return slashed, rewarded, utils.LavaFormatWarning("delegator was partially slashed", types.ErrPartialSlash,
+			utils.Attribute{Key: "delegator", Value: delegator},
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "amount", Value: amount.String()},
+			utils.Attribute{Key: "slashed", Value: slashed.String()},
+		)
+	}
+
+	return slashed, rewarded, nil
+}
@@ -7,6 +7,7 @@ const (
 	ClaimRewardsEventName      = "delegator_claim_rewards"
 	ContributorRewardEventName = "contributor_rewards"
 	ValidatorSlashEventName    = "validator_slash"
+	DelegatorSlashEventName    = "delegator_slash"
 	FreezeFromUnbond           = "freeze_from_unbond"
 	UnstakeFromUnbond          = "unstake_from_unbond"
 )
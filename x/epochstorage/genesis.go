@@ -21,6 +21,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.FixatedParamsList {
 		k.SetFixatedParams(ctx, elem)
 	}
+	// Set all the epochStartTime
+	for _, elem := range genState.EpochStartTimeList {
+		k.SetEpochStartTime(ctx, elem.Epoch, elem.BlockTime)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -37,6 +41,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.EpochDetails = &epochDetails
 	}
 	genesis.FixatedParamsList = k.GetAllFixatedParams(ctx)
+	genesis.EpochStartTimeList = k.GetAllEpochStartTimes(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
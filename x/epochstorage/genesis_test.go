@@ -34,6 +34,16 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		EpochStartTimeList: []types.EpochStartTime{
+			{
+				Epoch:     60,
+				BlockTime: 1700000000,
+			},
+			{
+				Epoch:     80,
+				BlockTime: 1700000400,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -48,5 +58,6 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.StakeStorageList, got.StakeStorageList)
 	require.Equal(t, genesisState.EpochDetails, got.EpochDetails)
 	require.ElementsMatch(t, genesisState.FixatedParamsList, got.FixatedParamsList)
+	require.ElementsMatch(t, genesisState.EpochStartTimeList, got.EpochStartTimeList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
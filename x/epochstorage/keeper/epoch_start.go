@@ -19,6 +19,7 @@ func (k Keeper) EpochStart(ctx sdk.Context) {
 	// 4. update earliest epoch start
 
 	k.SetEpochDetailsStart(ctx, block)
+	k.SetEpochStartTime(ctx, block, uint64(ctx.BlockTime().UTC().Unix()))
 
 	k.StoreCurrentEpochStakeStorage(ctx, block)
 
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/epochstorage/types"
+)
+
+// SetEpochStartTime saves the block time (unix seconds) of an epoch start block
+func (k Keeper) SetEpochStartTime(ctx sdk.Context, epoch uint64, blockTime uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	store.Set(sdk.Uint64ToBigEndian(epoch), sdk.Uint64ToBigEndian(blockTime))
+}
+
+// GetEpochStartTime returns the block time (unix seconds) of an epoch start block. The time is
+// kept for the epochs in memory
+func (k Keeper) GetEpochStartTime(ctx sdk.Context, epoch uint64) (blockTime uint64, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	b := store.Get(sdk.Uint64ToBigEndian(epoch))
+	if b == nil {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(b), true
+}
+
+// RemoveEpochStartTime removes the block time of an epoch start block
+func (k Keeper) RemoveEpochStartTime(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	store.Delete(sdk.Uint64ToBigEndian(epoch))
+}
+
+// GetAllEpochStartTimes returns the block times of all the epoch start blocks in memory
+func (k Keeper) GetAllEpochStartTimes(ctx sdk.Context) (list []types.EpochStartTime) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EpochStartTimeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.EpochStartTime{
+			Epoch:     binary.BigEndian.Uint64(iterator.Key()),
+			BlockTime: binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return
+}
@@ -128,3 +128,28 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate5to6 implements store migration from v5 to v6:
+// - set the start time of the epochs in memory. their past block times are unknown, so they are set
+// to the upgrade block time, the time used for them until now
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	utils.LavaFormatDebug("migrate: epochstorage set the start time of the epochs in memory")
+
+	blockTime := uint64(ctx.BlockTime().UTC().Unix())
+	currentEpoch := m.keeper.GetEpochStart(ctx)
+	for epoch := m.keeper.GetEarliestEpochStart(ctx); epoch <= currentEpoch; {
+		if _, found := m.keeper.GetEpochStartTime(ctx, epoch); !found {
+			m.keeper.SetEpochStartTime(ctx, epoch, blockTime)
+		}
+		nextEpoch, err := m.keeper.GetNextEpoch(ctx, epoch)
+		if err != nil {
+			return err
+		}
+		if nextEpoch <= epoch {
+			break
+		}
+		epoch = nextEpoch
+	}
+
+	return nil
+}
@@ -73,6 +73,7 @@ func (k Keeper) RemoveOldEpochData(ctx sdk.Context) {
 		for _, chainID := range allChainIDs {
 			k.RemoveStakeStorageByBlockAndChain(ctx, block, chainID)
 		}
+		k.RemoveEpochStartTime(ctx, block)
 	}
 }
 
@@ -10,9 +10,10 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		StakeStorageList:  []StakeStorage{},
-		EpochDetails:      &EpochDetails{StartBlock: 0, EarliestStart: 0, DeletedEpochs: []uint64{}},
-		FixatedParamsList: []FixatedParams{},
+		StakeStorageList:   []StakeStorage{},
+		EpochDetails:       &EpochDetails{StartBlock: 0, EarliestStart: 0, DeletedEpochs: []uint64{}},
+		FixatedParamsList:  []FixatedParams{},
+		EpochStartTimeList: []EpochStartTime{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -41,6 +42,15 @@ func (gs GenesisState) Validate() error {
 		}
 		fixatedParamsIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated epoch in epochStartTime
+	epochStartTimeIndexMap := make(map[uint64]struct{})
+
+	for _, elem := range gs.EpochStartTimeList {
+		if _, ok := epochStartTimeIndexMap[elem.Epoch]; ok {
+			return fmt.Errorf("duplicated index for epochStartTime")
+		}
+		epochStartTimeIndexMap[elem.Epoch] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
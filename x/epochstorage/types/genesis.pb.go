@@ -25,10 +25,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the epochstorage module's genesis state.
 type GenesisState struct {
-	Params            Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	StakeStorageList  []StakeStorage  `protobuf:"bytes,2,rep,name=stakeStorageList,proto3" json:"stakeStorageList"`
-	EpochDetails      *EpochDetails   `protobuf:"bytes,3,opt,name=epochDetails,proto3" json:"epochDetails,omitempty"`
-	FixatedParamsList []FixatedParams `protobuf:"bytes,4,rep,name=fixatedParamsList,proto3" json:"fixatedParamsList"`
+	Params             Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StakeStorageList   []StakeStorage   `protobuf:"bytes,2,rep,name=stakeStorageList,proto3" json:"stakeStorageList"`
+	EpochDetails       *EpochDetails    `protobuf:"bytes,3,opt,name=epochDetails,proto3" json:"epochDetails,omitempty"`
+	FixatedParamsList  []FixatedParams  `protobuf:"bytes,4,rep,name=fixatedParamsList,proto3" json:"fixatedParamsList"`
+	EpochStartTimeList []EpochStartTime `protobuf:"bytes,5,rep,name=epochStartTimeList,proto3" json:"epochStartTimeList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -92,8 +93,69 @@ func (m *GenesisState) GetFixatedParamsList() []FixatedParams {
 	return nil
 }
 
+func (m *GenesisState) GetEpochStartTimeList() []EpochStartTime {
+	if m != nil {
+		return m.EpochStartTimeList
+	}
+	return nil
+}
+
+// the block time (unix seconds) of an epoch start block
+type EpochStartTime struct {
+	Epoch     uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	BlockTime uint64 `protobuf:"varint,2,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+}
+
+func (m *EpochStartTime) Reset()         { *m = EpochStartTime{} }
+func (m *EpochStartTime) String() string { return proto.CompactTextString(m) }
+func (*EpochStartTime) ProtoMessage()    {}
+func (*EpochStartTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6b4c1a9bc1f09c0e, []int{1}
+}
+func (m *EpochStartTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochStartTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochStartTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochStartTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochStartTime.Merge(m, src)
+}
+func (m *EpochStartTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochStartTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochStartTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochStartTime proto.InternalMessageInfo
+
+func (m *EpochStartTime) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochStartTime) GetBlockTime() uint64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.epochstorage.GenesisState")
+	proto.RegisterType((*EpochStartTime)(nil), "lavanet.lava.epochstorage.EpochStartTime")
 }
 
 func init() {
@@ -101,27 +163,31 @@ func init() {
 }
 
 var fileDescriptor_6b4c1a9bc1f09c0e = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0xa9, 0x05, 0xf9, 0xc9, 0x19, 0xc5, 0x25, 0xf9, 0x45,
-	0x89, 0xe9, 0xa9, 0xfa, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0x92, 0x50, 0x85, 0x7a, 0x20, 0x5a, 0x0f, 0x59, 0xa1, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x58, 0x95, 0x3e, 0x88, 0x05, 0xd1, 0x20, 0xa5, 0x86, 0xdb, 0xe4, 0x82, 0xc4, 0xa2, 0xc4,
-	0x5c, 0xa8, 0xc1, 0x52, 0xba, 0xb8, 0xd5, 0x15, 0x97, 0x24, 0x66, 0xa7, 0xc6, 0x43, 0x79, 0x84,
-	0x95, 0x83, 0x39, 0xf1, 0x29, 0xa9, 0x25, 0x89, 0x99, 0x39, 0x30, 0xd3, 0xf5, 0x70, 0x2b, 0x4f,
-	0xcb, 0xac, 0x48, 0x2c, 0x49, 0x4d, 0x89, 0x47, 0x76, 0x8d, 0xd2, 0x55, 0x26, 0x2e, 0x1e, 0x77,
-	0x88, 0xc7, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xec, 0xb9, 0xd8, 0x20, 0x0a, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0x14, 0xf5, 0x70, 0x06, 0x84, 0x5e, 0x00, 0x58, 0xa1, 0x13, 0xcb, 0x89,
-	0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x6d, 0x42, 0x91, 0x5c, 0x02, 0x60, 0x7f, 0x04, 0x43, 0x14, 0xf9,
-	0x64, 0x16, 0x97, 0x48, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0xe3, 0x31, 0x2a, 0x18, 0x49,
-	0x0b, 0xd4, 0x40, 0x0c, 0x63, 0x84, 0xbc, 0xb9, 0x78, 0xc0, 0x9a, 0x5c, 0x20, 0x5e, 0x96, 0x60,
-	0x06, 0xbb, 0x10, 0x9f, 0xb1, 0xae, 0x48, 0xca, 0x83, 0x50, 0x34, 0x0b, 0xc5, 0x70, 0x09, 0x42,
-	0x43, 0x04, 0xe2, 0x0d, 0xb0, 0x43, 0x59, 0xc0, 0x0e, 0xd5, 0xc0, 0x63, 0xa2, 0x1b, 0xb2, 0x1e,
-	0xa8, 0x4b, 0x31, 0x0d, 0x72, 0x72, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0x9d, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x94, 0xc8, 0xaa,
-	0x40, 0x8d, 0xae, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x34, 0x19, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xb0, 0xc2, 0x6e, 0xab, 0xb8, 0x02, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0x87, 0x5b, 0xfe, 0x25, 0x77, 0x20, 0x37, 0xf7, 0x4e, 0x58, 0xf4, 0x92, 0xdc, 0x8a, 0x2c,
+	0x04, 0x13, 0x6d, 0x13, 0x7c, 0x00, 0x13, 0x23, 0xb8, 0xd0, 0x85, 0xa1, 0x6e, 0x34, 0x26, 0xcd,
+	0x00, 0x63, 0x69, 0xa0, 0x4c, 0xd3, 0x1e, 0x0d, 0xbe, 0x85, 0x4f, 0xe3, 0x33, 0xb0, 0x64, 0xe9,
+	0xca, 0x18, 0x78, 0x11, 0xc3, 0x99, 0xd1, 0xb4, 0xc1, 0xc2, 0x6a, 0x18, 0xfa, 0xfd, 0xbe, 0x9e,
+	0x73, 0x7a, 0x48, 0x73, 0xc2, 0x9e, 0xd8, 0x94, 0x83, 0xbd, 0x3e, 0x6d, 0x1e, 0x8a, 0xc1, 0x28,
+	0x06, 0x11, 0x31, 0x8f, 0xdb, 0x1e, 0x9f, 0xf2, 0xd8, 0x8f, 0xad, 0x30, 0x12, 0x20, 0xe8, 0x3f,
+	0x05, 0x5a, 0xeb, 0xd3, 0x4a, 0x82, 0xb5, 0xaa, 0x27, 0x3c, 0x81, 0x94, 0xbd, 0xfe, 0x25, 0x03,
+	0xb5, 0x83, 0x6c, 0x73, 0xc8, 0x22, 0x16, 0x28, 0x71, 0xed, 0x38, 0x9b, 0x8b, 0x81, 0x8d, 0xb9,
+	0xab, 0x6e, 0xbb, 0x71, 0xbc, 0xb8, 0x43, 0x0e, 0xcc, 0x9f, 0x7c, 0xd9, 0xad, 0x6c, 0xfc, 0xc1,
+	0x9f, 0x31, 0xe0, 0x43, 0x37, 0x59, 0x4d, 0xe3, 0x35, 0x4f, 0x2a, 0x17, 0xb2, 0x71, 0x07, 0x18,
+	0x70, 0x7a, 0x4a, 0x4a, 0x12, 0x30, 0xf4, 0xba, 0xde, 0x2a, 0xb7, 0xf7, 0xad, 0xcc, 0x41, 0x58,
+	0xd7, 0x08, 0x9e, 0x15, 0xe6, 0xef, 0x7b, 0x5a, 0x4f, 0xc5, 0xe8, 0x2d, 0xf9, 0x83, 0x7d, 0x38,
+	0x12, 0xba, 0xf2, 0x63, 0x30, 0x72, 0xf5, 0x7c, 0xab, 0xdc, 0x6e, 0x6e, 0x51, 0x39, 0x89, 0x88,
+	0x12, 0x6e, 0x68, 0xe8, 0x25, 0xa9, 0x60, 0xe8, 0x5c, 0xb6, 0x6c, 0xe4, 0xeb, 0xfa, 0x0e, 0x6d,
+	0x27, 0x81, 0xf7, 0x52, 0x61, 0x7a, 0x4f, 0xfe, 0xaa, 0x89, 0xc8, 0x36, 0xb0, 0xd0, 0x02, 0x16,
+	0xda, 0xda, 0x62, 0xec, 0x26, 0x33, 0xaa, 0xd2, 0x4d, 0x11, 0x75, 0x09, 0xc5, 0x98, 0x03, 0x2c,
+	0x82, 0x1b, 0x3f, 0x90, 0x73, 0x28, 0xa2, 0xfe, 0x70, 0x57, 0xc1, 0xdf, 0x21, 0xe5, 0xff, 0x41,
+	0xd5, 0xe8, 0x90, 0xdf, 0x69, 0x96, 0x56, 0x49, 0x11, 0x39, 0xfc, 0x70, 0x85, 0x9e, 0xbc, 0xd0,
+	0xff, 0x84, 0xf4, 0x27, 0x62, 0x30, 0x76, 0xc1, 0x0f, 0xb8, 0x91, 0xc3, 0x47, 0xbf, 0xf0, 0x1f,
+	0x7c, 0x41, 0x77, 0xbe, 0x34, 0xf5, 0xc5, 0xd2, 0xd4, 0x3f, 0x96, 0xa6, 0xfe, 0xb2, 0x32, 0xb5,
+	0xc5, 0xca, 0xd4, 0xde, 0x56, 0xa6, 0x76, 0x77, 0xe4, 0xf9, 0x30, 0x7a, 0xec, 0x5b, 0x03, 0x11,
+	0xd8, 0xa9, 0xa5, 0x9a, 0xa5, 0xd7, 0x0a, 0x9e, 0x43, 0x1e, 0xf7, 0x4b, 0xb8, 0x4e, 0x27, 0x9f,
+	0x03, 0x00, 0x56, 0xaf, 0xd2, 0x0a, 0x60, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +210,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EpochStartTimeList) > 0 {
+		for iNdEx := len(m.EpochStartTimeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochStartTimeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.FixatedParamsList) > 0 {
 		for iNdEx := len(m.FixatedParamsList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -197,6 +277,39 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EpochStartTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochStartTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochStartTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -232,6 +345,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EpochStartTimeList) > 0 {
+		for _, e := range m.EpochStartTimeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochStartTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockTime))
+	}
 	return n
 }
 
@@ -407,6 +541,128 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartTimeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochStartTimeList = append(m.EpochStartTimeList, EpochStartTime{})
+			if err := m.EpochStartTimeList[len(m.EpochStartTimeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochStartTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochStartTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochStartTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -76,6 +76,23 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated epochStartTime",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				EpochStartTimeList: []types.EpochStartTime{
+					{
+						Epoch:     20,
+						BlockTime: 1700000000,
+					},
+					{
+						Epoch:     20,
+						BlockTime: 1700000400,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -32,3 +32,13 @@ func (stakeEntry *StakeEntry) UnFreeze(currentBlock uint64) {
 func (stakeEntry *StakeEntry) IsFrozen() bool {
 	return stakeEntry.StakeAppliedBlock == FROZEN_BLOCK
 }
+
+func (stakeEntry *StakeEntry) IsJailed(block uint64) bool {
+	return stakeEntry.JailEndBlock > block
+}
+
+// IsOperator returns true if the address can sign relays, payments and votes for the provider.
+// The provider address is always allowed, the operator only if it was set
+func (stakeEntry *StakeEntry) IsOperator(address string) bool {
+	return address == stakeEntry.Address || (stakeEntry.Operator != "" && address == stakeEntry.Operator)
+}
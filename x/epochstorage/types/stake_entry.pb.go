@@ -37,6 +37,9 @@ type StakeEntry struct {
 	DelegateCommission uint64       `protobuf:"varint,11,opt,name=delegate_commission,json=delegateCommission,proto3" json:"delegate_commission,omitempty"`
 	LastChange         uint64       `protobuf:"varint,12,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
 	BlockReport        *BlockReport `protobuf:"bytes,13,opt,name=block_report,json=blockReport,proto3" json:"block_report,omitempty"`
+	JailEndBlock       uint64       `protobuf:"varint,14,opt,name=jail_end_block,json=jailEndBlock,proto3" json:"jail_end_block,omitempty"`
+	Bail               *types.Coin  `protobuf:"bytes,15,opt,name=bail,proto3" json:"bail,omitempty"`
+	Operator           string       `protobuf:"bytes,16,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
 func (m *StakeEntry) Reset()         { *m = StakeEntry{} }
@@ -156,6 +159,27 @@ func (m *StakeEntry) GetBlockReport() *BlockReport {
 	return nil
 }
 
+func (m *StakeEntry) GetJailEndBlock() uint64 {
+	if m != nil {
+		return m.JailEndBlock
+	}
+	return 0
+}
+
+func (m *StakeEntry) GetBail() *types.Coin {
+	if m != nil {
+		return m.Bail
+	}
+	return nil
+}
+
+func (m *StakeEntry) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
 // BlockReport holds the most up-to-date info regarding blocks of the provider
 // It is set in the relay payment TX logic
 // used by the consumer to calculate the provider's sync score
@@ -221,39 +245,41 @@ func init() {
 }
 
 var fileDescriptor_df6302d6b53c056e = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xb6, 0x74, 0x6b, 0x9d, 0x0e, 0x81, 0xb7, 0x83, 0xb7, 0x43, 0x16, 0x86, 0x84, 0x22,
-	0x81, 0x1c, 0x6d, 0x88, 0x1f, 0x40, 0xab, 0x15, 0x81, 0x38, 0x05, 0x4e, 0x5c, 0x2a, 0x27, 0xb1,
-	0x52, 0xab, 0x89, 0x5f, 0x14, 0x9b, 0x89, 0xfd, 0x0b, 0x7e, 0xd6, 0x8e, 0x3b, 0x22, 0x21, 0x21,
-	0xd4, 0xfe, 0x11, 0x64, 0x3b, 0xdd, 0xda, 0xc3, 0x10, 0x3b, 0xd9, 0xef, 0x7d, 0xdf, 0xf7, 0xf4,
-	0xbd, 0xe7, 0x67, 0xf4, 0xaa, 0x62, 0x57, 0x4c, 0x72, 0x9d, 0x98, 0x33, 0xe1, 0x0d, 0xe4, 0x73,
-	0xa5, 0xa1, 0x65, 0x25, 0x4f, 0x94, 0x66, 0x0b, 0x3e, 0xe3, 0x52, 0xb7, 0xd7, 0xb4, 0x69, 0x41,
-	0x03, 0x3e, 0xee, 0xc8, 0xd4, 0x9c, 0x74, 0x93, 0x7c, 0x12, 0x3f, 0x5c, 0x87, 0xcb, 0xa2, 0x01,
-	0x21, 0xb5, 0x2b, 0x72, 0x72, 0x54, 0x42, 0x09, 0xf6, 0x9a, 0x98, 0x5b, 0x97, 0x0d, 0x73, 0x50,
-	0x35, 0xa8, 0x24, 0x63, 0x8a, 0x27, 0x57, 0xe7, 0x19, 0xd7, 0xec, 0x3c, 0xc9, 0x41, 0x48, 0x87,
-	0x9f, 0xfd, 0xf2, 0x11, 0xfa, 0x6c, 0x0c, 0x5d, 0x1a, 0x3f, 0xf8, 0x2d, 0xea, 0x5b, 0x7b, 0xc4,
-	0x8b, 0xbc, 0x38, 0xb8, 0x38, 0xa6, 0x4e, 0x4e, 0x8d, 0x9c, 0x76, 0x72, 0x3a, 0x01, 0x21, 0xc7,
-	0xfe, 0xcd, 0xef, 0xd3, 0x5e, 0xea, 0xd8, 0x98, 0xa0, 0x7d, 0x56, 0x14, 0x2d, 0x57, 0x8a, 0xec,
-	0x44, 0x5e, 0x3c, 0x4c, 0xd7, 0x21, 0xa6, 0xe8, 0xd0, 0xf5, 0xcb, 0x9a, 0xa6, 0x12, 0xbc, 0x98,
-	0x65, 0x15, 0xe4, 0x0b, 0xb2, 0x1b, 0x79, 0xb1, 0x9f, 0x3e, 0xb3, 0xd0, 0x3b, 0x87, 0x8c, 0x0d,
-	0x80, 0xdf, 0xa3, 0xe1, 0xba, 0x2f, 0x45, 0xfc, 0x68, 0x37, 0x0e, 0x2e, 0x5e, 0xd0, 0x07, 0xc7,
-	0x43, 0x2f, 0x3b, 0x6e, 0x67, 0xe7, 0x5e, 0x8b, 0x23, 0x14, 0x94, 0x1c, 0x2a, 0xc8, 0x99, 0x16,
-	0x20, 0x49, 0x3f, 0xf2, 0xe2, 0x7e, 0xba, 0x99, 0xc2, 0x47, 0xa8, 0x9f, 0xcf, 0x99, 0x90, 0x64,
-	0xcf, 0x5a, 0x76, 0x81, 0x69, 0xa5, 0x06, 0x29, 0x16, 0xbc, 0x25, 0x03, 0xd7, 0x4a, 0x17, 0xe2,
-	0x29, 0x7a, 0x52, 0xf0, 0x8a, 0x97, 0x4c, 0xf3, 0x99, 0x06, 0xcd, 0x2a, 0x32, 0xfc, 0xbf, 0x21,
-	0x1d, 0xac, 0x65, 0x5f, 0x8c, 0x6a, 0xab, 0x4e, 0x25, 0x6a, 0xa1, 0x09, 0x7a, 0x64, 0x9d, 0x4f,
-	0x46, 0x85, 0x13, 0x74, 0x78, 0x57, 0x27, 0x87, 0xba, 0x16, 0x4a, 0x99, 0x4e, 0x03, 0x3b, 0x5a,
-	0xbc, 0x86, 0x26, 0x77, 0x08, 0x3e, 0x45, 0x41, 0xc5, 0x94, 0x9e, 0xe5, 0x73, 0x26, 0x4b, 0x4e,
-	0x46, 0x96, 0x88, 0x4c, 0x6a, 0x62, 0x33, 0xf8, 0x03, 0x1a, 0xd9, 0xe7, 0x99, 0xb5, 0xbc, 0x81,
-	0x56, 0x93, 0x03, 0xeb, 0xeb, 0xe5, 0x3f, 0xe6, 0x6f, 0x1f, 0x2d, 0xb5, 0xec, 0x34, 0xc8, 0xee,
-	0x83, 0x8f, 0xfe, 0x60, 0xff, 0xe9, 0xe0, 0x6c, 0x8a, 0x82, 0x0d, 0x86, 0x99, 0xb8, 0x55, 0xdb,
-	0xed, 0xf2, 0x53, 0x17, 0xe0, 0xe7, 0x68, 0x54, 0x31, 0xcd, 0x95, 0xee, 0x76, 0x63, 0xc7, 0x82,
-	0x81, 0xcb, 0x59, 0xf9, 0x78, 0x7a, 0xb3, 0x0c, 0xbd, 0xdb, 0x65, 0xe8, 0xfd, 0x59, 0x86, 0xde,
-	0x8f, 0x55, 0xd8, 0xbb, 0x5d, 0x85, 0xbd, 0x9f, 0xab, 0xb0, 0xf7, 0xf5, 0x75, 0x29, 0xf4, 0xfc,
-	0x5b, 0x46, 0x73, 0xa8, 0x93, 0xad, 0xaf, 0xf2, 0x7d, 0xfb, 0xb3, 0xe8, 0xeb, 0x86, 0xab, 0x6c,
-	0xcf, 0x2e, 0xfd, 0x9b, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x58, 0x95, 0x4a, 0xd0, 0x9e, 0x03,
-	0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x5b, 0xa7, 0x4d, 0xd7, 0x69, 0x29, 0xdb, 0x1e, 0xb6, 0x3d, 0xb8, 0xa6, 0x20, 0x64,
+	0x09, 0xb0, 0xd5, 0x22, 0x1e, 0x80, 0x44, 0x09, 0x02, 0x71, 0x32, 0x9c, 0xb8, 0x58, 0x6b, 0x67,
+	0xe5, 0x2c, 0x59, 0xef, 0x58, 0xde, 0xa5, 0xa2, 0x6f, 0xc1, 0x63, 0xf5, 0xc0, 0xa1, 0x47, 0x4e,
+	0x08, 0x25, 0x2f, 0x82, 0x76, 0xed, 0xa4, 0xc9, 0xa1, 0xfc, 0x9c, 0x76, 0x67, 0xbe, 0xef, 0x1b,
+	0x7f, 0x33, 0xe3, 0x45, 0xcf, 0x04, 0xbd, 0xa2, 0x92, 0xe9, 0xd8, 0x9c, 0x31, 0xab, 0x20, 0x9f,
+	0x2a, 0x0d, 0x35, 0x2d, 0x58, 0xac, 0x34, 0x9d, 0xb1, 0x94, 0x49, 0x5d, 0x5f, 0x47, 0x55, 0x0d,
+	0x1a, 0xf0, 0x49, 0x4b, 0x8e, 0xcc, 0x19, 0xad, 0x93, 0x4f, 0xc3, 0xfb, 0xeb, 0x30, 0x39, 0xa9,
+	0x80, 0x4b, 0xdd, 0x14, 0x39, 0x3d, 0x2e, 0xa0, 0x00, 0x7b, 0x8d, 0xcd, 0xad, 0xcd, 0xfa, 0x39,
+	0xa8, 0x12, 0x54, 0x9c, 0x51, 0xc5, 0xe2, 0xab, 0x8b, 0x8c, 0x69, 0x7a, 0x11, 0xe7, 0xc0, 0x65,
+	0x83, 0x9f, 0x7f, 0xef, 0x22, 0xf4, 0xc1, 0x18, 0x1a, 0x19, 0x3f, 0xf8, 0x15, 0xea, 0x5a, 0x7b,
+	0xc4, 0x09, 0x9c, 0xd0, 0xbb, 0x3c, 0x89, 0x1a, 0x79, 0x64, 0xe4, 0x51, 0x2b, 0x8f, 0x86, 0xc0,
+	0xe5, 0xc0, 0xbd, 0xf9, 0x79, 0xd6, 0x49, 0x1a, 0x36, 0x26, 0x68, 0x97, 0x4e, 0x26, 0x35, 0x53,
+	0x8a, 0x6c, 0x05, 0x4e, 0xb8, 0x97, 0x2c, 0x43, 0x1c, 0xa1, 0xa3, 0xa6, 0x5f, 0x5a, 0x55, 0x82,
+	0xb3, 0x49, 0x9a, 0x09, 0xc8, 0x67, 0x64, 0x3b, 0x70, 0x42, 0x37, 0x79, 0x68, 0xa1, 0xd7, 0x0d,
+	0x32, 0x30, 0x00, 0x7e, 0x83, 0xf6, 0x96, 0x7d, 0x29, 0xe2, 0x06, 0xdb, 0xa1, 0x77, 0xf9, 0x38,
+	0xba, 0x77, 0x3c, 0xd1, 0xa8, 0xe5, 0xb6, 0x76, 0xee, 0xb4, 0x38, 0x40, 0x5e, 0xc1, 0x40, 0x40,
+	0x4e, 0x35, 0x07, 0x49, 0xba, 0x81, 0x13, 0x76, 0x93, 0xf5, 0x14, 0x3e, 0x46, 0xdd, 0x7c, 0x4a,
+	0xb9, 0x24, 0x3b, 0xd6, 0x72, 0x13, 0x98, 0x56, 0x4a, 0x90, 0x7c, 0xc6, 0x6a, 0xd2, 0x6b, 0x5a,
+	0x69, 0x43, 0x3c, 0x46, 0x07, 0x13, 0x26, 0x58, 0x41, 0x35, 0x4b, 0x35, 0x68, 0x2a, 0xc8, 0xde,
+	0xbf, 0x0d, 0x69, 0x7f, 0x29, 0xfb, 0x68, 0x54, 0x1b, 0x75, 0x04, 0x2f, 0xb9, 0x26, 0xe8, 0x3f,
+	0xeb, 0xbc, 0x37, 0x2a, 0x1c, 0xa3, 0xa3, 0x55, 0x9d, 0x1c, 0xca, 0x92, 0x2b, 0x65, 0x3a, 0xf5,
+	0xec, 0x68, 0xf1, 0x12, 0x1a, 0xae, 0x10, 0x7c, 0x86, 0x3c, 0x41, 0x95, 0x4e, 0xf3, 0x29, 0x95,
+	0x05, 0x23, 0x7d, 0x4b, 0x44, 0x26, 0x35, 0xb4, 0x19, 0xfc, 0x16, 0xf5, 0xed, 0x7a, 0xd2, 0x9a,
+	0x55, 0x50, 0x6b, 0xb2, 0x6f, 0x7d, 0x3d, 0xfd, 0xc3, 0xfc, 0xed, 0xd2, 0x12, 0xcb, 0x4e, 0xbc,
+	0xec, 0x2e, 0xc0, 0x4f, 0xd0, 0xc1, 0x67, 0xca, 0x45, 0xca, 0xe4, 0x72, 0xe5, 0x07, 0xf6, 0x73,
+	0x7d, 0x93, 0x1d, 0xc9, 0x76, 0xdb, 0x2f, 0x90, 0x9b, 0x51, 0x2e, 0xc8, 0x83, 0xbf, 0x0c, 0x20,
+	0xb1, 0x34, 0x7c, 0x8a, 0x7a, 0x50, 0xb1, 0x9a, 0x6a, 0xa8, 0xc9, 0xa1, 0x5d, 0xce, 0x2a, 0x7e,
+	0xe7, 0xf6, 0x76, 0x0f, 0x7b, 0xe7, 0x63, 0xe4, 0xad, 0x59, 0x32, 0x2b, 0xb6, 0x76, 0xed, 0xef,
+	0xec, 0x26, 0x4d, 0x80, 0x1f, 0xa1, 0xbe, 0xa0, 0x9a, 0x29, 0xdd, 0x3a, 0xdb, 0xb2, 0xa0, 0xd7,
+	0xe4, 0xac, 0x7c, 0x30, 0xbe, 0x99, 0xfb, 0xce, 0xed, 0xdc, 0x77, 0x7e, 0xcd, 0x7d, 0xe7, 0xdb,
+	0xc2, 0xef, 0xdc, 0x2e, 0xfc, 0xce, 0x8f, 0x85, 0xdf, 0xf9, 0xf4, 0xbc, 0xe0, 0x7a, 0xfa, 0x25,
+	0x8b, 0x72, 0x28, 0xe3, 0x8d, 0xb7, 0xf9, 0x75, 0xf3, 0x75, 0xea, 0xeb, 0x8a, 0xa9, 0x6c, 0xc7,
+	0xbe, 0xb2, 0x97, 0xbf, 0x07, 0x00, 0x7c, 0x02, 0xc1, 0xb6, 0x0f, 0x04, 0x00, 0x00,
 }
 
 func (m *StakeEntry) Marshal() (dAtA []byte, err error) {
@@ -276,6 +302,32 @@ func (m *StakeEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintStakeEntry(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Bail != nil {
+		{
+			size, err := m.Bail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStakeEntry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.JailEndBlock != 0 {
+		i = encodeVarintStakeEntry(dAtA, i, uint64(m.JailEndBlock))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.BlockReport != nil {
 		{
 			size, err := m.BlockReport.MarshalToSizedBuffer(dAtA[:i])
@@ -466,6 +518,17 @@ func (m *StakeEntry) Size() (n int) {
 		l = m.BlockReport.Size()
 		n += 1 + l + sovStakeEntry(uint64(l))
 	}
+	if m.JailEndBlock != 0 {
+		n += 1 + sovStakeEntry(uint64(m.JailEndBlock))
+	}
+	if m.Bail != nil {
+		l = m.Bail.Size()
+		n += 1 + l + sovStakeEntry(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 2 + l + sovStakeEntry(uint64(l))
+	}
 	return n
 }
 
@@ -860,6 +923,93 @@ func (m *StakeEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEndBlock", wireType)
+			}
+			m.JailEndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailEndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bail == nil {
+				m.Bail = &types.Coin{}
+			}
+			if err := m.Bail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStakeEntry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStakeEntry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStakeEntry(dAtA[iNdEx:])
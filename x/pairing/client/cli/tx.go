@@ -46,6 +46,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRelayPayment())
 	cmd.AddCommand(CmdFreeze())
 	cmd.AddCommand(CmdUnfreeze())
+	cmd.AddCommand(CmdBail())
+	cmd.AddCommand(CmdSetOperator())
 	cmd.AddCommand(CmdModifyProvider())
 	cmd.AddCommand(CmdSimulateRelayPayment())
 
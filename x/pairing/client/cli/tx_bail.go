@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdBail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bail [chain-id] [amount]",
+		Short: "Pays the bail of a jailed provider",
+		Long:  `The bail command allows a jailed provider to leave the jail before the jail period ends, effective next epoch. The bail is paid from the provider's balance to the conflict reward pool (it is not added to the provider's stake) and must be at least the bail set when the provider was jailed.`,
+		Example: `required flags: --from alice
+		lavad tx pairing bail [chain-id] [amount] --from <provider_address>
+		lavad tx pairing bail ETH1 1000ulava --from alice`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainID := args[0]
+			argBail, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBail(
+				clientCtx.GetFromAddress().String(),
+				argChainID,
+				argBail,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdSetOperator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-operator [chain-ids] [optional: operator]",
+		Short: "Sets the operator of a provider",
+		Long: `The set-operator command sets the address that is authorized to sign relays, relay payments and conflict votes for the provider, effective immediately. The provider address keeps the stake and the rewards, so its key doesn't need to be on the machine running the provider process. Use it to rotate a compromised operator key.
+		[operator] optional arg. if no operator is specified, the operator is reset and only the provider address can act for the provider`,
+		Example: `required flags: --from alice
+		lavad tx pairing set-operator [chain-ids] [operator] --from <provider_address>
+		lavad tx pairing set-operator ETH1,OSMOSIS lava@1wvn4slrf2r7cm92fnqdhvl3x470944uev92squ --from alice`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argChainIds := strings.Split(args[0], listSeparator)
+
+			var operator string
+			if len(args) > 1 {
+				operator = args[1]
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetProviderOperator(
+				clientCtx.GetFromAddress().String(),
+				argChainIds,
+				operator,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -95,6 +95,10 @@ func CmdStakeProvider() *cobra.Command {
 				delegationLimit,
 				commission,
 			)
+			msg.Operator, err = cmd.Flags().GetString(types.FlagOperator)
+			if err != nil {
+				return err
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -105,6 +109,7 @@ func CmdStakeProvider() *cobra.Command {
 	cmd.Flags().String(types.FlagMoniker, "", "The provider's moniker (non-unique name)")
 	cmd.Flags().Uint64(types.FlagCommission, 50, "The provider's commission from the delegators (default 50)")
 	cmd.Flags().String(types.FlagDelegationLimit, "0ulava", "The provider's total delegation limit from delegators (default 0)")
+	cmd.Flags().String(types.FlagOperator, "", "The address that runs the provider process and signs relay payments and votes (default is the --from address)")
 	cmd.MarkFlagRequired(types.FlagMoniker)
 	cmd.MarkFlagRequired(types.FlagDelegationLimit)
 	flags.AddTxFlagsToCmd(cmd)
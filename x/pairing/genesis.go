@@ -26,6 +26,16 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.SetBadgeUsedCu(ctx, elem)
 	}
 
+	// Set all the developerKeyEpochCu
+	for _, elem := range genState.DeveloperKeyEpochCuList {
+		k.SetDeveloperKeyEpochCu(ctx, elem)
+	}
+
+	// Set all the providerEpochQos
+	for _, elem := range genState.ProviderEpochQosList {
+		k.SetProviderEpochQos(ctx, elem)
+	}
+
 	k.InitBadgeTimers(ctx, genState.BadgesTS)
 	k.InitProviderQoS(ctx, genState.ProviderQosFS)
 	// this line is used by starport scaffolding # genesis/module/init
@@ -43,6 +53,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.BadgeUsedCuList = k.GetAllBadgeUsedCu(ctx)
 	genesis.BadgesTS = k.ExportBadgesTimers(ctx)
 	genesis.ProviderQosFS = k.ExportProviderQoS(ctx)
+	genesis.DeveloperKeyEpochCuList = k.GetAllDeveloperKeyEpochCu(ctx)
+	genesis.ProviderEpochQosList = k.GetAllProviderEpochQos(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -33,6 +33,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgUnfreezeProvider:
 			res, err := msgServer.UnfreezeProvider(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBailProvider:
+			res, err := msgServer.BailProvider(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgSetProviderOperator:
+			res, err := msgServer.SetProviderOperator(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
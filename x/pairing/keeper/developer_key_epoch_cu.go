@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetDeveloperKeyEpochCu set a specific developerKeyEpochCu in the store from its index
+func (k Keeper) SetDeveloperKeyEpochCu(ctx sdk.Context, developerKeyEpochCu types.DeveloperKeyEpochCu) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeveloperKeyEpochCuKeyPrefix))
+	b := k.cdc.MustMarshal(&developerKeyEpochCu)
+	store.Set(developerKeyEpochCu.DeveloperKeyEpochCuKey, b)
+}
+
+// GetDeveloperKeyEpochCu returns a developerKeyEpochCu from its index
+func (k Keeper) GetDeveloperKeyEpochCu(
+	ctx sdk.Context,
+	developerKeyEpochCuKey []byte,
+) (val types.DeveloperKeyEpochCu, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeveloperKeyEpochCuKeyPrefix))
+
+	b := store.Get(developerKeyEpochCuKey)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllDeveloperKeyEpochCu returns all developerKeyEpochCu
+func (k Keeper) GetAllDeveloperKeyEpochCu(ctx sdk.Context) (list []types.DeveloperKeyEpochCu) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeveloperKeyEpochCuKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DeveloperKeyEpochCu
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AddDeveloperKeyEpochCu adds CU used by a developer key in an epoch and returns the total CU the
+// key used in the epoch, with all the providers on all the chains
+func (k Keeper) AddDeveloperKeyEpochCu(ctx sdk.Context, epoch uint64, developerKey string, cu uint64) uint64 {
+	key := types.DeveloperKeyEpochCuKey(epoch, developerKey)
+	developerKeyEpochCu, found := k.GetDeveloperKeyEpochCu(ctx, key)
+	if !found {
+		developerKeyEpochCu = types.DeveloperKeyEpochCu{DeveloperKeyEpochCuKey: key}
+	}
+	developerKeyEpochCu.UsedCu += cu
+	k.SetDeveloperKeyEpochCu(ctx, developerKeyEpochCu)
+	return developerKeyEpochCu.UsedCu
+}
+
+// RemoveAllDeveloperKeyEpochCuForEpoch removes all the developerKeyEpochCu of an epoch
+func (k Keeper) RemoveAllDeveloperKeyEpochCuForEpoch(ctx sdk.Context, epoch uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DeveloperKeyEpochCuKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.DeveloperKeyEpochCuEpochPrefix(epoch))
+
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -1,20 +1,168 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
+// JailEntry removes the provider from the pairing until jailStartBlock+jailBlocks. The provider
+// can leave the jail early by paying the bail. Jailing an already jailed provider
+// extends the jail and adds up the bail.
 func (k Keeper) JailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, jailStartBlock, jailBlocks uint64, bail sdk.Coin) error {
-	// todo - provider will not get pairing and payment for this period
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatWarning("Jail_cant_get_stake_entry", types.JailStakeEntryNotFoundError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+		)
+	}
+
+	jailEndBlock := jailStartBlock + jailBlocks
+	if jailEndBlock <= uint64(ctx.BlockHeight()) {
+		// the jail period is already over
+		return nil
+	}
+
+	if stakeEntry.IsJailed(uint64(ctx.BlockHeight())) && stakeEntry.Bail != nil {
+		bail = bail.Add(*stakeEntry.Bail)
+	}
+	if jailEndBlock > stakeEntry.JailEndBlock {
+		stakeEntry.JailEndBlock = jailEndBlock
+	}
+	stakeEntry.Bail = &bail
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+
+	details := map[string]string{
+		"provider_address": account.String(),
+		"chain_id":         chainID,
+		"jail_end_block":   strconv.FormatUint(stakeEntry.JailEndBlock, 10),
+		"bail":             bail.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderJailedEventName, details, "Provider was jailed")
 	return nil
 }
 
+// BailEntry releases a jailed provider. The bail is paid from the provider's balance to the conflict
+// reward pool (it does not add to the provider's stake), it must be at least the bail set when the
+// provider was jailed.
 func (k Keeper) BailEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, bail sdk.Coin) error {
-	// todo - remove provider from jail and remove bail amount from account and add to stake
+	stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return utils.LavaFormatWarning("Bail_cant_get_stake_entry", types.JailStakeEntryNotFoundError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+		)
+	}
+
+	if !stakeEntry.IsJailed(uint64(ctx.BlockHeight())) {
+		return utils.LavaFormatWarning("Bail_provider_not_jailed", types.ProviderNotJailedError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+		)
+	}
+
+	if err := utils.ValidateCoins(ctx, k.stakingKeeper.BondDenom(ctx), bail, false); err != nil {
+		return err
+	}
+
+	if stakeEntry.Bail != nil && bail.IsLT(*stakeEntry.Bail) {
+		return utils.LavaFormatWarning("Bail_insufficient_bail", types.InsufficientBailError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+			utils.Attribute{Key: "bail", Value: bail},
+			utils.Attribute{Key: "requiredBail", Value: stakeEntry.Bail},
+		)
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, conflicttypes.ModuleName, sdk.NewCoins(bail))
+	if err != nil {
+		return utils.LavaFormatWarning("Bail_failed_paying_bail", err,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+			utils.Attribute{Key: "bail", Value: bail},
+		)
+	}
+
+	stakeEntry.JailEndBlock = 0
+	stakeEntry.Bail = nil
+	k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+
+	details := map[string]string{
+		"provider_address": account.String(),
+		"chain_id":         chainID,
+		"bail":             bail.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderBailEventName, details, "Provider paid bail and left the jail")
 	return nil
 }
 
-func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, percentage sdk.Dec) (sdk.Coin, error) {
-	// TODO: jail user, and count problems
-	return sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt()), nil
+// SlashEntry slashes a percentage of the provider's self stake and of its delegations on the chain.
+// The slashed tokens are burned by x/dualstaking, except for the reward percentage of them which is
+// sent to the reward account (if given). The total slashed and rewarded amounts are returned. If some
+// of the delegations couldn't be fully slashed the rest are still slashed, and the amounts that were
+// slashed are returned with an error.
+func (k Keeper) SlashEntry(ctx sdk.Context, account sdk.AccAddress, chainID string, percentage sdk.Dec, rewardAcct sdk.AccAddress, rewardPercent sdk.Dec) (slashed sdk.Coin, rewarded sdk.Coin, err error) {
+	slashed = sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())
+	rewarded = slashed
+	if percentage.IsNegative() || percentage.GT(sdk.OneDec()) {
+		return slashed, rewarded, utils.LavaFormatWarning("Slash_invalid_percentage", types.InvalidSlashPercentageError,
+			utils.Attribute{Key: "percentage", Value: percentage},
+		)
+	}
+	if rewardPercent.IsNegative() || rewardPercent.GT(sdk.OneDec()) {
+		return slashed, rewarded, utils.LavaFormatWarning("Slash_invalid_reward_percentage", types.InvalidSlashPercentageError,
+			utils.Attribute{Key: "rewardPercent", Value: rewardPercent},
+		)
+	}
+
+	_, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, account)
+	if !found {
+		return slashed, rewarded, utils.LavaFormatWarning("Slash_cant_get_stake_entry", types.JailStakeEntryNotFoundError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: account.String()},
+		)
+	}
+
+	provider := account.String()
+	delegations, err := k.dualstakingKeeper.GetProviderDelegators(ctx, provider, k.epochStorageKeeper.GetCurrentNextEpoch(ctx))
+	if err != nil {
+		return slashed, rewarded, err
+	}
+
+	var slashErr error
+	for _, delegation := range delegations {
+		if delegation.ChainID != chainID {
+			continue
+		}
+		amount := sdk.NewCoin(delegation.Amount.Denom, percentage.MulInt(delegation.Amount.Amount).TruncateInt())
+		if amount.IsZero() {
+			continue
+		}
+		delegationSlashed, delegationRewarded, err := k.dualstakingKeeper.SlashDelegator(ctx, delegation.Delegator, provider, chainID, amount, rewardAcct, rewardPercent)
+		slashed = slashed.Add(delegationSlashed)
+		rewarded = rewarded.Add(delegationRewarded)
+		if err != nil {
+			slashErr = utils.LavaFormatError("failed slashing delegator", err,
+				utils.Attribute{Key: "delegator", Value: delegation.Delegator},
+				utils.Attribute{Key: "provider", Value: provider},
+				utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "amount", Value: amount.String()},
+				utils.Attribute{Key: "slashed", Value: delegationSlashed.String()},
+			)
+		}
+	}
+
+	details := map[string]string{
+		"provider_address": provider,
+		"chain_id":         chainID,
+		"percentage":       percentage.String(),
+		"slashed":          slashed.String(),
+		"rewarded":         rewarded.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderSlashedEventName, details, "Provider and its delegators were slashed")
+	return slashed, rewarded, slashErr
 }
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/lavanet/lava/testutil/common"
+	conflicttypes "github.com/lavanet/lava/x/conflict/types"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test jail/bail and its effect on the pairing list
+func TestJailBail(t *testing.T) {
+	ts := newTester(t)
+
+	providersCount := 2
+	ts.setupForPayments(providersCount, 1, providersCount) // 1 client, set providers-to-pair
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	providerAcc, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	bail := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake/5))
+
+	// bail a provider that is not jailed
+	_, err := ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail)
+	require.ErrorIs(t, err, types.ProviderNotJailedError)
+
+	// jail the provider
+	err = ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, ts.BlockHeight(), 100*ts.EpochBlocks(), bail)
+	require.NoError(t, err)
+
+	// advance epoch and verify the provider is not in the pairing list anymore
+	ts.AdvanceEpoch()
+
+	res, err := ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, providersCount-1, len(res.Providers))
+	for _, provider := range res.Providers {
+		require.NotEqual(t, providerAddr, provider.Address)
+	}
+
+	// a jailed provider can't unfreeze
+	_, err = ts.TxPairingUnfreezeProvider(providerAddr, ts.spec.Index)
+	require.ErrorIs(t, err, types.UnFreezeJailedProviderError)
+
+	// the bail must cover the bail set when jailed
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail.SubAmount(sdk.OneInt()))
+	require.ErrorIs(t, err, types.InsufficientBailError)
+
+	// the bail is paid to the conflict reward pool
+	poolAddr := authtypes.NewModuleAddress(conflicttypes.ModuleName)
+	balance := ts.GetBalance(providerAcc.Addr)
+	poolBalance := ts.GetBalance(poolAddr)
+	_, err = ts.TxPairingBailProvider(providerAddr, ts.spec.Index, bail)
+	require.NoError(t, err)
+	require.Equal(t, balance-bail.Amount.Int64(), ts.GetBalance(providerAcc.Addr))
+	require.Equal(t, poolBalance+bail.Amount.Int64(), ts.GetBalance(poolAddr))
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.False(t, stakeEntry.IsJailed(ts.BlockHeight()))
+	require.Nil(t, stakeEntry.Bail)
+	require.Equal(t, int64(testStake), stakeEntry.Stake.Amount.Int64())
+
+	// advance an epoch and verify the provider is back in the pairing list
+	ts.AdvanceEpoch()
+
+	res, err = ts.QueryPairingGetPairing(ts.spec.Index, clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, providersCount, len(res.Providers))
+}
+
+// Test that jailing an already jailed provider extends the jail and adds up the bail
+func TestJailTwice(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 1)
+
+	providerAcc, _ := ts.GetAccount(common.PROVIDER, 0)
+	bail := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(100))
+
+	err := ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, ts.BlockHeight(), 10, bail)
+	require.NoError(t, err)
+	err = ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, ts.BlockHeight(), 20, bail)
+	require.NoError(t, err)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.Equal(t, ts.BlockHeight()+20, stakeEntry.JailEndBlock)
+	require.Equal(t, bail.Add(bail), *stakeEntry.Bail)
+
+	// the jail ends by itself
+	ts.AdvanceBlocks(20)
+	stakeEntry, _, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.False(t, stakeEntry.IsJailed(ts.BlockHeight()))
+
+	// a jail that already ended does nothing
+	err = ts.Keepers.Pairing.JailEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, 0, 1, bail)
+	require.NoError(t, err)
+	stakeEntry, _, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.False(t, stakeEntry.IsJailed(ts.BlockHeight()))
+}
+
+// Test slashing a provider and its delegators
+func TestSlashEntry(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 1)
+
+	providerAcc, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	delegatorAcc, delegatorAddr := ts.AddAccount(common.CONSUMER, 1, testBalance)
+	rewardAcc, _ := ts.AddAccount(common.CONSUMER, 2, testBalance)
+	delegation := sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(testStake))
+	_, err := ts.TxDualstakingDelegate(delegatorAddr, providerAddr, ts.spec.Index, delegation)
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+
+	supply := ts.Keepers.BankKeeper.GetSupply(ts.Ctx, ts.TokenDenom()).Amount
+
+	_, _, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, sdk.NewDecWithPrec(11, 1), nil, sdk.ZeroDec())
+	require.ErrorIs(t, err, types.InvalidSlashPercentageError)
+
+	_, _, err = ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, sdk.NewDecWithPrec(1, 1), rewardAcc.Addr, sdk.NewDecWithPrec(11, 1))
+	require.ErrorIs(t, err, types.InvalidSlashPercentageError)
+
+	rewardBalance := ts.GetBalance(rewardAcc.Addr)
+	slashed, rewarded, err := ts.Keepers.Pairing.SlashEntry(ts.Ctx, providerAcc.Addr, ts.spec.Index, sdk.NewDecWithPrec(1, 1), rewardAcc.Addr, sdk.NewDecWithPrec(5, 1))
+	require.NoError(t, err)
+	require.Equal(t, int64(2*testStake/10), slashed.Amount.Int64())
+	require.Equal(t, int64(testStake/10), rewarded.Amount.Int64())
+
+	// the reward is paid from the slashed tokens and the rest is burned
+	require.Equal(t, rewardBalance+rewarded.Amount.Int64(), ts.GetBalance(rewardAcc.Addr))
+	require.Equal(t, supply.Sub(slashed.Amount).Add(rewarded.Amount), ts.Keepers.BankKeeper.GetSupply(ts.Ctx, ts.TokenDenom()).Amount)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcc.Addr)
+	require.True(t, found)
+	require.Equal(t, testStake*9/10, stakeEntry.Stake.Amount.Int64())
+	require.Equal(t, testStake*9/10, stakeEntry.DelegateTotal.Amount.Int64())
+
+	res, err := ts.QueryDualstakingDelegatorProviders(delegatorAcc.Addr.String(), true)
+	require.NoError(t, err)
+	require.Len(t, res.Delegations, 1)
+	require.Equal(t, testStake*9/10, res.Delegations[0].Amount.Amount.Int64())
+}
@@ -87,6 +87,7 @@ func (k Keeper) GetAllEpochPayments(ctx sdk.Context) (list []types.EpochPayments
 func (k Keeper) RemoveOldEpochPayment(ctx sdk.Context) {
 	for _, epoch := range k.epochStorageKeeper.GetDeletedEpochs(ctx) {
 		k.RemoveAllEpochPaymentsForBlockAppendAdjustments(ctx, epoch)
+		k.RemoveAllDeveloperKeyEpochCuForEpoch(ctx, epoch)
 	}
 }
 
@@ -169,7 +170,6 @@ func (k Keeper) RemoveAllEpochPaymentsForBlockAppendAdjustments(ctx sdk.Context,
 		return
 	}
 
-	// TODO: update Qos in providerQosFS. new consumers (cluster.subUsage = 0) get default QoS (what is default?)
 	consumerUsage := map[string]uint64{}
 	type couplingConsumerProvider struct {
 		consumer string
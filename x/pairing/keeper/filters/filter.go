@@ -7,7 +7,6 @@ import (
 	"github.com/lavanet/lava/utils"
 	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
 	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
-	"github.com/lavanet/lava/x/pairing/types"
 	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
@@ -85,14 +84,9 @@ func SetupScores(ctx sdk.Context, filters []Filter, providers []epochstoragetype
 		}
 
 		if result {
-			// TODO: uncomment this code once the providerQosFS's update is implemented (it's currently always empty)
-			// qos, err := qg.GetQos(ctx, providers[j].Chain, cluster, providers[j].Address)
-			// if err != nil {
-			// 	// only printing error and skipping provider so pairing won't fail
-			// 	utils.LavaFormatError("could not construct provider qos", err)
-			// 	continue
-			// }
-			providerScore := pairingscores.NewPairingScore(&providers[j], types.QualityOfServiceReport{})
+			// providers without QoS reports in the cluster get the default QoS score
+			qos, _ := qg.GetQos(ctx, providers[j].Chain, cluster, providers[j].Address, currentEpoch)
+			providerScore := pairingscores.NewPairingScore(&providers[j], qos)
 			providerScore.SlotFiltering = slotFiltering
 			providerScores = append(providerScores, providerScore)
 		}
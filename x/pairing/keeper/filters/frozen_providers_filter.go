@@ -13,7 +13,7 @@ func (f *FrozenProvidersFilter) IsMix() bool {
 }
 
 func (f *FrozenProvidersFilter) InitFilter(strictestPolicy planstypes.Policy) bool {
-	// frozen providers (or providers that their stake is not applied yet) can't be part of the pairing - this filter is always active
+	// frozen or jailed providers (or providers that their stake is not applied yet) can't be part of the pairing - this filter is always active
 	return true
 }
 
@@ -29,5 +29,5 @@ func (f *FrozenProvidersFilter) Filter(ctx sdk.Context, providers []epochstorage
 }
 
 func isProviderFrozen(ctx sdk.Context, stakeEntry epochstoragetypes.StakeEntry, currentEpoch uint64) bool {
-	return stakeEntry.StakeAppliedBlock > currentEpoch
+	return stakeEntry.StakeAppliedBlock > currentEpoch || stakeEntry.IsJailed(currentEpoch)
 }
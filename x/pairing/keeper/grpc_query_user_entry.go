@@ -45,17 +45,18 @@ func (k Keeper) UserEntry(goCtx context.Context, req *types.QueryUserEntryReques
 		return nil, err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
+	planPolicy, cuLeftInSubscription := k.planCuLimits(ctx, plan, sub)
 	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
 	// geolocation is a bitmap. common denominator can be calculated with logical AND
 	geolocation, err := k.CalculateEffectiveGeolocationFromPolicies(policies)
 	if err != nil {
 		return nil, err
 	}
-	allowedCU, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCU, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), cuLeftInSubscription)
 	if !planstypes.VerifyTotalCuUsage(allowedCUTotal, project.GetUsedCu()) {
 		allowedCU = 0
 	}
+	allowedCU = developerKeyMaxCu(project, req.Address, allowedCU)
 
 	return &types.QueryUserEntryResponse{Consumer: epochstoragetypes.StakeEntry{
 		Geolocation: geolocation,
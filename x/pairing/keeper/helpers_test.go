@@ -52,6 +52,14 @@ func newTester(t *testing.T) *tester {
 	return ts
 }
 
+// disableOveruse overwrites the default "free" plan so consumers are cut off once their CU allowance is used
+func (ts *tester) disableOveruse() *tester {
+	ts.plan.AllowOveruse = false
+	ts.plan.OveruseRate = 0
+	ts.AddPlan("free", ts.plan)
+	return ts
+}
+
 func (ts *tester) addClient(count int) {
 	start := len(ts.Accounts(common.CONSUMER))
 	for i := 0; i < count; i++ {
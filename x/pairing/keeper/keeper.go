@@ -107,6 +107,8 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) BeginBlock(ctx sdk.Context) {
 	if k.epochStorageKeeper.IsEpochStart(ctx) {
+		// aggregate the QoS excellence reports of the previous epoch
+		k.AggregateEpochQos(ctx)
 		// remove old session payments
 		k.RemoveOldEpochPayment(ctx)
 		// unstake any unstaking providers
@@ -2,13 +2,35 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/lavanet/lava/utils"
 	planstypes "github.com/lavanet/lava/x/plans/types"
+	projectstypes "github.com/lavanet/lava/x/projects/types"
+	subscriptiontypes "github.com/lavanet/lava/x/subscription/types"
 )
 
+// returns the plan policy and the CU left in the subscription to enforce. when the plan allows overuse the plan's
+// total CU limit is not enforced, and the CU left in the subscription include the overuse CU the subscription's
+// credit and creator can pay for (charged when the month ends). CU bought with top-ups extend the plan's total CU limit for the month
+func (k Keeper) planCuLimits(ctx sdk.Context, plan planstypes.Plan, sub subscriptiontypes.Subscription) (planPolicy planstypes.Policy, cuLeftInSubscription uint64) {
+	planPolicy = plan.GetPlanPolicy()
+	if plan.AllowOveruse {
+		planPolicy.TotalCuLimit = math.MaxUint64
+		overuseCuLeft := k.subscriptionKeeper.OveruseCuLeft(ctx, sub)
+		if overuseCuLeft > math.MaxUint64-sub.GetMonthCuLeft() {
+			return planPolicy, math.MaxUint64
+		}
+		return planPolicy, sub.GetMonthCuLeft() + overuseCuLeft
+	}
+	if planPolicy.TotalCuLimit != 0 {
+		planPolicy.TotalCuLimit += sub.GetMonthTopUpCu()
+	}
+	return planPolicy, sub.GetMonthCuLeft()
+}
+
 func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, epochAllowedCU, totalCUInEpochForUserProvider uint64, clientAddr sdk.AccAddress, chainID string, epoch uint64) (uint64, error) {
 	project, err := k.GetProjectData(ctx, clientAddr, chainID, epoch)
 	if err != nil {
@@ -20,33 +42,25 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, epochAllo
 		return 0, err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
-	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
-
 	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
 	if !found {
 		return 0, utils.LavaFormatError("can't find subscription", fmt.Errorf("EnforceClientCUsUsageInEpoch_cant_find_subscription"), utils.Attribute{Key: "subscriptionKey", Value: project.GetSubscription()})
 	}
 
-	if sub.GetMonthCuLeft() == 0 {
+	planPolicy, cuLeftInSubscription := k.planCuLimits(ctx, plan, sub)
+	policies := []*planstypes.Policy{&planPolicy, project.AdminPolicy, project.SubscriptionPolicy}
+
+	if cuLeftInSubscription == 0 {
 		return 0, utils.LavaFormatError("total cu in epoch for consumer exceeded the amount of CU left in the subscription", fmt.Errorf("consumer CU limit exceeded for subscription"), []utils.Attribute{{Key: "subscriptionCuLeft", Value: sub.GetMonthCuLeft()}}...)
 	}
 
-	_, effectivePolicyTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, sub.MonthCuLeft)
+	_, effectivePolicyTotalCu := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.UsedCu, cuLeftInSubscription)
 	if !planstypes.VerifyTotalCuUsage(effectivePolicyTotalCu, totalCUInEpochForUserProvider) {
 		return effectivePolicyTotalCu - project.UsedCu, nil
 	}
 
 	epochCuLimit := epochAllowedCU * k.downtimeKeeper.GetDowntimeFactor(ctx, epoch)
-	// Check if the total CUs used in the epoch is larger than the CU left for this epoch
-	if totalCUInEpochForUserProvider > epochCuLimit {
-		// Return remaining CU allowed for this epoch
-		originalTotalCUInEpochForUserProvider := totalCUInEpochForUserProvider - relayCU
-		if originalTotalCUInEpochForUserProvider <= epochCuLimit {
-			// Remaining CU allowed for this epoch is the epoch limit minus the original total used
-			return epochCuLimit - originalTotalCUInEpochForUserProvider, nil
-		}
-
+	if totalCUInEpochForUserProvider-relayCU > epochCuLimit {
 		utils.LavaFormatInfo("Client exceeded epoch CU limit",
 			utils.LogAttr("epochCuLimit", epochCuLimit),
 			utils.LogAttr("totalCUInEpochForUserProvider", totalCUInEpochForUserProvider),
@@ -55,10 +69,44 @@ func (k Keeper) EnforceClientCUsUsageInEpoch(ctx sdk.Context, relayCU, epochAllo
 			utils.LogAttr("chainID", chainID),
 			utils.LogAttr("epoch", epoch),
 		)
+	}
+
+	return cuWithinEpochLimit(relayCU, epochCuLimit, totalCUInEpochForUserProvider), nil
+}
+
+// EnforceDeveloperKeyCuUsageInEpoch caps the CU of a relay by the developer key's epoch CU limit (with all
+// the providers), the CU of the relay is added to the key's usage in the epoch
+func (k Keeper) EnforceDeveloperKeyCuUsageInEpoch(ctx sdk.Context, relayCU uint64, project projectstypes.Project, developerKey string, epoch uint64) uint64 {
+	keyCuLimit := project.GetKey(developerKey).EpochCuLimit
+	if keyCuLimit == 0 {
+		return relayCU
+	}
 
-		// There's no CU left for this epoch, so relay usage is 0
-		return 0, nil
+	totalCUInEpochForKey := k.AddDeveloperKeyEpochCu(ctx, epoch, developerKey, relayCU)
+	keyCuLimit *= k.downtimeKeeper.GetDowntimeFactor(ctx, epoch)
+	return cuWithinEpochLimit(relayCU, keyCuLimit, totalCUInEpochForKey)
+}
+
+// developerKeyMaxCu caps the CU allowed per epoch by the developer key's epoch CU limit
+func developerKeyMaxCu(project projectstypes.Project, developerKey string, allowedCU uint64) uint64 {
+	keyCuLimit := project.GetKey(developerKey).EpochCuLimit
+	if keyCuLimit != 0 && keyCuLimit < allowedCU {
+		return keyCuLimit
 	}
+	return allowedCU
+}
 
-	return relayCU, nil
+// returns the CU of a relay that fits in the epoch CU limit, given the total CU used in the epoch
+// (including the relay)
+func cuWithinEpochLimit(relayCU, epochCuLimit, totalCUInEpoch uint64) uint64 {
+	if totalCUInEpoch <= epochCuLimit {
+		return relayCU
+	}
+	// Remaining CU allowed for this epoch is the epoch limit minus the original total used
+	originalTotalCUInEpoch := totalCUInEpoch - relayCU
+	if originalTotalCUInEpoch <= epochCuLimit {
+		return epochCuLimit - originalTotalCUInEpoch
+	}
+	// There's no CU left for this epoch, so relay usage is 0
+	return 0
 }
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) BailProvider(goCtx context.Context, msg *types.MsgBailProvider) (*types.MsgBailProviderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return &types.MsgBailProviderResponse{}, err
+	}
+
+	providerAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return &types.MsgBailProviderResponse{}, err
+	}
+
+	err = k.Keeper.BailEntry(ctx, providerAddr, msg.ChainID, msg.Bail)
+	return &types.MsgBailProviderResponse{}, err
+}
@@ -82,13 +82,6 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				utils.Attribute{Key: "creator", Value: msg.Creator},
 			)
 		}
-		if !providerAddr.Equals(creator) {
-			return nil, utils.LavaFormatWarning("invalid provider address in relay msg", fmt.Errorf("creator and signed provider mismatch"),
-				utils.Attribute{Key: "provider", Value: relay.Provider},
-				utils.Attribute{Key: "creator", Value: msg.Creator},
-			)
-		}
-
 		var newBadgeTimerExpiry uint64 // if the badge is new and need to setup a timer, this will be a non-zero value
 		if relay.LavaChainId != lavaChainID {
 			utils.LavaFormatWarning("relay request for the wrong lava chain", fmt.Errorf("relay_payment_wrong_lava_chain_id"),
@@ -144,6 +137,14 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			continue
 		}
 
+		if !k.IsProviderOperator(ctx, relay.SpecId, providerAddr, creator, epochStart) {
+			return nil, utils.LavaFormatWarning("invalid provider address in relay msg", types.UnauthorizedOperatorError,
+				utils.Attribute{Key: "provider", Value: relay.Provider},
+				utils.Attribute{Key: "creator", Value: msg.Creator},
+				utils.Attribute{Key: "epoch", Value: epochStart},
+			)
+		}
+
 		if paymentHandler.IsDoubleSpend(ctx, relay.SpecId, epochStart, project.Index, providerAddr, strconv.FormatUint(relay.SessionId, 16)) {
 			utils.LavaFormatWarning("double spending detected", err,
 				utils.Attribute{Key: "epoch", Value: epochStart},
@@ -208,6 +209,13 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 					utils.Attribute{Key: "provider", Value: providerAddr.String()},
 				)
 			}
+			// the pairing is valid for operators too, but only the provider address is paid
+			if !isStakeEntryAddress(providers, relay.Provider) {
+				return nil, utils.LavaFormatWarning("invalid provider address in relay msg", fmt.Errorf("relay provider is an operator, not a provider address"),
+					utils.Attribute{Key: "client", Value: clientAddr.String()},
+					utils.Attribute{Key: "provider", Value: providerAddr.String()},
+				)
+			}
 			validatePairingCache[validatePairingKey] = providers
 		}
 
@@ -223,6 +231,9 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 				utils.Attribute{Key: "totalCUInEpochForUserProvider", Value: totalCUInEpochForUserProvider},
 			)
 		}
+		// the developer key's own CU limit applies within the project's epoch limit, CU above the
+		// project's limit doesn't count towards it
+		rewardedCU = k.Keeper.EnforceDeveloperKeyCuUsageInEpoch(ctx, rewardedCU, project, clientAddr.String(), epochStart)
 
 		// pairing is valid, we can pay provider for work
 		rewardedCUDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(rewardedCU))
@@ -253,6 +264,11 @@ func (k msgServer) RelayPayment(goCtx context.Context, msg *types.MsgRelayPaymen
 			details["ExcellenceQoSLatency"] = relay.QosExcellenceReport.Latency.String()
 			details["ExcellenceQoSAvailability"] = relay.QosExcellenceReport.Availability.String()
 			details["ExcellenceQoSSync"] = relay.QosExcellenceReport.Sync.String()
+
+			sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+			if found {
+				k.UpdateProviderQos(ctx, relay.SpecId, sub.Cluster, relay.Provider, *relay.QosExcellenceReport, rewardedCU)
+			}
 		}
 
 		details["projectID"] = project.Index
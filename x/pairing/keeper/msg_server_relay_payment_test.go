@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
@@ -540,6 +541,121 @@ func TestCuUsageInProjectsAndSubscription(t *testing.T) {
 	require.NotEqual(t, sub.Sub.MonthCuTotal-sub.Sub.MonthCuLeft, proj2.Project.UsedCu)
 }
 
+// TestDeveloperKeyRestrictionsInPayment tests that a developer key with chain scopes cannot be paired
+// on other chains, and that its relays are paid up to the key's epoch CU limit with all the providers
+func TestDeveloperKeyRestrictionsInPayment(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(0, 0, 2)    // 0 sub, 0 adm, 2 dev
+	ts.setupForPayments(2, 1, 0) // 2 providers, 1 client, default providers-to-pair
+
+	dev1Acct, dev1Addr := ts.Account("dev1")
+	_, dev2Addr := ts.Account("dev2")
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, provider2Addr := ts.GetAccount(common.PROVIDER, 1)
+
+	keyCuLimit := uint64(10)
+	dev1Key := projectstypes.ProjectDeveloperKey(dev1Addr)
+	dev1Key.ChainIds = []string{ts.spec.Index}
+	dev1Key.EpochCuLimit = keyCuLimit
+	dev2Key := projectstypes.ProjectDeveloperKey(dev2Addr)
+	dev2Key.ChainIds = []string{"OTHER"}
+
+	res, err := ts.QueryProjectDeveloper(client1Addr)
+	require.NoError(t, err)
+	err = ts.TxProjectAddKeys(res.Project.Index, client1Addr, dev1Key, dev2Key)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+
+	// the key can be paired only on its chains
+	_, err = ts.QueryPairingGetPairing(ts.spec.Index, dev1Addr)
+	require.NoError(t, err)
+	_, err = ts.QueryPairingGetPairing(ts.spec.Index, dev2Addr)
+	require.Error(t, err)
+	_, err = ts.QueryPairingVerifyPairing(ts.spec.Index, dev2Addr, providerAddr, ts.BlockHeight())
+	require.Error(t, err)
+
+	// the key's limit caps the max CU of the consumer
+	entry, err := ts.Keepers.Pairing.UserEntry(ts.GoCtx, &types.QueryUserEntryRequest{Address: dev1Addr, ChainID: ts.spec.Index, Block: ts.BlockHeight()})
+	require.NoError(t, err)
+	require.Equal(t, keyCuLimit, entry.MaxCU)
+
+	sub, err := ts.QuerySubscriptionCurrent(client1Addr)
+	require.NoError(t, err)
+
+	// relays are paid up to the key's epoch CU limit, with all the providers together
+	for i, relay := range []struct {
+		provider string
+		cu       uint64
+	}{
+		{providerAddr, keyCuLimit - 2},
+		{provider2Addr, 4},
+		{providerAddr, 4},
+	} {
+		relaySession := ts.newRelaySession(relay.provider, uint64(i), relay.cu, ts.BlockHeight(), 0)
+		relaySession.Sig, err = sigs.Sign(dev1Acct.SK, *relaySession)
+		require.NoError(t, err)
+		ts.relayPaymentWithoutPay(types.MsgRelayPayment{Creator: relay.provider, Relays: lavaslices.Slice(relaySession)}, true)
+	}
+
+	trackedCu, found, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client1Addr, providerAddr, ts.spec.Index, sub.Sub.Block)
+	require.True(t, found)
+	require.Equal(t, keyCuLimit-2, trackedCu)
+	trackedCu, found, _ = ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, client1Addr, provider2Addr, ts.spec.Index, sub.Sub.Block)
+	require.True(t, found)
+	require.Equal(t, uint64(2), trackedCu)
+
+	keyEpochCuKey := types.DeveloperKeyEpochCuKey(ts.EpochStart(), dev1Addr)
+	keyEpochCu, found := ts.Keepers.Pairing.GetDeveloperKeyEpochCu(ts.Ctx, keyEpochCuKey)
+	require.True(t, found)
+	require.Equal(t, keyCuLimit+6, keyEpochCu.UsedCu)
+
+	// the key's usage is removed with the epoch payments
+	ts.AdvanceEpochs(ts.EpochsToSave() + 1)
+	_, found = ts.Keepers.Pairing.GetDeveloperKeyEpochCu(ts.Ctx, keyEpochCuKey)
+	require.False(t, found)
+}
+
+// TestDeveloperKeyExpiryInPayment tests that relays that were served before a developer key
+// expired are paid when they are claimed after it, and relays of later epochs are not
+func TestDeveloperKeyExpiryInPayment(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(0, 0, 1)    // 0 sub, 0 adm, 1 dev
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	devAcct, devAddr := ts.Account("dev1")
+	_, client1Addr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	devKey := projectstypes.ProjectDeveloperKey(devAddr)
+	devKey.ExpiryTime = uint64(ts.BlockTime().Add(24 * time.Hour).Unix())
+	res, err := ts.QueryProjectDeveloper(client1Addr)
+	require.NoError(t, err)
+	err = ts.TxProjectAddKeys(res.Project.Index, client1Addr, devKey)
+	require.NoError(t, err)
+
+	ts.AdvanceEpoch()
+	servedRelay := ts.newRelaySession(providerAddr, 0, 10, ts.BlockHeight(), 0)
+	servedRelay.Sig, err = sigs.Sign(devAcct.SK, *servedRelay)
+	require.NoError(t, err)
+
+	// the key expires
+	ts.AdvanceBlock(24 * time.Hour)
+	ts.AdvanceEpoch()
+	_, err = ts.QueryPairingGetPairing(ts.spec.Index, devAddr)
+	require.Error(t, err)
+
+	// the relay that was served before the expiry is paid
+	ts.relayPaymentWithoutPay(types.MsgRelayPayment{Creator: providerAddr, Relays: lavaslices.Slice(servedRelay)}, true)
+
+	// relays of epochs after the expiry are not paid
+	expiredRelay := ts.newRelaySession(providerAddr, 1, 10, ts.BlockHeight(), 0)
+	expiredRelay.Sig, err = sigs.Sign(devAcct.SK, *expiredRelay)
+	require.NoError(t, err)
+	ts.relayPaymentWithoutPay(types.MsgRelayPayment{Creator: providerAddr, Relays: lavaslices.Slice(expiredRelay)}, false)
+}
+
 func TestBadgeValidation(t *testing.T) {
 	ts := newTester(t)
 	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
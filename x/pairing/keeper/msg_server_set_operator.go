@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+func (k msgServer) SetProviderOperator(goCtx context.Context, msg *types.MsgSetProviderOperator) (*types.MsgSetProviderOperatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return &types.MsgSetProviderOperatorResponse{}, err
+	}
+
+	err := k.Keeper.SetOperator(ctx, msg.Creator, msg.ChainIds, msg.Operator)
+	return &types.MsgSetProviderOperatorResponse{}, err
+}
@@ -24,7 +24,7 @@ func (k msgServer) StakeProvider(goCtx context.Context, msg *types.MsgStakeProvi
 	}
 
 	// stakes a new provider entry
-	err := k.Keeper.StakeNewEntry(ctx, msg.Validator, msg.Creator, msg.ChainID, msg.Amount, msg.Endpoints, msg.Geolocation, msg.Moniker, msg.DelegateLimit, msg.DelegateCommission)
+	err := k.Keeper.StakeNewEntry(ctx, msg.Validator, msg.Creator, msg.ChainID, msg.Amount, msg.Endpoints, msg.Geolocation, msg.Moniker, msg.DelegateLimit, msg.DelegateCommission, msg.Operator)
 
 	return &types.MsgStakeProviderResponse{}, err
 }
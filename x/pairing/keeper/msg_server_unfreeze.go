@@ -24,6 +24,15 @@ func (k msgServer) UnfreezeProvider(goCtx context.Context, msg *types.MsgUnfreez
 			return nil, utils.LavaFormatWarning("Unfreeze_cant_get_stake_entry", types.FreezeStakeEntryNotFoundError, []utils.Attribute{{Key: "chainID", Value: chainId}, {Key: "providerAddress", Value: msg.GetCreator()}}...)
 		}
 
+		if stakeEntry.IsJailed(uint64(ctx.BlockHeight())) {
+			return nil, utils.LavaFormatWarning("Unfreeze_jailed_provider", types.UnFreezeJailedProviderError,
+				[]utils.Attribute{
+					{Key: "chainID", Value: chainId},
+					{Key: "providerAddress", Value: msg.GetCreator()},
+					{Key: "jailEndBlock", Value: stakeEntry.JailEndBlock},
+				}...)
+		}
+
 		minStake := k.Keeper.specKeeper.GetMinStake(ctx, chainId)
 		if stakeEntry.EffectiveStake().LT(minStake.Amount) {
 			return nil, utils.LavaFormatWarning("Unfreeze_insufficient_stake", types.UnFreezeInsufficientStakeError,
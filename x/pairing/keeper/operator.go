@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	epochstoragetypes "github.com/lavanet/lava/x/epochstorage/types"
+	"github.com/lavanet/lava/x/pairing/types"
+)
+
+// SetOperator sets the address that signs relays, payments and votes for the provider on the given
+// chains. The provider address keeps owning the stake and the rewards. An empty operator resets it
+func (k Keeper) SetOperator(ctx sdk.Context, provider string, chainIDs []string, operator string) error {
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return utils.LavaFormatWarning("SetOperator_get_provider_address", err, utils.Attribute{Key: "providerAddress", Value: provider})
+	}
+	if operator == provider {
+		operator = ""
+	}
+
+	for _, chainID := range chainIDs {
+		stakeEntry, found, index := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, providerAddr)
+		if !found {
+			return utils.LavaFormatWarning("SetOperator_cant_get_stake_entry", types.OperatorStakeEntryNotFoundError,
+				utils.Attribute{Key: "chainID", Value: chainID},
+				utils.Attribute{Key: "providerAddress", Value: provider},
+			)
+		}
+		stakeEntry.Operator = operator
+		k.epochStorageKeeper.ModifyStakeEntryCurrent(ctx, chainID, stakeEntry, index)
+	}
+
+	details := map[string]string{
+		"provider_address": provider,
+		"chain_ids":        strings.Join(chainIDs, ","),
+		"operator":         operator,
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.ProviderOperatorEventName, details, "Provider operator set")
+	return nil
+}
+
+// IsProviderOperator checks if the operator can act for the provider on the chain in the given epoch.
+// The stake entry of the epoch is used, so an operator set (or rotated) during an epoch takes effect
+// from the next epoch, like the rest of the stake entry changes
+func (k Keeper) IsProviderOperator(ctx sdk.Context, chainID string, provider, operator sdk.AccAddress, epoch uint64) bool {
+	if provider.Equals(operator) {
+		return true
+	}
+	stakeEntry, err := k.epochStorageKeeper.GetStakeEntryForProviderEpoch(ctx, chainID, provider, epoch)
+	return err == nil && stakeEntry.IsOperator(operator.String())
+}
+
+func isStakeEntryAddress(stakeEntries []epochstoragetypes.StakeEntry, address string) bool {
+	for _, stakeEntry := range stakeEntries {
+		if stakeEntry.Address == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Test that the operator can sign payments for the provider from the next epoch and that rotating it
+// revokes the old key for the relays of the next epochs
+func TestProviderOperator(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	_, operatorAddr := ts.AddAccount("operator", 0, testBalance)
+	_, newOperatorAddr := ts.AddAccount("operator", 1, testBalance)
+
+	newRelay := func(session uint64) *types.RelaySession {
+		relaySession := ts.newRelaySession(providerAddr, session, 10, ts.BlockHeight(), 0)
+		sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		return relaySession
+	}
+
+	// the operator is not set yet
+	_, err := ts.TxPairingRelayPayment(operatorAddr, newRelay(1))
+	require.ErrorIs(t, err, types.UnauthorizedOperatorError)
+
+	// only the provider can set its operator
+	_, err = ts.TxPairingSetProviderOperator(operatorAddr, []string{ts.spec.Index}, operatorAddr)
+	require.ErrorIs(t, err, types.OperatorStakeEntryNotFoundError)
+	_, err = ts.TxPairingSetProviderOperator(providerAddr, []string{ts.spec.Index}, operatorAddr)
+	require.NoError(t, err)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Equal(t, operatorAddr, stakeEntry.Operator)
+	require.True(t, stakeEntry.IsOperator(providerAddr))
+	require.True(t, stakeEntry.IsOperator(operatorAddr))
+
+	// the operator is not in the stake entries of the current epoch yet
+	_, err = ts.TxPairingRelayPayment(operatorAddr, newRelay(2))
+	require.ErrorIs(t, err, types.UnauthorizedOperatorError)
+
+	// the operator pays for the provider, the payment is credited to the provider
+	ts.AdvanceEpoch()
+	relaySession := newRelay(2)
+	_, err = ts.TxPairingRelayPayment(operatorAddr, relaySession)
+	require.NoError(t, err)
+	ts.verifyRelayPayment(relaySession, true)
+
+	// the provider can still sign for itself
+	_, err = ts.TxPairingRelayPayment(providerAddr, newRelay(3))
+	require.NoError(t, err)
+
+	// the operator is valid in the pairing once it's in the epoch's stake entries
+	res, err := ts.QueryPairingVerifyPairing(ts.spec.Index, clientAddr, operatorAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, res.Valid)
+
+	// a relay made out to the operator is not paid
+	operatorRelay := ts.newRelaySession(operatorAddr, 4, 10, ts.BlockHeight(), 0)
+	sig, err := sigs.Sign(clientAcct.SK, *operatorRelay)
+	require.NoError(t, err)
+	operatorRelay.Sig = sig
+	_, err = ts.TxPairingRelayPayment(operatorAddr, operatorRelay)
+	require.Error(t, err)
+
+	// the rotated operator keeps signing the relays of the current epoch
+	_, err = ts.TxPairingSetProviderOperator(providerAddr, []string{ts.spec.Index}, newOperatorAddr)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(newOperatorAddr, newRelay(5))
+	require.ErrorIs(t, err, types.UnauthorizedOperatorError)
+	_, err = ts.TxPairingRelayPayment(operatorAddr, newRelay(5))
+	require.NoError(t, err)
+
+	// and it is revoked for the relays of the next epochs
+	ts.AdvanceEpoch()
+	_, err = ts.TxPairingRelayPayment(operatorAddr, newRelay(6))
+	require.ErrorIs(t, err, types.UnauthorizedOperatorError)
+	_, err = ts.TxPairingRelayPayment(newOperatorAddr, newRelay(6))
+	require.NoError(t, err)
+
+	// resetting the operator leaves only the provider
+	_, err = ts.TxPairingSetProviderOperator(providerAddr, []string{ts.spec.Index}, "")
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, err = ts.TxPairingRelayPayment(newOperatorAddr, newRelay(7))
+	require.ErrorIs(t, err, types.UnauthorizedOperatorError)
+}
+
+// Test setting the operator when staking and that modifying the stake keeps it
+func TestStakeWithOperator(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 0, 0)
+
+	providerAcct, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	validatorAcct, _ := ts.GetAccount(common.VALIDATOR, 0)
+	_, operatorAddr := ts.AddAccount("operator", 0, testBalance)
+
+	stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Empty(t, stakeEntry.Operator)
+
+	msg := types.NewMsgStakeProvider(providerAddr, sdk.ValAddress(validatorAcct.Addr).String(), ts.spec.Index, stakeEntry.Stake,
+		stakeEntry.Endpoints, stakeEntry.Geolocation, stakeEntry.Moniker, stakeEntry.DelegateLimit, stakeEntry.DelegateCommission)
+	msg.Operator = operatorAddr
+	_, err := ts.Servers.PairingServer.StakeProvider(ts.GoCtx, msg)
+	require.NoError(t, err)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Equal(t, operatorAddr, stakeEntry.Operator)
+
+	// an empty operator keeps the existing one
+	msg.Operator = ""
+	msg.Moniker = "new-moniker"
+	_, err = ts.Servers.PairingServer.StakeProvider(ts.GoCtx, msg)
+	require.NoError(t, err)
+
+	stakeEntry, found, _ = ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, providerAcct.Addr)
+	require.True(t, found)
+	require.Equal(t, "new-moniker", stakeEntry.Moniker)
+	require.Equal(t, operatorAddr, stakeEntry.Operator)
+}
@@ -64,6 +64,14 @@ func (k Keeper) GetProjectData(ctx sdk.Context, developerKey sdk.AccAddress, cha
 		)
 	}
 
+	if !project.GetKey(developerKey.String()).IsChainAllowed(chainID) {
+		return projectstypes.Project{}, utils.LavaFormatWarning("the developer key is not allowed to use the chain", fmt.Errorf("cannot get project data"),
+			utils.Attribute{Key: "project", Value: project.Index},
+			utils.Attribute{Key: "developer", Value: developerKey.String()},
+			utils.Attribute{Key: "chainID", Value: chainID},
+		)
+	}
+
 	return project, nil
 }
 
@@ -142,7 +150,12 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	planPolicy := plan.GetPlanPolicy()
+	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
+	if !found {
+		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
+	}
+
+	planPolicy, cuLeftInSubscription := k.planCuLimits(ctx, plan, sub)
 	policies := []*planstypes.Policy{&planPolicy}
 	if project.SubscriptionPolicy != nil {
 		policies = append(policies, project.SubscriptionPolicy)
@@ -164,11 +177,7 @@ func (k Keeper) GetProjectStrictestPolicy(ctx sdk.Context, project projectstypes
 		return nil, "", err
 	}
 
-	sub, found := k.subscriptionKeeper.GetSubscription(ctx, project.GetSubscription())
-	if !found {
-		return nil, "", fmt.Errorf("could not find subscription with address %s", project.GetSubscription())
-	}
-	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), sub.GetMonthCuLeft())
+	allowedCUEpoch, allowedCUTotal := k.CalculateEffectiveAllowedCuPerEpochFromPolicies(policies, project.GetUsedCu(), cuLeftInSubscription)
 
 	selectedProvidersMode, selectedProvidersList := k.CalculateEffectiveSelectedProviders(policies)
 
@@ -294,7 +303,7 @@ func (k Keeper) ValidatePairingForClient(ctx sdk.Context, chainID string, provid
 			)
 		}
 
-		if providerAccAddr.Equals(providerAddress) {
+		if providerAccAddr.Equals(providerAddress) || possibleAddr.IsOperator(providerAddress.String()) {
 			return true, allowedCU, validAddresses, nil
 		}
 	}
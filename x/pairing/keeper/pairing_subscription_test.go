@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/testutil/common"
 	"github.com/lavanet/lava/utils/lavaslices"
 	"github.com/lavanet/lava/utils/sigs"
@@ -105,6 +106,7 @@ func TestRelayPaymentSubscription(t *testing.T) {
 
 func TestRelayPaymentSubscriptionCU(t *testing.T) {
 	ts := newTester(t)
+	ts.disableOveruse()
 	ts.SetupAccounts(0, 0, 1)    // 0 sub, 0 adm, 1 dev
 	ts.setupForPayments(1, 1, 0) // 1 provider, 2 client, default providers-to-pair
 
@@ -321,6 +323,7 @@ func TestStrictestPolicyProvidersToPair(t *testing.T) {
 
 func TestStrictestPolicyCuPerEpoch(t *testing.T) {
 	ts := newTester(t)
+	ts.disableOveruse()
 	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
 
 	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
@@ -439,6 +442,7 @@ func TestStrictestPolicyCuPerEpoch(t *testing.T) {
 
 func TestPairingNotChangingDueToCuOveruse(t *testing.T) {
 	ts := newTester(t)
+	ts.disableOveruse()
 	ts.setupForPayments(100, 1, 0) // 1 provider, 1 client, default providers-to-pair
 
 	client1Acct, client1Addr := ts.GetAccount(common.CONSUMER, 0)
@@ -487,6 +491,137 @@ func TestPairingNotChangingDueToCuOveruse(t *testing.T) {
 	}
 }
 
+// TestRelayPaymentOveruse checks that a plan that allows overuse keeps serving CU past the monthly
+// allowance, that the overuse is charged from the credit and the creator when the month advances,
+// and that overuse paid after the month advanced is charged and paid to the providers with the month's credit
+func TestRelayPaymentOveruse(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	require.True(t, ts.plan.AllowOveruse)
+
+	// extend by a month so the sub won't expire
+	_, err := ts.TxSubscriptionBuy(clientAddr, clientAddr, ts.plan.Index, 1, false, false)
+	require.NoError(t, err)
+
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+
+	i := 0
+	for ; uint64(i) < totalCuLimit/epochCuLimit; i++ {
+		relaySession := ts.newRelaySession(providerAddr, uint64(i), epochCuLimit, ts.BlockHeight(), 0)
+		relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+		require.NoError(t, err)
+		_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+		require.NoError(t, err)
+
+		ts.AdvanceEpoch()
+	}
+
+	// the allowance is used up, but the consumer is still served. the overuse is charged when the month ends
+	creatorBalance := ts.GetBalance(clientAcct.Addr)
+	overuseCu := uint64(30)
+	relaySession := ts.newRelaySession(providerAddr, uint64(i), overuseCu, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+
+	res, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Sub.MonthCuLeft)
+	require.Equal(t, overuseCu, res.Sub.MonthOveruseCu)
+	overuseCost := int64(overuseCu * ts.plan.OveruseRate)
+	require.Equal(t, overuseCost, res.AccruedOveruse.Amount.Int64())
+	require.Equal(t, creatorBalance, ts.GetBalance(clientAcct.Addr))
+
+	// the credit left after the month's share and the creator's balance can't pay for more overuse, the consumer isn't served
+	creditLeft := res.Sub.Credit.Amount.Sub(res.Sub.Credit.Amount.QuoRaw(int64(res.Sub.DurationLeft)))
+	require.True(t, creditLeft.LT(sdk.NewInt(overuseCost)))
+	err = ts.Keepers.BankKeeper.SetBalance(ts.Ctx, clientAcct.Addr, sdk.NewCoins())
+	require.NoError(t, err)
+	relaySession = ts.newRelaySession(providerAddr, uint64(i+1), overuseCu, ts.BlockHeight(), 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.Error(t, err)
+	err = ts.Keepers.BankKeeper.SetBalance(ts.Ctx, clientAcct.Addr, sdk.NewCoins(sdk.NewCoin(ts.TokenDenom(), sdk.NewInt(creatorBalance))))
+	require.NoError(t, err)
+
+	// the month ends: the overuse is charged from the credit left, and the rest from the creator
+	monthSubBlock := res.Sub.Block
+	monthEpoch := ts.BlockHeight()
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+
+	res, err = ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Sub.MonthOveruseCu)
+	require.True(t, res.AccruedOveruse.IsZero())
+	require.True(t, res.Sub.Credit.IsZero())
+	fromCreator := sdk.NewInt(overuseCost).Sub(creditLeft).Int64()
+	require.Equal(t, creatorBalance-fromCreator, ts.GetBalance(clientAcct.Addr))
+
+	// a payment of the previous month lands after the month ended: its overuse is charged from the creator now
+	// and kept until the month's CU tracker timer pays the providers
+	creatorBalance = ts.GetBalance(clientAcct.Addr)
+	relaySession = ts.newRelaySession(providerAddr, uint64(i+2), overuseCu, monthEpoch, 0)
+	relaySession.Sig, err = sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(t, err)
+	_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+	require.NoError(t, err)
+	require.Equal(t, creatorBalance-overuseCost, ts.GetBalance(clientAcct.Addr))
+
+	lateCharge, found := ts.Keepers.Subscription.GetLateOveruseCharge(ts.Ctx, clientAddr, monthSubBlock)
+	require.True(t, found)
+	require.Equal(t, overuseCost, lateCharge.Charged.Amount.Int64())
+
+	ts.AdvanceBlocks(ts.BlocksToSave() + 1)
+	_, found = ts.Keepers.Subscription.GetLateOveruseCharge(ts.Ctx, clientAddr, monthSubBlock)
+	require.False(t, found)
+}
+
+// TestRelayPaymentTopUpCu checks that a consumer that used its monthly CU allowance is served again after a top-up
+func TestRelayPaymentTopUpCu(t *testing.T) {
+	ts := newTester(t)
+	ts.disableOveruse()
+	ts.setupForPayments(1, 1, 0) // 1 provider, 1 client, default providers-to-pair
+
+	clientAcct, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+
+	totalCuLimit := ts.plan.PlanPolicy.TotalCuLimit
+	epochCuLimit := ts.plan.PlanPolicy.EpochCuLimit
+
+	sessionID := uint64(0)
+	relay := func() error {
+		sessionID++
+		relaySession := ts.newRelaySession(providerAddr, sessionID, epochCuLimit, ts.BlockHeight(), 0)
+		sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+		require.NoError(t, err)
+		relaySession.Sig = sig
+		_, err = ts.TxPairingRelayPayment(providerAddr, relaySession)
+		return err
+	}
+
+	for i := uint64(0); i < totalCuLimit/epochCuLimit; i++ {
+		require.NoError(t, relay())
+		ts.AdvanceEpoch()
+	}
+	require.Error(t, relay())
+
+	err := ts.TxSubscriptionTopUpCu(clientAddr, clientAddr, epochCuLimit)
+	require.NoError(t, err)
+	require.NoError(t, relay())
+
+	res, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Sub.MonthCuLeft)
+	require.Equal(t, epochCuLimit, res.Sub.MonthTopUpCu)
+}
+
 func TestAddProjectAfterPlanUpdate(t *testing.T) {
 	ts := newTester(t)
 	ts.SetupAccounts(0, 0, 1)    // 0 sub, 0 adm, 1 dev
@@ -1078,8 +1078,8 @@ func TestGeolocationPairingScores(t *testing.T) {
 			cluster := subRes.Sub.Cluster
 
 			for i := range stakeEntries {
-				// TODO: require err to be nil once the providerQosFS's update is implemented
-				qos, _ := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, stakeEntries[i].Address)
+				// no QoS reports were sent, the providers get the default QoS
+				qos, _ := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, stakeEntries[i].Address, ts.EpochStart())
 				providerScore := pairingscores.NewPairingScore(&stakeEntries[i], qos)
 				providerScores = append(providerScores, providerScore)
 			}
@@ -3,25 +3,139 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/lavanet/lava/utils"
 	pairingtypes "github.com/lavanet/lava/x/pairing/types"
 )
 
-func (k Keeper) UpdateProviderQos(epochPayments pairingtypes.EpochPayments) {
+// QosDecayFactor is the weight of the aggregated QoS when the QoS excellence reports of an epoch are added:
+// aggregated = aggregated*QosDecayFactor + epochQos*(1-QosDecayFactor)
+var QosDecayFactor = sdk.NewDecWithPrec(9, 1) // 0.9
+
+// UpdateProviderQos adds a consumer's QoS excellence report about a provider, weighted by the CU paid
+// for the relay, to the provider's QoS of the current epoch. The epoch's QoS is aggregated into the
+// providerQosFS when the next epoch starts (see AggregateEpochQos).
+func (k Keeper) UpdateProviderQos(ctx sdk.Context, chainID string, cluster string, provider string, report pairingtypes.QualityOfServiceReport, cu uint64) {
+	if !isValidQosExcellenceReport(report) {
+		utils.LavaFormatDebug("skipping invalid QoS excellence report",
+			utils.Attribute{Key: "provider", Value: provider},
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "report", Value: report.String()},
+		)
+		return
+	}
+	if cu == 0 {
+		return
+	}
+
+	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
+	epochQos, found := k.GetProviderEpochQos(ctx, key)
+	if !found {
+		zero := sdk.ZeroDec()
+		epochQos = pairingtypes.ProviderEpochQos{
+			ProviderQosKey: key,
+			WeightedSum:    pairingtypes.QualityOfServiceReport{Latency: zero, Availability: zero, Sync: zero},
+		}
+	}
+
+	weight := sdk.NewDecFromInt(sdk.NewIntFromUint64(cu))
+	epochQos.WeightedSum.Latency = epochQos.WeightedSum.Latency.Add(report.Latency.Mul(weight))
+	epochQos.WeightedSum.Availability = epochQos.WeightedSum.Availability.Add(report.Availability.Mul(weight))
+	epochQos.WeightedSum.Sync = epochQos.WeightedSum.Sync.Add(report.Sync.Mul(weight))
+	epochQos.Cu += cu
+	k.SetProviderEpochQos(ctx, epochQos)
+}
+
+// AggregateEpochQos aggregates the QoS of the epoch that ended into the providerQosFS, once per provider
+// and cluster. The aggregated QoS applies from the epoch that starts.
+func (k Keeper) AggregateEpochQos(ctx sdk.Context) {
+	block := uint64(ctx.BlockHeight())
+	for _, epochQos := range k.GetAllProviderEpochQos(ctx) {
+		k.RemoveProviderEpochQos(ctx, epochQos.ProviderQosKey)
+
+		weight := sdk.NewDecFromInt(sdk.NewIntFromUint64(epochQos.Cu))
+		report := pairingtypes.QualityOfServiceReport{
+			Latency:      epochQos.WeightedSum.Latency.Quo(weight),
+			Availability: epochQos.WeightedSum.Availability.Quo(weight),
+			Sync:         epochQos.WeightedSum.Sync.Quo(weight),
+		}
+
+		var qos pairingtypes.QualityOfServiceReport
+		if k.providerQosFS.FindEntry(ctx, epochQos.ProviderQosKey, block, &qos) {
+			newWeight := sdk.OneDec().Sub(QosDecayFactor)
+			qos.Latency = qos.Latency.Mul(QosDecayFactor).Add(report.Latency.Mul(newWeight))
+			qos.Availability = qos.Availability.Mul(QosDecayFactor).Add(report.Availability.Mul(newWeight))
+			qos.Sync = qos.Sync.Mul(QosDecayFactor).Add(report.Sync.Mul(newWeight))
+		} else {
+			qos = report
+		}
+
+		err := k.providerQosFS.AppendEntry(ctx, epochQos.ProviderQosKey, block, &qos)
+		if err != nil {
+			utils.LavaFormatError("failed updating provider QoS", err,
+				utils.Attribute{Key: "key", Value: epochQos.ProviderQosKey},
+			)
+		}
+	}
+}
+
+// SetProviderEpochQos set a specific providerEpochQos in the store from its index
+func (k Keeper) SetProviderEpochQos(ctx sdk.Context, providerEpochQos pairingtypes.ProviderEpochQos) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderEpochQosKeyPrefix))
+	b := k.cdc.MustMarshal(&providerEpochQos)
+	store.Set([]byte(providerEpochQos.ProviderQosKey), b)
 }
 
-// GetQos gets a provider's QoS excellence report from the providerQosFS
-func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error) {
+// GetProviderEpochQos returns a providerEpochQos from its index
+func (k Keeper) GetProviderEpochQos(ctx sdk.Context, providerQosKey string) (val pairingtypes.ProviderEpochQos, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderEpochQosKeyPrefix))
+
+	b := store.Get([]byte(providerQosKey))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveProviderEpochQos removes a providerEpochQos from the store
+func (k Keeper) RemoveProviderEpochQos(ctx sdk.Context, providerQosKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderEpochQosKeyPrefix))
+	store.Delete([]byte(providerQosKey))
+}
+
+// GetAllProviderEpochQos returns all providerEpochQos
+func (k Keeper) GetAllProviderEpochQos(ctx sdk.Context) (list []pairingtypes.ProviderEpochQos) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pairingtypes.KeyPrefix(pairingtypes.ProviderEpochQosKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val pairingtypes.ProviderEpochQos
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func isValidQosExcellenceReport(report pairingtypes.QualityOfServiceReport) bool {
+	if report.Latency.IsNil() || report.Availability.IsNil() || report.Sync.IsNil() {
+		return false
+	}
+	return !report.Latency.IsNegative() && !report.Sync.IsNegative() &&
+		!report.Availability.IsNegative() && report.Availability.LTE(sdk.OneDec())
+}
+
+// GetQos gets a provider's aggregated QoS excellence report from the providerQosFS
+func (k Keeper) GetQos(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (pairingtypes.QualityOfServiceReport, error) {
 	var qos pairingtypes.QualityOfServiceReport
 	key := pairingtypes.ProviderQosKey(provider, chainID, cluster)
-	found := k.providerQosFS.FindEntry(ctx, key, uint64(ctx.BlockHeight()), &qos)
+	found := k.providerQosFS.FindEntry(ctx, key, block, &qos)
 	if !found {
-		return qos, utils.LavaFormatWarning("provider of chain and cluster was not found in the store", fmt.Errorf("qos not found"),
-			utils.Attribute{Key: "provider", Value: provider},
-			utils.Attribute{Key: "chainID", Value: chainID},
-			utils.Attribute{Key: "cluster", Value: cluster},
-		)
+		return qos, fmt.Errorf("qos not found for provider %s of chain %s and cluster %s", provider, chainID, cluster)
 	}
 	return qos, nil
 }
@@ -2,31 +2,207 @@ package keeper_test
 
 import (
 	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/testutil/common"
+	"github.com/lavanet/lava/utils/sigs"
+	"github.com/lavanet/lava/x/pairing/keeper"
+	pairingscores "github.com/lavanet/lava/x/pairing/keeper/scores"
+	pairingtypes "github.com/lavanet/lava/x/pairing/types"
+	"github.com/stretchr/testify/require"
 )
 
-// TODO: All tests are not implemented since providerQosFS update and Qos score are not implemented yet
+func newQosReport(latency, availability, sync int64) *pairingtypes.QualityOfServiceReport {
+	return &pairingtypes.QualityOfServiceReport{
+		Latency:      sdk.NewDecWithPrec(latency, 1),
+		Availability: sdk.NewDecWithPrec(availability, 1),
+		Sync:         sdk.NewDecWithPrec(sync, 1),
+	}
+}
 
-// TestProviderQosMap checks that getting a providers' Qos map for specific chainID and cluster works properly
-func TestProviderQosMap(t *testing.T) {
+// payWithQos sends a relay payment of cu with a QoS excellence report
+func (ts *tester) payWithQos(clientIdx int, provider string, session uint64, cu uint64, qos *pairingtypes.QualityOfServiceReport) {
+	clientAcct, _ := ts.GetAccount(common.CONSUMER, clientIdx)
+	relaySession := ts.newRelaySession(provider, session, cu, ts.BlockHeight(), 0)
+	relaySession.QosExcellenceReport = qos
+	sig, err := sigs.Sign(clientAcct.SK, *relaySession)
+	require.NoError(ts.T, err)
+	relaySession.Sig = sig
+	_, err = ts.TxPairingRelayPayment(provider, relaySession)
+	require.NoError(ts.T, err)
 }
 
-// TestGetQos checks that using GetQos() returns the right Qos
+// TestGetQos checks that the QoS excellence reports of each epoch are aggregated with decay and that
+// the aggregated QoS is used only from the next epoch
 func TestGetQos(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	subRes, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	cluster := subRes.Sub.Cluster
+
+	// no reports yet
+	_, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.Error(t, err)
+
+	first := newQosReport(10, 10, 10)
+	ts.payWithQos(0, providerAddr, 1, 10, first)
+
+	// the report is not used in the current epoch
+	_, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+	qos, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.Equal(t, *first, qos)
+
+	// a new report moves the aggregated QoS by (1-decay)
+	second := newQosReport(20, 0, 20)
+	ts.payWithQos(0, providerAddr, 2, 10, second)
+
+	decay := keeper.QosDecayFactor
+	newWeight := sdk.OneDec().Sub(decay)
+	expected := pairingtypes.QualityOfServiceReport{
+		Latency:      first.Latency.Mul(decay).Add(second.Latency.Mul(newWeight)),
+		Availability: first.Availability.Mul(decay).Add(second.Availability.Mul(newWeight)),
+		Sync:         first.Sync.Mul(decay).Add(second.Sync.Mul(newWeight)),
+	}
+
+	// the previous epoch's QoS still applies until the next epoch
+	qos, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.Equal(t, *first, qos)
+
+	ts.AdvanceEpoch()
+	qos, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, expected.Latency.Equal(qos.Latency))
+	require.True(t, expected.Availability.Equal(qos.Availability))
+	require.True(t, expected.Sync.Equal(qos.Sync))
+
+	// invalid reports are ignored
+	ts.payWithQos(0, providerAddr, 3, 10, newQosReport(10, 20, 10))
+	ts.AdvanceEpoch()
+	qos, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, expected.Availability.Equal(qos.Availability))
 }
 
-// TestQosReqForSlots checks that if Qos req is active, all slots are assigned with Qos req
-func TestQosReqForSlots(t *testing.T) {
+// TestQosEpochAggregation checks that the QoS excellence reports of an epoch are weighted by their paid CU
+// and aggregated once, when the next epoch starts
+func TestQosEpochAggregation(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	subRes, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	cluster := subRes.Sub.Cluster
+
+	ts.payWithQos(0, providerAddr, 1, 10, newQosReport(10, 10, 10))
+	ts.payWithQos(0, providerAddr, 2, 30, newQosReport(30, 2, 50))
+
+	key := pairingtypes.ProviderQosKey(providerAddr, ts.spec.Index, cluster)
+	epochQos, found := ts.Keepers.Pairing.GetProviderEpochQos(ts.Ctx, key)
+	require.True(t, found)
+	require.Equal(t, uint64(40), epochQos.Cu)
+
+	ts.AdvanceEpoch()
+
+	// the epoch's reports are averaged by CU: (10*r1 + 30*r2) / 40
+	qos, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.True(t, sdk.NewDecWithPrec(25, 1).Equal(qos.Latency), qos.Latency.String())
+	require.True(t, sdk.NewDecWithPrec(4, 1).Equal(qos.Availability), qos.Availability.String())
+	require.True(t, sdk.NewDecWithPrec(4, 0).Equal(qos.Sync), qos.Sync.String())
+
+	// the epoch's reports are cleared once aggregated
+	_, found = ts.Keepers.Pairing.GetProviderEpochQos(ts.Ctx, key)
+	require.False(t, found)
+
+	// an epoch without reports keeps the aggregated QoS
+	ts.AdvanceEpoch()
+	qosAfter, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	require.Equal(t, qos, qosAfter)
 }
 
-// TestQosScoreCluster that consumer pairing uses the correct cluster for QoS score calculations.
+// TestQosScoreCluster checks that QoS reports are aggregated in the cluster of the reporting consumer
 func TestQosScoreCluster(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(1, 1, 1)
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, providerAddr := ts.GetAccount(common.PROVIDER, 0)
+	subRes, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	cluster := subRes.Sub.Cluster
+
+	ts.payWithQos(0, providerAddr, 1, 10, newQosReport(10, 10, 10))
+	ts.AdvanceEpoch()
+
+	_, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, providerAddr, ts.BlockHeight())
+	require.NoError(t, err)
+	_, err = ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster+"_other", providerAddr, ts.BlockHeight())
+	require.Error(t, err)
 }
 
-// TestQosScore checks that the qos score component is as expected (score == ComputeQos(), new users (sub usage less
-// than a month) are not infuenced by Qos score, invalid Qos score == 1)
+// TestQosScore checks that the qos score component is as expected (ranges between 0.5-2 and providers
+// without QoS reports get the default score)
 func TestQosScore(t *testing.T) {
+	qosReq := pairingscores.QosReq{}
+	defaultScore := qosReq.Score(*pairingscores.NewPairingScore(nil, pairingtypes.QualityOfServiceReport{}))
+
+	tests := []struct {
+		name     string
+		qos      *pairingtypes.QualityOfServiceReport
+		expected math.Uint
+	}{
+		{"neutral", newQosReport(10, 10, 10), defaultScore},
+		{"excellent", newQosReport(1, 10, 1), defaultScore.MulUint64(2)},
+		{"bad", newQosReport(100, 10, 100), defaultScore.QuoUint64(2)},
+		{"unavailable", newQosReport(10, 0, 10), defaultScore.QuoUint64(2)},
+		{"invalid", newQosReport(0, 10, 0), defaultScore},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := qosReq.Score(*pairingscores.NewPairingScore(nil, *tt.qos))
+			require.Equal(t, tt.expected, score)
+		})
+	}
 }
 
-// TestUpdateClusteringCriteria checks that updating the clustering criteria doesn't make different version clusters to be mixed
-func TestUpdateClusteringCriteria(t *testing.T) {
+// TestQosReqFromReports checks that the QoS score of paired providers is computed from the reports about them
+func TestQosReqFromReports(t *testing.T) {
+	ts := newTester(t)
+	ts.setupForPayments(2, 1, 2)
+
+	_, clientAddr := ts.GetAccount(common.CONSUMER, 0)
+	_, goodProvider := ts.GetAccount(common.PROVIDER, 0)
+	_, badProvider := ts.GetAccount(common.PROVIDER, 1)
+
+	ts.payWithQos(0, goodProvider, 1, 10, newQosReport(1, 10, 1))
+	ts.payWithQos(0, badProvider, 2, 10, newQosReport(100, 10, 100))
+	ts.AdvanceEpoch()
+
+	subRes, err := ts.QuerySubscriptionCurrent(clientAddr)
+	require.NoError(t, err)
+	cluster := subRes.Sub.Cluster
+
+	qosReq := pairingscores.QosReq{}
+	expected := map[string]math.Uint{goodProvider: math.NewUint(20), badProvider: math.NewUint(5)}
+	for provider, expectedScore := range expected {
+		stakeEntry, found, _ := ts.Keepers.Epochstorage.GetStakeEntryByAddressCurrent(ts.Ctx, ts.spec.Index, sdk.MustAccAddressFromBech32(provider))
+		require.True(t, found)
+		qos, err := ts.Keepers.Pairing.GetQos(ts.Ctx, ts.spec.Index, cluster, provider, ts.BlockHeight())
+		require.NoError(t, err)
+		require.Equal(t, expectedScore, qosReq.Score(*pairingscores.NewPairingScore(&stakeEntry, qos)))
+	}
 }
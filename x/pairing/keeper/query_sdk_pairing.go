@@ -39,5 +39,5 @@ func (k Keeper) SdkPairing(goCtx context.Context, req *types.QueryGetPairingRequ
 
 	downtimeParams := k.downtimeKeeper.GetParams(ctx)
 
-	return &types.QuerySdkPairingResponse{Pairing: pairing, Spec: spec, MaxCu: strictestPolicy.EpochCuLimit, DowntimeParams: &downtimeParams}, err
+	return &types.QuerySdkPairingResponse{Pairing: pairing, Spec: spec, MaxCu: developerKeyMaxCu(project, req.Client, strictestPolicy.EpochCuLimit), DowntimeParams: &downtimeParams}, err
 }
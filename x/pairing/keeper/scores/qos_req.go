@@ -7,10 +7,20 @@ import (
 	planstypes "github.com/lavanet/lava/x/plans/types"
 )
 
-const qosReqName = "qos-req"
+const (
+	qosReqName = "qos-req"
+	// the qos score ranges between 0.5-2. Since scores are integers, it is scaled by qosScoreScale
+	// (the default qos score, for providers without qos reports, is 1*qosScoreScale)
+	qosScoreScale = 10
+)
+
+var (
+	minQosScore = sdk.NewDecWithPrec(5, 1)
+	maxQosScore = sdk.NewDec(2)
+)
 
 type QosGetter interface {
-	GetQos(ctx sdk.Context, chainID string, cluster string, provider string) (pairingtypes.QualityOfServiceReport, error)
+	GetQos(ctx sdk.Context, chainID string, cluster string, provider string, block uint64) (pairingtypes.QualityOfServiceReport, error)
 }
 
 // QosReq implements the ScoreReq interface for provider staking requirement(s)
@@ -22,16 +32,27 @@ func (qr *QosReq) Init(policy planstypes.Policy) bool {
 
 // Score calculates the the provider's qos score
 func (qr *QosReq) Score(score PairingScore) math.Uint {
-	// TODO: update Qos in providerQosFS properly and uncomment this code below
-	// Also, the qos score should range between 0.5-2
+	qos := score.QosExcellenceReport
+	if qos.Latency.IsNil() || qos.Availability.IsNil() || qos.Sync.IsNil() {
+		return math.NewUint(qosScoreScale)
+	}
+
+	if qos.Availability.IsZero() {
+		return math.NewUint(minQosScore.MulInt64(qosScoreScale).TruncateInt().Uint64())
+	}
+
+	qosScore, err := qos.ComputeQoSExcellence()
+	if err != nil {
+		return math.NewUint(qosScoreScale)
+	}
 
-	// qosScore, err := score.QosExcellenceReport.ComputeQoS()
-	// if err != nil {
-	// 	return math.NewUint(1)
-	// }
+	if qosScore.LT(minQosScore) {
+		qosScore = minQosScore
+	} else if qosScore.GT(maxQosScore) {
+		qosScore = maxQosScore
+	}
 
-	// return math.Uint(qosScore)
-	return math.NewUint(1)
+	return math.NewUint(qosScore.MulInt64(qosScoreScale).TruncateInt().Uint64())
 }
 
 func (qr *QosReq) GetName() string {
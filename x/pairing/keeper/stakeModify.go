@@ -1,10 +1,60 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/pairing/types"
 )
 
+// CreditStakeEntry adds the credit amount, which must already be in the provider's balance, to the
+// provider's stake on the chain. The credit is self delegated through the validator of the provider's
+// largest delegation. On failure the credit is left in the provider's balance.
 func (k Keeper) CreditStakeEntry(ctx sdk.Context, chainID string, lookUpAddress sdk.AccAddress, creditAmount sdk.Coin) (bool, error) {
-	// TODO: add a way to rewards for conflict
+	if creditAmount.IsZero() {
+		return true, nil
+	}
+
+	if err := utils.ValidateCoins(ctx, k.stakingKeeper.BondDenom(ctx), creditAmount, false); err != nil {
+		return false, err
+	}
+
+	_, found, _ := k.epochStorageKeeper.GetStakeEntryByAddressCurrent(ctx, chainID, lookUpAddress)
+	if !found {
+		return false, utils.LavaFormatWarning("Credit_cant_get_stake_entry", types.JailStakeEntryNotFoundError,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: lookUpAddress.String()},
+		)
+	}
+
+	var validator string
+	largest := sdk.ZeroInt()
+	for _, delegation := range k.stakingKeeper.GetAllDelegatorDelegations(ctx, lookUpAddress) {
+		val, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		if amount := val.TokensFromShares(delegation.Shares).TruncateInt(); amount.GT(largest) {
+			largest = amount
+			validator = val.OperatorAddress
+		}
+	}
+	if validator == "" {
+		return false, utils.LavaFormatWarning("Credit_provider_has_no_delegations", fmt.Errorf("no validator to delegate the credit through"),
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: lookUpAddress.String()},
+		)
+	}
+
+	provider := lookUpAddress.String()
+	err := k.dualstakingKeeper.DelegateFull(ctx, provider, validator, provider, chainID, creditAmount)
+	if err != nil {
+		return false, utils.LavaFormatWarning("Credit_failed_adding_credit_to_stake", err,
+			utils.Attribute{Key: "chainID", Value: chainID},
+			utils.Attribute{Key: "providerAddress", Value: provider},
+			utils.Attribute{Key: "credit", Value: creditAmount},
+		)
+	}
 	return true, nil
 }
@@ -53,6 +53,14 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgUnfreeze int = 100
 
+	opWeightMsgBail = "op_weight_msg_bail"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgBail int = 100
+
+	opWeightMsgSetProviderOperator = "op_weight_msg_set_provider_operator"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgSetProviderOperator int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -178,6 +186,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		pairingsimulation.SimulateMsgUnfreeze(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgBail int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgBail, &weightMsgBail, nil,
+		func(_ *rand.Rand) {
+			weightMsgBail = defaultWeightMsgBail
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBail,
+		pairingsimulation.SimulateMsgBail(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgSetProviderOperator int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgSetProviderOperator, &weightMsgSetProviderOperator, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetProviderOperator = defaultWeightMsgSetProviderOperator
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetProviderOperator,
+		pairingsimulation.SimulateMsgSetProviderOperator(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayPayment{}, "pairing/RelayPayment", nil)
 	cdc.RegisterConcrete(&MsgFreezeProvider{}, "pairing/Freeze", nil)
 	cdc.RegisterConcrete(&MsgUnfreezeProvider{}, "pairing/Unfreeze", nil)
+	cdc.RegisterConcrete(&MsgBailProvider{}, "pairing/Bail", nil)
+	cdc.RegisterConcrete(&MsgSetProviderOperator{}, "pairing/SetOperator", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -33,6 +35,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUnfreezeProvider{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBailProvider{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetProviderOperator{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -24,4 +24,11 @@ var (
 	UnFreezeInsufficientStakeError                     = sdkerrors.New("UnFreezeInsufficientStakeError Error", 697, "Could not unfreeze provider due to insufficient stake. Stake must be above minimum stake to unfreeze")
 	InvalidCreatorAddressError                         = sdkerrors.New("InvalidCreatorAddressError Error", 698, "The creator address is invalid")
 	AmountCoinError                                    = sdkerrors.New("AmountCoinError Error", 699, "Amount limit coin is invalid")
+	JailStakeEntryNotFoundError                        = sdkerrors.New("JailStakeEntryNotFoundError Error", 700, "Can't get stake entry to jail, bail or slash")
+	ProviderNotJailedError                             = sdkerrors.New("ProviderNotJailedError Error", 701, "The provider is not jailed")
+	InsufficientBailError                              = sdkerrors.New("InsufficientBailError Error", 702, "The bail is lower than the bail set when the provider was jailed")
+	UnFreezeJailedProviderError                        = sdkerrors.New("UnFreezeJailedProviderError Error", 703, "Could not unfreeze a jailed provider. Wait for the jail to end or pay the bail")
+	InvalidSlashPercentageError                        = sdkerrors.New("InvalidSlashPercentageError Error", 704, "The slash percentage must be between 0 and 1")
+	OperatorStakeEntryNotFoundError                    = sdkerrors.New("OperatorStakeEntryNotFoundError Error", 705, "Can't get stake entry to set the operator")
+	UnauthorizedOperatorError                          = sdkerrors.New("UnauthorizedOperatorError Error", 706, "The address is not the provider or its operator")
 )
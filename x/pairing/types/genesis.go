@@ -19,6 +19,8 @@ func DefaultGenesis() *GenesisState {
 		BadgeUsedCuList:                        []BadgeUsedCu{},
 		BadgesTS:                               *timerstoretypes.DefaultGenesis(),
 		ProviderQosFS:                          *fixationtypes.DefaultGenesis(),
+		DeveloperKeyEpochCuList:                []DeveloperKeyEpochCu{},
+		ProviderEpochQosList:                   []ProviderEpochQos{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -63,6 +65,27 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("badgeUsedCuList is not empty")
 	}
 
+	// Check for duplicated index in developerKeyEpochCu
+	developerKeyEpochCuIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.DeveloperKeyEpochCuList {
+		index := string(elem.DeveloperKeyEpochCuKey)
+		if _, ok := developerKeyEpochCuIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for developerKeyEpochCu")
+		}
+		developerKeyEpochCuIndexMap[index] = struct{}{}
+	}
+
+	// Check for duplicated index in providerEpochQos
+	providerEpochQosIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ProviderEpochQosList {
+		if _, ok := providerEpochQosIndexMap[elem.ProviderQosKey]; ok {
+			return fmt.Errorf("duplicated index for providerEpochQos")
+		}
+		providerEpochQosIndexMap[elem.ProviderQosKey] = struct{}{}
+	}
+
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
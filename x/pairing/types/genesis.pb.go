@@ -77,6 +77,120 @@ func (m *BadgeUsedCu) GetUsedCu() uint64 {
 	return 0
 }
 
+type DeveloperKeyEpochCu struct {
+	DeveloperKeyEpochCuKey []byte `protobuf:"bytes,1,opt,name=developer_key_epoch_cu_key,json=developerKeyEpochCuKey,proto3" json:"developer_key_epoch_cu_key,omitempty"`
+	UsedCu                 uint64 `protobuf:"varint,2,opt,name=used_cu,json=usedCu,proto3" json:"used_cu,omitempty"`
+}
+
+func (m *DeveloperKeyEpochCu) Reset()         { *m = DeveloperKeyEpochCu{} }
+func (m *DeveloperKeyEpochCu) String() string { return proto.CompactTextString(m) }
+func (*DeveloperKeyEpochCu) ProtoMessage()    {}
+func (*DeveloperKeyEpochCu) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd1e49b8b57595b, []int{1}
+}
+func (m *DeveloperKeyEpochCu) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeveloperKeyEpochCu) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeveloperKeyEpochCu.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeveloperKeyEpochCu) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeveloperKeyEpochCu.Merge(m, src)
+}
+func (m *DeveloperKeyEpochCu) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeveloperKeyEpochCu) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeveloperKeyEpochCu.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeveloperKeyEpochCu proto.InternalMessageInfo
+
+func (m *DeveloperKeyEpochCu) GetDeveloperKeyEpochCuKey() []byte {
+	if m != nil {
+		return m.DeveloperKeyEpochCuKey
+	}
+	return nil
+}
+
+func (m *DeveloperKeyEpochCu) GetUsedCu() uint64 {
+	if m != nil {
+		return m.UsedCu
+	}
+	return 0
+}
+
+// ProviderEpochQos is the sum of the QoS excellence reports about a provider in the current epoch,
+// each report weighted by the CU paid for its relay
+type ProviderEpochQos struct {
+	ProviderQosKey string                 `protobuf:"bytes,1,opt,name=provider_qos_key,json=providerQosKey,proto3" json:"provider_qos_key,omitempty"`
+	WeightedSum    QualityOfServiceReport `protobuf:"bytes,2,opt,name=weighted_sum,json=weightedSum,proto3" json:"weighted_sum"`
+	Cu             uint64                 `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+}
+
+func (m *ProviderEpochQos) Reset()         { *m = ProviderEpochQos{} }
+func (m *ProviderEpochQos) String() string { return proto.CompactTextString(m) }
+func (*ProviderEpochQos) ProtoMessage()    {}
+func (*ProviderEpochQos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbd1e49b8b57595b, []int{2}
+}
+func (m *ProviderEpochQos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderEpochQos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderEpochQos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderEpochQos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderEpochQos.Merge(m, src)
+}
+func (m *ProviderEpochQos) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderEpochQos) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderEpochQos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderEpochQos proto.InternalMessageInfo
+
+func (m *ProviderEpochQos) GetProviderQosKey() string {
+	if m != nil {
+		return m.ProviderQosKey
+	}
+	return ""
+}
+
+func (m *ProviderEpochQos) GetWeightedSum() QualityOfServiceReport {
+	if m != nil {
+		return m.WeightedSum
+	}
+	return QualityOfServiceReport{}
+}
+
+func (m *ProviderEpochQos) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
 // GenesisState defines the pairing module's genesis state.
 type GenesisState struct {
 	Params                                 Params                               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
@@ -86,13 +200,15 @@ type GenesisState struct {
 	BadgeUsedCuList                        []BadgeUsedCu                        `protobuf:"bytes,5,rep,name=badgeUsedCuList,proto3" json:"badgeUsedCuList"`
 	BadgesTS                               types.GenesisState                   `protobuf:"bytes,6,opt,name=badgesTS,proto3" json:"badgesTS"`
 	ProviderQosFS                          types1.GenesisState                  `protobuf:"bytes,7,opt,name=providerQosFS,proto3" json:"providerQosFS"`
+	DeveloperKeyEpochCuList                []DeveloperKeyEpochCu                `protobuf:"bytes,8,rep,name=developerKeyEpochCuList,proto3" json:"developerKeyEpochCuList"`
+	ProviderEpochQosList                   []ProviderEpochQos                   `protobuf:"bytes,9,rep,name=providerEpochQosList,proto3" json:"providerEpochQosList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbd1e49b8b57595b, []int{1}
+	return fileDescriptor_dbd1e49b8b57595b, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -170,8 +286,24 @@ func (m *GenesisState) GetProviderQosFS() types1.GenesisState {
 	return types1.GenesisState{}
 }
 
+func (m *GenesisState) GetDeveloperKeyEpochCuList() []DeveloperKeyEpochCu {
+	if m != nil {
+		return m.DeveloperKeyEpochCuList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProviderEpochQosList() []ProviderEpochQos {
+	if m != nil {
+		return m.ProviderEpochQosList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BadgeUsedCu)(nil), "lavanet.lava.pairing.BadgeUsedCu")
+	proto.RegisterType((*DeveloperKeyEpochCu)(nil), "lavanet.lava.pairing.DeveloperKeyEpochCu")
+	proto.RegisterType((*ProviderEpochQos)(nil), "lavanet.lava.pairing.ProviderEpochQos")
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.pairing.GenesisState")
 }
 
@@ -180,39 +312,49 @@ func init() {
 }
 
 var fileDescriptor_dbd1e49b8b57595b = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe3, 0x36, 0x4d, 0xd1, 0xa6, 0x80, 0xba, 0xaa, 0x84, 0x15, 0x21, 0x93, 0xa6, 0x02,
-	0x52, 0x09, 0xd9, 0x52, 0x7b, 0x41, 0xdc, 0x9a, 0x0a, 0x7a, 0x80, 0x43, 0x3e, 0x5a, 0x21, 0x71,
-	0xb1, 0x36, 0xc9, 0xe0, 0xae, 0x68, 0xbc, 0xc6, 0xbb, 0xae, 0x9a, 0xb7, 0xe0, 0xc4, 0x03, 0xf0,
-	0x34, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0xc7, 0x93, 0x34, 0x2e, 0xcb, 0xc7, 0xc9,
-	0xde, 0xf8, 0x37, 0xbf, 0xff, 0x66, 0x66, 0x97, 0xb5, 0x2e, 0xc4, 0xa5, 0x88, 0xc1, 0x04, 0xf9,
-	0x33, 0x48, 0x84, 0x4c, 0x65, 0x1c, 0x05, 0x11, 0xc4, 0xa0, 0xa5, 0xf6, 0x93, 0x54, 0x19, 0xc5,
-	0x77, 0x88, 0xf1, 0xf3, 0xa7, 0x4f, 0x4c, 0x63, 0x27, 0x52, 0x91, 0x42, 0x20, 0xc8, 0xdf, 0x0a,
-	0xb6, 0xb1, 0x6b, 0xf5, 0x25, 0x22, 0x15, 0x13, 0xd2, 0x35, 0x8e, 0xac, 0x48, 0x16, 0xcb, 0xcf,
-	0x19, 0x84, 0x89, 0x98, 0x4e, 0x20, 0x36, 0xa1, 0x36, 0x2a, 0x15, 0x11, 0x84, 0xa3, 0x0b, 0x99,
-	0x2f, 0x93, 0x54, 0x5d, 0xca, 0x31, 0xa4, 0xa4, 0x38, 0xb4, 0xa7, 0x10, 0x74, 0x57, 0x42, 0x45,
-	0xfb, 0xd6, 0x22, 0x48, 0xd4, 0xe8, 0x7c, 0x51, 0xa1, 0xad, 0xe8, 0x47, 0x79, 0x25, 0x8c, 0x54,
-	0x71, 0xae, 0x83, 0xe5, 0x8a, 0xd0, 0xbd, 0x12, 0x6a, 0xe4, 0x04, 0xd2, 0x82, 0xc3, 0xd7, 0x02,
-	0x6a, 0xf5, 0x58, 0xbd, 0x23, 0xc6, 0x11, 0x9c, 0x69, 0x18, 0x1f, 0x67, 0x7c, 0x9f, 0x6d, 0x0f,
-	0xf3, 0x65, 0x98, 0x69, 0x18, 0x87, 0xa3, 0x2c, 0xfc, 0x04, 0x53, 0xd7, 0x69, 0x3a, 0xed, 0xad,
-	0xfe, 0x83, 0xe1, 0x2d, 0xf7, 0x16, 0xa6, 0xfc, 0x11, 0xdb, 0x24, 0xc8, 0x5d, 0x6b, 0x3a, 0xed,
-	0x6a, 0xbf, 0x96, 0xe1, 0xb7, 0xd6, 0xb7, 0x0d, 0xb6, 0x75, 0x52, 0x8c, 0x69, 0x60, 0x84, 0x01,
-	0xfe, 0x8a, 0xd5, 0x8a, 0x36, 0xa3, 0xa9, 0x7e, 0xf0, 0xd8, 0xb7, 0x8d, 0xcd, 0xef, 0x22, 0xd3,
-	0xa9, 0x5e, 0xff, 0x78, 0x52, 0xe9, 0x53, 0x05, 0xff, 0xea, 0xb0, 0x67, 0xc5, 0x00, 0xba, 0x45,
-	0x23, 0x06, 0x45, 0xe7, 0x8e, 0xb1, 0xfb, 0x5d, 0xea, 0xeb, 0x3b, 0xa9, 0x8d, 0xbb, 0xd6, 0x5c,
-	0x6f, 0xd7, 0x0f, 0x5e, 0xda, 0xe5, 0x67, 0xff, 0x74, 0x50, 0xf0, 0x7f, 0xa6, 0xf1, 0x94, 0x35,
-	0x16, 0x53, 0x2d, 0xb3, 0xb8, 0x97, 0x75, 0xdc, 0xcb, 0x8b, 0x3f, 0xfc, 0x51, 0x6b, 0x1d, 0xe5,
-	0xff, 0xc5, 0xca, 0xdf, 0xb3, 0x6d, 0x3c, 0x14, 0xf4, 0x49, 0x63, 0x54, 0x15, 0xa3, 0xf6, 0xec,
-	0x51, 0xaf, 0x57, 0x71, 0x4a, 0xf8, 0xdd, 0xc1, 0x7b, 0xec, 0xe1, 0xca, 0x74, 0x51, 0xbb, 0x81,
-	0xda, 0x5d, 0xbb, 0x76, 0xe5, 0xc8, 0x90, 0xf4, 0x6e, 0x3d, 0x3f, 0x61, 0xf7, 0xf0, 0x27, 0x7d,
-	0x3a, 0x70, 0x6b, 0x38, 0xf6, 0xa7, 0x65, 0xd7, 0xed, 0x81, 0xf4, 0x57, 0x4f, 0x0b, 0xf9, 0x96,
-	0xc5, 0xfc, 0x94, 0xdd, 0x5f, 0xb4, 0xa4, 0xa7, 0xf4, 0x9b, 0x81, 0xbb, 0x89, 0xb6, 0x76, 0xd9,
-	0x56, 0xba, 0x09, 0x36, 0x61, 0x59, 0xd2, 0x39, 0xba, 0x9e, 0x79, 0xce, 0xcd, 0xcc, 0x73, 0x7e,
-	0xce, 0x3c, 0xe7, 0xcb, 0xdc, 0xab, 0xdc, 0xcc, 0xbd, 0xca, 0xf7, 0xb9, 0x57, 0xf9, 0xf0, 0x3c,
-	0x92, 0xe6, 0x3c, 0x1b, 0xfa, 0x23, 0x35, 0x09, 0x4a, 0x37, 0xe8, 0x6a, 0x79, 0x33, 0xcd, 0x34,
-	0x01, 0x3d, 0xac, 0xe1, 0x0d, 0x3a, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x86, 0x08, 0xe8, 0x13,
-	0xa9, 0x04, 0x00, 0x00,
+	// 664 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x4e, 0xdb, 0x4e,
+	0x14, 0xc5, 0xe3, 0x84, 0x7f, 0x80, 0x09, 0x7f, 0x0a, 0x53, 0x54, 0xa2, 0xa8, 0x4a, 0x43, 0x50,
+	0x69, 0x90, 0x2a, 0x47, 0x82, 0x4d, 0xc5, 0x0e, 0x28, 0x65, 0x41, 0xa5, 0xe6, 0x03, 0x54, 0xa9,
+	0x1b, 0xd7, 0xb1, 0x2f, 0x66, 0xda, 0x24, 0x63, 0xe6, 0x23, 0xc5, 0x6f, 0xd1, 0x55, 0x5f, 0xa0,
+	0x2f, 0xc3, 0x92, 0x65, 0x57, 0x55, 0x05, 0x0f, 0xd0, 0x57, 0xa8, 0x3c, 0x1e, 0x87, 0x18, 0x86,
+	0xb4, 0x2b, 0x7b, 0xc6, 0xe7, 0xfe, 0xce, 0xcc, 0xf8, 0xdc, 0x41, 0xf5, 0xbe, 0x3b, 0x72, 0x87,
+	0x20, 0x9a, 0xf1, 0xb3, 0x19, 0xba, 0x84, 0x91, 0x61, 0xd0, 0x0c, 0x60, 0x08, 0x9c, 0x70, 0x3b,
+	0x64, 0x54, 0x50, 0xbc, 0xa2, 0x35, 0x76, 0xfc, 0xb4, 0xb5, 0xa6, 0xb2, 0x12, 0xd0, 0x80, 0x2a,
+	0x41, 0x33, 0x7e, 0x4b, 0xb4, 0x95, 0x35, 0x23, 0x2f, 0x74, 0x99, 0x3b, 0xd0, 0xb8, 0xca, 0xae,
+	0x51, 0x22, 0x87, 0xe4, 0x5c, 0x82, 0x13, 0xba, 0xd1, 0x00, 0x86, 0xc2, 0xe1, 0x82, 0x32, 0x37,
+	0x00, 0xc7, 0xeb, 0x93, 0x78, 0x18, 0x32, 0x3a, 0x22, 0x3e, 0x30, 0x8d, 0xd8, 0x36, 0xbb, 0x68,
+	0xd1, 0x5d, 0x88, 0x2e, 0xda, 0x34, 0x16, 0x41, 0x48, 0xbd, 0xb3, 0xb4, 0x22, 0x5d, 0x62, 0xcd,
+	0x28, 0x65, 0xd0, 0x77, 0x23, 0x23, 0xec, 0x94, 0x5c, 0xb8, 0x82, 0xd0, 0x61, 0x6c, 0x08, 0xe3,
+	0x91, 0x96, 0xae, 0x67, 0xa4, 0x82, 0x0c, 0x80, 0x25, 0x3a, 0xf5, 0x9a, 0x88, 0xea, 0x6d, 0x54,
+	0xda, 0x73, 0xfd, 0x00, 0x4e, 0x38, 0xf8, 0xfb, 0x12, 0x6f, 0xa2, 0xe5, 0x5e, 0x3c, 0x74, 0x24,
+	0x07, 0xdf, 0xf1, 0xa4, 0xf3, 0x19, 0xa2, 0xb2, 0x55, 0xb3, 0x1a, 0x0b, 0x9d, 0xc5, 0xde, 0xad,
+	0xee, 0x08, 0x22, 0xbc, 0x8a, 0x66, 0xb5, 0xa8, 0x9c, 0xaf, 0x59, 0x8d, 0x99, 0x4e, 0x51, 0xaa,
+	0x6f, 0xf5, 0x4f, 0xe8, 0xf1, 0x6b, 0x18, 0x41, 0x9f, 0x86, 0xc0, 0x8e, 0x20, 0x3a, 0x88, 0x37,
+	0xba, 0x2f, 0xf1, 0x0e, 0xaa, 0xf8, 0xe9, 0x74, 0x8c, 0x75, 0x92, 0x13, 0xc8, 0x78, 0x3c, 0xf1,
+	0xef, 0x17, 0x4e, 0xf5, 0xfa, 0x6e, 0xa1, 0xa5, 0x96, 0x3e, 0x7e, 0xa5, 0x6f, 0x53, 0x8e, 0x1b,
+	0x68, 0x69, 0xfc, 0x4b, 0xce, 0x29, 0x1f, 0xf3, 0xe7, 0x3b, 0x8b, 0xe9, 0x7c, 0x9b, 0xf2, 0x98,
+	0x7b, 0x82, 0x16, 0xbe, 0x00, 0x09, 0xce, 0x04, 0xf8, 0x0e, 0x97, 0x03, 0x05, 0x2f, 0x6d, 0xbd,
+	0xb4, 0x4d, 0xc1, 0xb3, 0xdb, 0xd2, 0xed, 0x13, 0x11, 0xbd, 0x3b, 0xed, 0x02, 0x1b, 0x11, 0x0f,
+	0x3a, 0x10, 0x52, 0x26, 0xf6, 0x66, 0x2e, 0x7f, 0x3e, 0xcb, 0x75, 0x4a, 0x29, 0xa7, 0x2b, 0x07,
+	0x78, 0x11, 0xe5, 0x3d, 0x59, 0x2e, 0xa8, 0x95, 0xe6, 0x3d, 0x59, 0xff, 0x5d, 0x44, 0x0b, 0x87,
+	0x49, 0xb4, 0xbb, 0xc2, 0x15, 0x80, 0x77, 0x50, 0x31, 0x89, 0xa6, 0x5a, 0x57, 0x69, 0xeb, 0xa9,
+	0xd9, 0xb1, 0xa5, 0x34, 0xda, 0x41, 0x57, 0xe0, 0x6f, 0x16, 0xda, 0x48, 0x42, 0xdb, 0x4a, 0xc2,
+	0xd3, 0x4d, 0xd2, 0xb6, 0xaf, 0x12, 0x9b, 0x1e, 0xc6, 0x5b, 0xc2, 0x45, 0x39, 0x5f, 0x2b, 0x34,
+	0x4a, 0x5b, 0xaf, 0xcc, 0xf0, 0x93, 0xbf, 0x32, 0xb4, 0xf1, 0x3f, 0xba, 0x61, 0x86, 0x2a, 0xe9,
+	0xf1, 0x66, 0xb5, 0x6a, 0x2d, 0x85, 0x5a, 0xe1, 0xe1, 0xa3, 0x6d, 0x19, 0xeb, 0xb4, 0xff, 0x14,
+	0x2a, 0x7e, 0x8f, 0x96, 0x55, 0x8c, 0xf4, 0x27, 0xae, 0xac, 0x66, 0x94, 0xd5, 0xba, 0xd9, 0xea,
+	0x60, 0x52, 0xae, 0x1d, 0xee, 0x33, 0x70, 0x1b, 0x3d, 0x9a, 0xc8, 0xbb, 0xc2, 0xfe, 0xa7, 0xb0,
+	0x6b, 0x66, 0xec, 0x44, 0x13, 0x69, 0xe8, 0xdd, 0x7a, 0x7c, 0x88, 0xe6, 0xd4, 0x14, 0x3f, 0xee,
+	0x96, 0x8b, 0xea, 0xb7, 0x3f, 0xcf, 0xb2, 0x6e, 0x5b, 0xd4, 0x9e, 0x4c, 0x8b, 0xe6, 0x8d, 0x8b,
+	0xf1, 0x31, 0xfa, 0x7f, 0x22, 0xc7, 0x6f, 0xba, 0xe5, 0x59, 0x45, 0x6b, 0x64, 0x69, 0x99, 0xbb,
+	0xc1, 0x04, 0xcc, 0x42, 0x30, 0x41, 0xab, 0x86, 0xee, 0x53, 0x3b, 0x9f, 0x53, 0x3b, 0xdf, 0x34,
+	0xef, 0xdc, 0xd0, 0xeb, 0xda, 0xe0, 0x21, 0x1e, 0xfe, 0x88, 0x56, 0xc2, 0x3b, 0x4d, 0xab, 0x7c,
+	0xe6, 0x95, 0xcf, 0xc6, 0xf4, 0x8c, 0xa4, 0x15, 0xda, 0xc4, 0x48, 0xda, 0xdb, 0xbd, 0xbc, 0xae,
+	0x5a, 0x57, 0xd7, 0x55, 0xeb, 0xd7, 0x75, 0xd5, 0xfa, 0x7a, 0x53, 0xcd, 0x5d, 0xdd, 0x54, 0x73,
+	0x3f, 0x6e, 0xaa, 0xb9, 0x0f, 0x2f, 0x02, 0x22, 0xce, 0x64, 0xcf, 0xf6, 0xe8, 0xa0, 0x99, 0xb9,
+	0x20, 0x2f, 0xc6, 0xf7, 0xad, 0x88, 0x42, 0xe0, 0xbd, 0xa2, 0xba, 0x20, 0xb7, 0xff, 0x0c, 0x00,
+	0xc3, 0x8e, 0xe1, 0x1b, 0xaa, 0x06, 0x00, 0x00,
 }
 
 func (m *BadgeUsedCu) Marshal() (dAtA []byte, err error) {
@@ -250,6 +392,86 @@ func (m *BadgeUsedCu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeveloperKeyEpochCu) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeveloperKeyEpochCu) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeveloperKeyEpochCu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UsedCu != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UsedCu))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DeveloperKeyEpochCuKey) > 0 {
+		i -= len(m.DeveloperKeyEpochCuKey)
+		copy(dAtA[i:], m.DeveloperKeyEpochCuKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DeveloperKeyEpochCuKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderEpochQos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderEpochQos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderEpochQos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cu != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.WeightedSum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ProviderQosKey) > 0 {
+		i -= len(m.ProviderQosKey)
+		copy(dAtA[i:], m.ProviderQosKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProviderQosKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -270,6 +492,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProviderEpochQosList) > 0 {
+		for iNdEx := len(m.ProviderEpochQosList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProviderEpochQosList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DeveloperKeyEpochCuList) > 0 {
+		for iNdEx := len(m.DeveloperKeyEpochCuList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperKeyEpochCuList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size, err := m.ProviderQosFS.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -386,6 +636,40 @@ func (m *BadgeUsedCu) Size() (n int) {
 	return n
 }
 
+func (m *DeveloperKeyEpochCu) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperKeyEpochCuKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.UsedCu != 0 {
+		n += 1 + sovGenesis(uint64(m.UsedCu))
+	}
+	return n
+}
+
+func (m *ProviderEpochQos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderQosKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.WeightedSum.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Cu != 0 {
+		n += 1 + sovGenesis(uint64(m.Cu))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -422,6 +706,18 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.ProviderQosFS.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DeveloperKeyEpochCuList) > 0 {
+		for _, e := range m.DeveloperKeyEpochCuList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProviderEpochQosList) > 0 {
+		for _, e := range m.ProviderEpochQosList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -534,6 +830,243 @@ func (m *BadgeUsedCu) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeveloperKeyEpochCu) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeveloperKeyEpochCu: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeveloperKeyEpochCu: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKeyEpochCuKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperKeyEpochCuKey = append(m.DeveloperKeyEpochCuKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeveloperKeyEpochCuKey == nil {
+				m.DeveloperKeyEpochCuKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedCu", wireType)
+			}
+			m.UsedCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderEpochQos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderEpochQos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderEpochQos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderQosKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderQosKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedSum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedSum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -798,6 +1331,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKeyEpochCuList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperKeyEpochCuList = append(m.DeveloperKeyEpochCuList, DeveloperKeyEpochCu{})
+			if err := m.DeveloperKeyEpochCuList[len(m.DeveloperKeyEpochCuList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderEpochQosList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderEpochQosList = append(m.ProviderEpochQosList, ProviderEpochQos{})
+			if err := m.ProviderEpochQosList[len(m.ProviderEpochQosList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,20 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// DeveloperKeyEpochCuKeyPrefix is the prefix to retrieve all DeveloperKeyEpochCu
+	DeveloperKeyEpochCuKeyPrefix = "DeveloperKeyEpochCu/value/"
+)
+
+// DeveloperKeyEpochCuEpochPrefix returns the store key prefix of all the DeveloperKeyEpochCu of an epoch
+func DeveloperKeyEpochCuEpochPrefix(epoch uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{}, epoch)
+}
+
+// DeveloperKeyEpochCuKey returns the store key to retrieve a DeveloperKeyEpochCu from the index fields
+func DeveloperKeyEpochCuKey(epoch uint64, developerKey string) []byte {
+	key := DeveloperKeyEpochCuEpochPrefix(epoch)
+	key = append(key, []byte(developerKey)...)
+	return append(key, byte('/'))
+}
@@ -4,7 +4,12 @@ import (
 	"strings"
 )
 
-const ProviderQosStorePrefix = "ProviderQosStore/"
+const (
+	ProviderQosStorePrefix = "ProviderQosStore/"
+
+	// ProviderEpochQosKeyPrefix is the prefix to retrieve all ProviderEpochQos
+	ProviderEpochQosKeyPrefix = "ProviderEpochQos/value/"
+)
 
 func ProviderQosKey(provider string, chainID string, cluster string) string {
 	return strings.Join([]string{chainID, cluster, provider}, "/")
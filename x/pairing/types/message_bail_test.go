@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBail_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgBailProvider
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgBailProvider{
+				Creator: "invalid_address",
+				Bail:    sdk.NewCoin("ulava", sdk.OneInt()),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "zero bail",
+			msg: MsgBailProvider{
+				Creator: sample.AccAddress(),
+				Bail:    sdk.NewCoin("ulava", sdk.ZeroInt()),
+			},
+			err: AmountCoinError,
+		}, {
+			name: "valid",
+			msg: MsgBailProvider{
+				Creator: sample.AccAddress(),
+				Bail:    sdk.NewCoin("ulava", sdk.OneInt()),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
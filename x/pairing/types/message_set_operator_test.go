@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetProviderOperator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgSetProviderOperator
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSetProviderOperator{
+				Creator:  "invalid_address",
+				ChainIds: []string{"LAV1"},
+				Operator: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid operator",
+			msg: MsgSetProviderOperator{
+				Creator:  sample.AccAddress(),
+				ChainIds: []string{"LAV1"},
+				Operator: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		}, {
+			name: "no chains",
+			msg: MsgSetProviderOperator{
+				Creator:  sample.AccAddress(),
+				Operator: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidRequest,
+		}, {
+			name: "reset operator",
+			msg: MsgSetProviderOperator{
+				Creator:  sample.AccAddress(),
+				ChainIds: []string{"LAV1"},
+			},
+		}, {
+			name: "valid",
+			msg: MsgSetProviderOperator{
+				Creator:  sample.AccAddress(),
+				ChainIds: []string{"LAV1"},
+				Operator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
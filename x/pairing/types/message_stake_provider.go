@@ -59,6 +59,12 @@ func (msg *MsgStakeProvider) ValidateBasic() error {
 		return sdkerrors.Wrapf(MonikerTooLongError, "invalid moniker (%s)", msg.Moniker)
 	}
 
+	if msg.Operator != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Operator); err != nil {
+			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid operator address (%s)", err)
+		}
+	}
+
 	if msg.DelegateCommission > 100 {
 		return sdkerrors.Wrapf(DelegateCommissionOOBError, "commission out of bound (%d)", msg.DelegateCommission)
 	}
@@ -11,6 +11,9 @@ const (
 	LatestBlocksReportEventName = "provider_latest_block_report"
 	RejectedCuEventName         = "rejected_cu"
 	UnstakeProposalEventName    = "unstake_gov_proposal"
+	ProviderBailEventName       = "provider_bail"
+	ProviderSlashedEventName    = "provider_slashed"
+	ProviderOperatorEventName   = "provider_operator_set"
 )
 
 // unstake description strings
@@ -24,6 +27,7 @@ const (
 	FlagMoniker                  = "provider-moniker"
 	FlagCommission               = "delegate-commission"
 	FlagDelegationLimit          = "delegate-limit"
+	FlagOperator                 = "operator"
 	MAX_LEN_MONIKER              = 50
 	MAX_ENDPOINTS_AMOUNT_PER_GEO = 5 // max number of endpoints per geolocation for provider stake entry
 )
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -38,12 +39,20 @@ import (
 //   -> unregisterKey(all-keys, project, nextEpoch) (see below)
 //   -> DelEntry(project, nextEpoch)
 //
+// upon TransferSubscriptionProjects(old-sub, new-sub)
+//   -> for each project: find project-next (epoch-next)
+//     -> unregisterKey(all-keys, project-next, now)
+//     -> DelEntry(project, now)
+//   -> for each project: registerKey(all-keys, new-project, now)
+//     -> AppendEntry(new-project, now)
+//
 // upon registerKey(project, epoch)
 //   -> if admin: add to project
 //   -> if devel:
 //        find devel-key (epoch)
 //        if belongs to another project: bail
-//        else if not already ours: add to project, AppendEntry(dev-key, epoch)
+//        else if not already ours: AppendEntry(dev-key, epoch)
+//        add to project (with the key's expiry, chain scopes and CU limit)
 //
 // upon unregisterKey(project, epoch)
 //   -> if admin: del from project
@@ -122,6 +131,10 @@ func (k Keeper) CreateProject(ctx sdk.Context, subAddr string, projectData types
 	project.SubscriptionPolicy = project.AdminPolicy
 
 	for _, projectKey := range projectData.GetProjectKeys() {
+		err = k.validateNewKey(ctx, projectKey)
+		if err != nil {
+			return err
+		}
 		err = k.registerKey(ctx, projectKey, &project, epoch)
 		if err != nil {
 			return err
@@ -173,6 +186,96 @@ func (k Keeper) DeleteProject(ctx sdk.Context, creator, projectID string) error
 	return k.projectsFS.DelEntry(ctx, project.Index, nextEpoch)
 }
 
+// TransferSubscriptionProjects moves all the projects of a subscription, with their
+// keys and policies, to a new subscription address. The old subscription address is
+// replaced by the new one in the projects' keys. Returns the new projects' IDs.
+// (takes effect at the current block, the old projects remain valid for the epoch)
+func (k Keeper) TransferSubscriptionProjects(ctx sdk.Context, oldSubAddr, newSubAddr string) ([]string, error) {
+	ctxBlock := uint64(ctx.BlockHeight())
+
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, ctxBlock)
+	if err != nil {
+		return nil, utils.LavaFormatError("critical: TransferSubscriptionProjects failed to get next epoch", err,
+			utils.Attribute{Key: "subscription", Value: oldSubAddr},
+			utils.Attribute{Key: "block", Value: ctxBlock},
+		)
+	}
+
+	// unregister the keys of all the projects before registering any of them, so
+	// developer keys can move between the transferred projects
+	projects := []types.Project{}
+	for _, projectID := range k.GetAllProjectsForSubscription(ctx, oldSubAddr) {
+		// the next epoch version includes the pending policy and keys changes
+		project, _, err := k.getProjectForBlock(ctx, projectID, nextEpoch)
+		if err != nil {
+			// the project is deleted by next epoch, no need to transfer it
+			continue
+		}
+
+		projectKeys := make([]types.ProjectKey, len(project.ProjectKeys))
+		copy(projectKeys, project.ProjectKeys)
+		for _, projectKey := range projectKeys {
+			err := k.unregisterKey(ctx, projectKey, &project, ctxBlock)
+			if err != nil {
+				return nil, utils.LavaFormatWarning("transfer project failed: unregister key", err,
+					utils.Attribute{Key: "project", Value: projectID},
+					utils.Attribute{Key: "key", Value: projectKey.Key},
+				)
+			}
+		}
+
+		err = k.projectsFS.DelEntry(ctx, projectID, ctxBlock)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("transfer project failed: delete project", err,
+				utils.Attribute{Key: "project", Value: projectID},
+			)
+		}
+
+		project.ProjectKeys = projectKeys
+		projects = append(projects, project)
+	}
+
+	projectIDs := []string{}
+	for _, project := range projects {
+		name := strings.TrimPrefix(project.Index, types.ProjectIndex(oldSubAddr, ""))
+		newProject := project
+		newProject.Index = types.ProjectIndex(newSubAddr, name)
+		newProject.Subscription = newSubAddr
+		newProject.ProjectKeys = []types.ProjectKey{}
+
+		var emptyProject types.Project
+		if found := k.projectsFS.FindEntry(ctx, newProject.Index, ctxBlock, &emptyProject); found {
+			return nil, utils.LavaFormatWarning("transfer project failed",
+				fmt.Errorf("project name already exist for new subscription"),
+				utils.Attribute{Key: "project", Value: newProject.Index},
+			)
+		}
+
+		for _, projectKey := range project.ProjectKeys {
+			if projectKey.Key == oldSubAddr {
+				projectKey.Key = newSubAddr
+			}
+			err := k.registerKey(ctx, projectKey, &newProject, ctxBlock)
+			if err != nil {
+				return nil, utils.LavaFormatWarning("transfer project failed: register key", err,
+					utils.Attribute{Key: "project", Value: newProject.Index},
+					utils.Attribute{Key: "key", Value: projectKey.Key},
+				)
+			}
+		}
+
+		err = k.projectsFS.AppendEntry(ctx, newProject.Index, ctxBlock, &newProject)
+		if err != nil {
+			return nil, utils.LavaFormatWarning("transfer project failed: append project", err,
+				utils.Attribute{Key: "project", Value: newProject.Index},
+			)
+		}
+		projectIDs = append(projectIDs, newProject.Index)
+	}
+
+	return projectIDs, nil
+}
+
 // registerKey adds a key to a project. For developer keys it also updates the
 // developer key registry (that maps them to projects). The block argument is
 // expected to be current block height (takes effect immediately).
@@ -215,12 +318,32 @@ func (k Keeper) registerKey(ctx sdk.Context, key types.ProjectKey, project *type
 			}
 		}
 
-		project.AppendKey(types.ProjectDeveloperKey(key.Key))
+		// the developer key keeps its restrictions (expiry, chain scopes and CU limit)
+		devKey := key.SetType(types.ProjectKey_DEVELOPER)
+		project.AppendKey(devKey)
 	}
 
 	return nil
 }
 
+// validateNewKey checks the restrictions of a key that is added to a project, and that
+// it is not already expired
+func (k Keeper) validateNewKey(ctx sdk.Context, key types.ProjectKey) error {
+	if err := key.ValidateRestrictions(); err != nil {
+		return utils.LavaFormatWarning("invalid key restrictions", err,
+			utils.Attribute{Key: "key", Value: key.Key},
+		)
+	}
+	if key.IsExpired(uint64(ctx.BlockHeight()), uint64(ctx.BlockTime().UTC().Unix())) {
+		return utils.LavaFormatWarning("invalid key", fmt.Errorf("key already expired"),
+			utils.Attribute{Key: "key", Value: key.Key},
+			utils.Attribute{Key: "expiry_block", Value: key.ExpiryBlock},
+			utils.Attribute{Key: "expiry_time", Value: key.ExpiryTime},
+		)
+	}
+	return nil
+}
+
 // unregisterKey removes a key from a project. For developer keys it also updates
 // the developer key registry (that maps them to projects). The epoch argument is
 // expected to be the next epoch start (takes effect upon next epoch).
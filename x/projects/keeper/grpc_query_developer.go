@@ -16,20 +16,22 @@ func (k Keeper) Developer(goCtx context.Context, req *types.QueryDeveloperReques
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	project, err := k.GetProjectForDeveloper(ctx, req.Developer, uint64(ctx.BlockHeight()))
+	// expired keys are shown too, so their restrictions can be inspected
+	project, err := k.getProjectForDeveloper(ctx, req.Developer, uint64(ctx.BlockHeight()))
 	if err != nil {
 		return nil, err
 	}
+	developerKey := project.GetKey(req.Developer)
 
 	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, uint64(ctx.BlockHeight()))
 	if err != nil {
 		return nil, err
 	}
 
-	pendingProject, err := k.GetProjectForDeveloper(ctx, req.Developer, nextEpoch)
+	pendingProject, err := k.getProjectForDeveloper(ctx, req.Developer, nextEpoch)
 	if err != nil || project.Equal(pendingProject) {
-		return &types.QueryDeveloperResponse{Project: &project}, nil
+		return &types.QueryDeveloperResponse{Project: &project, DeveloperKey: &developerKey}, nil
 	} else {
-		return &types.QueryDeveloperResponse{Project: &project, PendingProject: &pendingProject}, nil
+		return &types.QueryDeveloperResponse{Project: &project, PendingProject: &pendingProject, DeveloperKey: &developerKey}, nil
 	}
 }
@@ -41,7 +41,39 @@ func (k Keeper) GetProjectDeveloperData(ctx sdk.Context, developerKey string, bl
 	return devkeyData, nil
 }
 
+// GetProjectForDeveloper returns the project of a developer key at a given block. It fails if
+// the developer key expired
 func (k Keeper) GetProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (proj types.Project, errRet error) {
+	project, err := k.getProjectForDeveloper(ctx, developerKey, blockHeight)
+	if err != nil {
+		return project, err
+	}
+
+	// the expiry block is checked against the requested block, the expiry time against the
+	// start time of the requested block's epoch, so relays of past epochs are checked by
+	// the time they were served in
+	devKey := project.GetKey(developerKey)
+	blockTime := uint64(ctx.BlockTime().UTC().Unix())
+	if epoch, _, err := k.epochstorageKeeper.GetEpochStartForBlock(ctx, blockHeight); err == nil {
+		if epochStartTime, found := k.epochstorageKeeper.GetEpochStartTime(ctx, epoch); found {
+			blockTime = epochStartTime
+		}
+	}
+	if devKey.IsExpired(blockHeight, blockTime) {
+		return types.Project{}, utils.LavaFormatWarning("the developer key expired", fmt.Errorf("developer key expired"),
+			utils.Attribute{Key: "developer", Value: developerKey},
+			utils.Attribute{Key: "project", Value: project.Index},
+			utils.Attribute{Key: "expiry_block", Value: devKey.ExpiryBlock},
+			utils.Attribute{Key: "expiry_time", Value: devKey.ExpiryTime},
+			utils.Attribute{Key: "block", Value: blockHeight},
+			utils.Attribute{Key: "block_time", Value: blockTime},
+		)
+	}
+
+	return project, nil
+}
+
+func (k Keeper) getProjectForDeveloper(ctx sdk.Context, developerKey string, blockHeight uint64) (types.Project, error) {
 	var project types.Project
 	projectDeveloperData, err := k.GetProjectDeveloperData(ctx, developerKey, blockHeight)
 	if err != nil {
@@ -122,6 +154,16 @@ func (k Keeper) AddKeysToProject(ctx sdk.Context, projectID, adminKey string, pr
 		)
 	}
 
+	for _, projectKey := range projectKeys {
+		err := k.validateNewKey(ctx, projectKey)
+		if err != nil {
+			return utils.LavaFormatWarning("failed to add keys", err,
+				utils.Attribute{Key: "project", Value: projectID},
+				utils.Attribute{Key: "block", Value: ctxBlock},
+			)
+		}
+	}
+
 	for _, projectKey := range projectKeys {
 		err := k.registerKey(ctx, projectKey, &project, epoch)
 		if err != nil {
@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -1317,3 +1318,84 @@ func TestMaxKeysInProject(t *testing.T) {
 	})
 	require.Error(t, err)
 }
+
+// TestDeveloperKeyRestrictions tests developer keys with an expiry, chain scopes and a CU limit
+// scenarios:
+// 1. only developer keys can be restricted, and an already expired key cannot be added
+// 2. the restrictions are kept in the project and shown in the developer query
+// 3. a key expired by block or by time has no project, and the developer query still shows it
+func TestDeveloperKeyRestrictions(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(1, 1, 3) // 1 sub, 1 adm, 3 dev
+
+	_, sub := ts.Account("sub1")
+	_, adm := ts.Account("adm1")
+	_, dev1 := ts.Account("dev1")
+	_, dev2 := ts.Account("dev2")
+	_, dev3 := ts.Account("dev3")
+
+	_, err := ts.TxSubscriptionBuy(sub, sub, "free", 1, false, false)
+	require.NoError(t, err)
+	res, err := ts.QueryProjectDeveloper(sub)
+	require.NoError(t, err)
+	projectID := res.Project.Index
+
+	// admin keys cannot be restricted
+	admKey := types.ProjectAdminKey(adm)
+	admKey.ChainIds = []string{"LAV1"}
+	err = ts.TxProjectAddKeys(projectID, sub, admKey)
+	require.Error(t, err)
+
+	// already expired keys cannot be added
+	expiredKey := types.ProjectDeveloperKey(dev1)
+	expiredKey.ExpiryBlock = ts.BlockHeight()
+	err = ts.TxProjectAddKeys(projectID, sub, expiredKey)
+	require.Error(t, err)
+
+	blockKey := types.ProjectDeveloperKey(dev1)
+	blockKey.ExpiryBlock = ts.BlockHeight() + ts.EpochBlocks()
+	blockKey.ChainIds = []string{"LAV1", "ETH1"}
+	blockKey.EpochCuLimit = 100
+	timeKey := types.ProjectDeveloperKey(dev2)
+	timeKey.ExpiryTime = uint64(ts.BlockTime().Add(24 * time.Hour).Unix())
+	err = ts.TxProjectAddKeys(projectID, sub, blockKey, timeKey, types.ProjectDeveloperKey(dev3))
+	require.NoError(t, err)
+
+	devRes, err := ts.QueryProjectDeveloper(dev1)
+	require.NoError(t, err)
+	require.Equal(t, blockKey, *devRes.DeveloperKey)
+	require.Equal(t, blockKey, devRes.Project.GetKey(dev1))
+	devRes, err = ts.QueryProjectDeveloper(dev2)
+	require.NoError(t, err)
+	require.Equal(t, timeKey, *devRes.DeveloperKey)
+
+	// the block expiry is checked against the requested block
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev1, ts.BlockHeight())
+	require.NoError(t, err)
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev1, blockKey.ExpiryBlock)
+	require.Error(t, err)
+
+	ts.AdvanceEpoch()
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev1, ts.BlockHeight())
+	require.Error(t, err)
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev2, ts.BlockHeight())
+	require.NoError(t, err)
+
+	// the time expiry is checked against the start time of the requested block's epoch
+	servedBlock := ts.BlockHeight()
+	ts.AdvanceBlock(24 * time.Hour)
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev2, ts.BlockHeight())
+	require.NoError(t, err)
+	ts.AdvanceEpoch()
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev2, ts.BlockHeight())
+	require.Error(t, err)
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev2, servedBlock)
+	require.NoError(t, err)
+	_, err = ts.Keepers.Projects.GetProjectForDeveloper(ts.Ctx, dev3, ts.BlockHeight())
+	require.NoError(t, err)
+
+	// expired keys are still shown by the developer query
+	devRes, err = ts.QueryProjectDeveloper(dev1)
+	require.NoError(t, err)
+	require.Equal(t, blockKey, *devRes.DeveloperKey)
+}
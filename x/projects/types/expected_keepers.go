@@ -10,6 +10,7 @@ type EpochStorageKeeper interface {
 	GetNextEpoch(ctx sdk.Context, block uint64) (nextEpoch uint64, erro error)
 	GetEpochStartForBlock(ctx sdk.Context, block uint64) (epochStart, blockInEpoch uint64, err error)
 	BlocksToSaveRaw(ctx sdk.Context) (res uint64)
+	GetEpochStartTime(ctx sdk.Context, epoch uint64) (blockTime uint64, found bool)
 }
 
 type FixationStoreKeeper interface {
@@ -50,6 +50,9 @@ func (msg *MsgAddKeys) ValidateBasic() error {
 		if err != nil {
 			return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid project key address (%s)", err)
 		}
+		if err := key.ValidateRestrictions(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidKeyType, "invalid project key restrictions (%s)", err)
+		}
 	}
 
 	return nil
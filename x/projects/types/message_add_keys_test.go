@@ -37,6 +37,29 @@ func TestMsgAddKeys_ValidateBasic(t *testing.T) {
 				ProjectKeys: []ProjectKey{ProjectAdminKey(sample.AccAddress())},
 			},
 		},
+		{
+			name: "restricted admin key",
+			msg: MsgAddKeys{
+				Creator:     sample.AccAddress(),
+				ProjectKeys: []ProjectKey{{Key: sample.AccAddress(), Kinds: uint32(ProjectKey_ADMIN), ExpiryBlock: 100}},
+			},
+			err: ErrInvalidKeyType,
+		},
+		{
+			name: "duplicate chain scopes",
+			msg: MsgAddKeys{
+				Creator:     sample.AccAddress(),
+				ProjectKeys: []ProjectKey{{Key: sample.AccAddress(), Kinds: uint32(ProjectKey_DEVELOPER), ChainIds: []string{"LAV1", "LAV1"}}},
+			},
+			err: ErrInvalidKeyType,
+		},
+		{
+			name: "restricted developer key",
+			msg: MsgAddKeys{
+				Creator:     sample.AccAddress(),
+				ProjectKeys: []ProjectKey{{Key: sample.AccAddress(), Kinds: uint32(ProjectKey_DEVELOPER), ExpiryTime: 100, ChainIds: []string{"LAV1"}, EpochCuLimit: 10}},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
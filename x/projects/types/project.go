@@ -72,6 +72,52 @@ func (projectKey ProjectKey) IsTypeValid() bool {
 		(projectKey.Kinds & ^keyKindsAll) == 0x0
 }
 
+// HasRestrictions returns true if the key has an expiry, chain scopes or a CU limit
+func (projectKey ProjectKey) HasRestrictions() bool {
+	return projectKey.ExpiryBlock != 0 || projectKey.ExpiryTime != 0 ||
+		len(projectKey.ChainIds) != 0 || projectKey.EpochCuLimit != 0
+}
+
+// ValidateRestrictions checks that only developer keys are restricted
+func (projectKey ProjectKey) ValidateRestrictions() error {
+	if !projectKey.HasRestrictions() {
+		return nil
+	}
+	if projectKey.Kinds != uint32(ProjectKey_DEVELOPER) {
+		return fmt.Errorf("only developer keys can have an expiry, chain scopes or a CU limit (key: %s)", projectKey.Key)
+	}
+	chainIDs := map[string]struct{}{}
+	for _, chainID := range projectKey.ChainIds {
+		if chainID == "" {
+			return fmt.Errorf("empty chain ID in key scopes (key: %s)", projectKey.Key)
+		}
+		if _, ok := chainIDs[chainID]; ok {
+			return fmt.Errorf("duplicate chain ID %s in key scopes (key: %s)", chainID, projectKey.Key)
+		}
+		chainIDs[chainID] = struct{}{}
+	}
+	return nil
+}
+
+// IsExpired returns true if the key expired by the given block or time (unix seconds)
+func (projectKey ProjectKey) IsExpired(block, blockTime uint64) bool {
+	return (projectKey.ExpiryBlock != 0 && block >= projectKey.ExpiryBlock) ||
+		(projectKey.ExpiryTime != 0 && blockTime >= projectKey.ExpiryTime)
+}
+
+// IsChainAllowed returns true if the key can be used for the chain
+func (projectKey ProjectKey) IsChainAllowed(chainID string) bool {
+	if len(projectKey.ChainIds) == 0 {
+		return true
+	}
+	for _, allowed := range projectKey.ChainIds {
+		if allowed == chainID {
+			return true
+		}
+	}
+	return false
+}
+
 func (project *Project) GetKey(key string) ProjectKey {
 	for _, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key {
@@ -85,6 +131,12 @@ func (project *Project) AppendKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds |= key.Kinds
+			if key.IsType(ProjectKey_DEVELOPER) {
+				project.ProjectKeys[i].ExpiryBlock = key.ExpiryBlock
+				project.ProjectKeys[i].ExpiryTime = key.ExpiryTime
+				project.ProjectKeys[i].ChainIds = key.ChainIds
+				project.ProjectKeys[i].EpochCuLimit = key.EpochCuLimit
+			}
 			return true
 		}
 	}
@@ -97,6 +149,12 @@ func (project *Project) DeleteKey(key ProjectKey) bool {
 	for i, projectKey := range project.ProjectKeys {
 		if projectKey.Key == key.Key {
 			project.ProjectKeys[i].Kinds &= ^key.Kinds
+			if !project.ProjectKeys[i].IsType(ProjectKey_DEVELOPER) {
+				project.ProjectKeys[i].ExpiryBlock = 0
+				project.ProjectKeys[i].ExpiryTime = 0
+				project.ProjectKeys[i].ChainIds = nil
+				project.ProjectKeys[i].EpochCuLimit = 0
+			}
 			if project.ProjectKeys[i].Kinds == uint32(ProjectKey_NONE) {
 				if i < length-1 {
 					project.ProjectKeys[i] = project.ProjectKeys[length-1]
@@ -155,6 +155,11 @@ func (m *Project) GetSnapshot() uint64 {
 type ProjectKey struct {
 	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
 	Kinds uint32 `protobuf:"varint,4,opt,name=kinds,proto3" json:"kinds"`
+	// restrictions of developer keys
+	ExpiryBlock  uint64   `protobuf:"varint,5,opt,name=expiry_block,json=expiryBlock,proto3" json:"expiry_block"`
+	ExpiryTime   uint64   `protobuf:"varint,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time"`
+	ChainIds     []string `protobuf:"bytes,7,rep,name=chain_ids,json=chainIds,proto3" json:"chain_ids"`
+	EpochCuLimit uint64   `protobuf:"varint,8,opt,name=epoch_cu_limit,json=epochCuLimit,proto3" json:"epoch_cu_limit"`
 }
 
 func (m *ProjectKey) Reset()         { *m = ProjectKey{} }
@@ -204,6 +209,34 @@ func (m *ProjectKey) GetKinds() uint32 {
 	return 0
 }
 
+func (m *ProjectKey) GetExpiryBlock() uint64 {
+	if m != nil {
+		return m.ExpiryBlock
+	}
+	return 0
+}
+
+func (m *ProjectKey) GetExpiryTime() uint64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+func (m *ProjectKey) GetChainIds() []string {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+func (m *ProjectKey) GetEpochCuLimit() uint64 {
+	if m != nil {
+		return m.EpochCuLimit
+	}
+	return 0
+}
+
 type ProtoDeveloperData struct {
 	ProjectID string `protobuf:"bytes,1,opt,name=projectID,proto3" json:"projectID,omitempty"`
 }
@@ -330,41 +363,48 @@ func init() {
 }
 
 var fileDescriptor_9027839604ae2915 = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x8f, 0xd2, 0x40,
-	0x14, 0x67, 0x68, 0x81, 0xf6, 0xc1, 0x26, 0xcd, 0x88, 0xd9, 0x4a, 0x4c, 0x8b, 0x78, 0x69, 0x3c,
-	0x94, 0x04, 0x2f, 0x5e, 0x45, 0x30, 0x61, 0x55, 0x20, 0x8d, 0xf1, 0xb0, 0x17, 0x52, 0xe8, 0x84,
-	0xad, 0x40, 0xdb, 0x30, 0x65, 0xb3, 0xbd, 0xf9, 0x11, 0xfc, 0x18, 0x26, 0x7e, 0x09, 0x8f, 0x7b,
-	0xdc, 0xa3, 0x27, 0x62, 0xe0, 0xc6, 0xa7, 0x30, 0xd3, 0x19, 0x96, 0xd6, 0x6c, 0xb2, 0x5e, 0x3a,
-	0xf3, 0xde, 0xfc, 0xde, 0xbf, 0xfe, 0x7e, 0x0f, 0x5e, 0x2e, 0xdd, 0x6b, 0x37, 0x20, 0x71, 0x9b,
-	0x9d, 0xed, 0x68, 0x1d, 0x7e, 0x25, 0xb3, 0x98, 0x1e, 0x2f, 0x76, 0xb4, 0x0e, 0xe3, 0x10, 0x3f,
-	0x15, 0x20, 0x9b, 0x9d, 0xf6, 0x11, 0xd4, 0xa8, 0xcf, 0xc3, 0x79, 0x98, 0x22, 0xda, 0xec, 0xc6,
-	0xc1, 0x0d, 0x33, 0x9f, 0x71, 0xe9, 0x06, 0xb4, 0x1d, 0x85, 0x4b, 0x7f, 0x96, 0x70, 0x40, 0xeb,
-	0xa7, 0x04, 0x95, 0x31, 0xcf, 0x81, 0xeb, 0x50, 0xf2, 0x03, 0x8f, 0xdc, 0xe8, 0xa8, 0x89, 0x2c,
-	0xd5, 0xe1, 0x06, 0x6e, 0x41, 0x8d, 0x6e, 0xa6, 0x74, 0xb6, 0xf6, 0xa3, 0xd8, 0x0f, 0x03, 0xbd,
-	0x98, 0x3e, 0xe6, 0x7c, 0x58, 0x87, 0x0a, 0x09, 0xdc, 0xe9, 0x92, 0x78, 0xba, 0xdc, 0x44, 0x96,
-	0xe2, 0x1c, 0x4d, 0x7c, 0x09, 0x35, 0xd1, 0xe2, 0x64, 0x41, 0x12, 0xaa, 0x97, 0x9a, 0x92, 0x55,
-	0xed, 0xbc, 0xb0, 0x1f, 0x1c, 0xc2, 0x16, 0x9d, 0x7c, 0x20, 0x49, 0xb7, 0x7e, 0xbb, 0x35, 0x0b,
-	0x87, 0xad, 0x99, 0x0b, 0x77, 0xaa, 0xd1, 0x3d, 0x82, 0xe2, 0x11, 0xd4, 0x5c, 0x6f, 0xe5, 0x07,
-	0x13, 0x3e, 0x91, 0x5e, 0x6e, 0x22, 0xab, 0xda, 0x69, 0xfc, 0x93, 0x9b, 0xcd, 0x6c, 0x8f, 0x53,
-	0x44, 0x57, 0x63, 0x09, 0xb3, 0x31, 0x4e, 0x35, 0xb5, 0xf8, 0x33, 0x3e, 0x87, 0xca, 0x86, 0x12,
-	0x6f, 0x32, 0xdb, 0xe8, 0x95, 0x26, 0xb2, 0x64, 0xa7, 0xcc, 0xcc, 0x77, 0x1b, 0xec, 0xc1, 0x93,
-	0xec, 0xbc, 0xc7, 0x82, 0xca, 0xa3, 0x05, 0xcf, 0x0f, 0x5b, 0xf3, 0xa1, 0x50, 0x07, 0x67, 0x9d,
-	0xa2, 0x7c, 0x03, 0x14, 0x1a, 0xb8, 0x11, 0xbd, 0x0a, 0x63, 0x5d, 0x4d, 0xeb, 0xdf, 0xdb, 0x17,
-	0xb2, 0x22, 0x69, 0x72, 0xeb, 0x1b, 0x02, 0x38, 0xfd, 0x23, 0xfc, 0x0c, 0xa4, 0x05, 0x49, 0x38,
-	0x5d, 0xdd, 0xca, 0x61, 0x6b, 0x32, 0xd3, 0x61, 0x1f, 0x6c, 0x42, 0x69, 0xe1, 0x07, 0x1e, 0x4d,
-	0xf9, 0x38, 0xeb, 0xaa, 0x87, 0xad, 0xc9, 0x1d, 0x0e, 0x3f, 0x5a, 0xaf, 0x40, 0xfe, 0x9c, 0x44,
-	0x04, 0x2b, 0x20, 0x0f, 0x47, 0xc3, 0xbe, 0x56, 0xc0, 0x2a, 0x94, 0xde, 0xf6, 0x3e, 0x0d, 0x86,
-	0x1a, 0xc2, 0x67, 0xa0, 0xf6, 0xfa, 0x5f, 0xfa, 0x1f, 0x47, 0xe3, 0xbe, 0xa3, 0x15, 0x2f, 0x64,
-	0xa5, 0xa8, 0x49, 0xa2, 0x85, 0x37, 0x80, 0xc7, 0x4c, 0x39, 0x3d, 0x72, 0x4d, 0x96, 0x61, 0x44,
-	0xd6, 0x3d, 0x37, 0x76, 0xf1, 0x73, 0x50, 0x05, 0x33, 0x83, 0x9e, 0x90, 0xcf, 0xc9, 0xc1, 0xe3,
-	0x5b, 0xbf, 0x10, 0x54, 0x45, 0xf3, 0x69, 0x0c, 0x06, 0x39, 0x70, 0x57, 0x44, 0xc0, 0xd3, 0x7b,
-	0x56, 0x48, 0x52, 0x5e, 0x48, 0x03, 0xc8, 0x72, 0xaf, 0xcb, 0xff, 0xab, 0x23, 0x99, 0xe9, 0x28,
-	0xaf, 0x9b, 0x0e, 0x94, 0x05, 0x81, 0xa5, 0xc7, 0x08, 0x74, 0x04, 0x92, 0x8f, 0xd0, 0x7d, 0xff,
-	0x63, 0x67, 0xa0, 0xdb, 0x9d, 0x81, 0xee, 0x76, 0x06, 0xfa, 0xb3, 0x33, 0xd0, 0xf7, 0xbd, 0x51,
-	0xb8, 0xdb, 0x1b, 0x85, 0xdf, 0x7b, 0xa3, 0x70, 0x69, 0xcd, 0xfd, 0xf8, 0x6a, 0x33, 0xb5, 0x67,
-	0xe1, 0xaa, 0x9d, 0xdb, 0xbb, 0x9b, 0xd3, 0x2e, 0xc7, 0x49, 0x44, 0xe8, 0xb4, 0x9c, 0x2e, 0xdf,
-	0xeb, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0xd2, 0x34, 0xbb, 0xf1, 0x03, 0x00, 0x00,
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xc1, 0x6e, 0x9b, 0x40,
+	0x10, 0x35, 0x36, 0xb6, 0x61, 0xec, 0xb4, 0xd6, 0x36, 0x55, 0xa8, 0x55, 0x81, 0xeb, 0x5e, 0x50,
+	0x0e, 0xb8, 0x4a, 0x2e, 0xb9, 0x96, 0xd8, 0x95, 0x9c, 0xa6, 0x89, 0xb5, 0x8a, 0x7a, 0xc8, 0x05,
+	0x61, 0x58, 0xc5, 0x5b, 0x63, 0x40, 0x06, 0xa2, 0xf0, 0x17, 0xfd, 0x8c, 0x4a, 0xfd, 0x89, 0x1e,
+	0x73, 0xa9, 0x94, 0x63, 0x4f, 0xa8, 0x72, 0x6e, 0x7c, 0x45, 0x05, 0x8b, 0x63, 0x1c, 0x45, 0x4a,
+	0x2f, 0xec, 0xbe, 0xd9, 0x37, 0x33, 0xcc, 0xec, 0xbc, 0x85, 0xf7, 0x8e, 0x79, 0x6d, 0xba, 0x24,
+	0x1c, 0x64, 0xeb, 0xc0, 0x5f, 0x7a, 0xdf, 0x88, 0x15, 0x06, 0xeb, 0x8d, 0xe6, 0x2f, 0xbd, 0xd0,
+	0x43, 0xaf, 0x0b, 0x92, 0x96, 0xad, 0xda, 0x9a, 0xd4, 0xdd, 0xbd, 0xf2, 0xae, 0xbc, 0x9c, 0x31,
+	0xc8, 0x76, 0x8c, 0xdc, 0x55, 0xb6, 0x23, 0x3a, 0xa6, 0x1b, 0x0c, 0x7c, 0xcf, 0xa1, 0x56, 0xcc,
+	0x08, 0xfd, 0x9f, 0x35, 0x68, 0x4e, 0x58, 0x0c, 0xb4, 0x0b, 0x75, 0xea, 0xda, 0xe4, 0x46, 0xe2,
+	0x7a, 0x9c, 0x2a, 0x62, 0x06, 0x50, 0x1f, 0xda, 0x41, 0x34, 0x0d, 0xac, 0x25, 0xf5, 0x43, 0xea,
+	0xb9, 0x52, 0x35, 0x3f, 0xdc, 0xb2, 0x21, 0x09, 0x9a, 0xc4, 0x35, 0xa7, 0x0e, 0xb1, 0x25, 0xbe,
+	0xc7, 0xa9, 0x02, 0x5e, 0x43, 0x74, 0x09, 0xed, 0xe2, 0x17, 0x8d, 0x39, 0x89, 0x03, 0xa9, 0xde,
+	0xab, 0xa9, 0xad, 0x83, 0x77, 0xda, 0x93, 0x45, 0x68, 0xc5, 0x9f, 0x7c, 0x26, 0xb1, 0xbe, 0x7b,
+	0x9b, 0x28, 0x95, 0x34, 0x51, 0xb6, 0xdc, 0x71, 0xcb, 0x7f, 0x60, 0x04, 0xe8, 0x1c, 0xda, 0xa6,
+	0xbd, 0xa0, 0xae, 0xc1, 0x2a, 0x92, 0x1a, 0x3d, 0x4e, 0x6d, 0x1d, 0x74, 0x1f, 0xc5, 0xce, 0x6a,
+	0xd6, 0x26, 0x39, 0x43, 0xef, 0x64, 0x01, 0xcb, 0x3e, 0xb8, 0x95, 0x23, 0x76, 0x8c, 0xf6, 0xa0,
+	0x19, 0x05, 0xc4, 0x36, 0xac, 0x48, 0x6a, 0xf6, 0x38, 0x95, 0xc7, 0x8d, 0x0c, 0x1e, 0x47, 0xc8,
+	0x86, 0x57, 0xe5, 0x7a, 0xd7, 0x09, 0x85, 0x67, 0x13, 0xee, 0xa5, 0x89, 0xf2, 0x94, 0x2b, 0x46,
+	0x65, 0x63, 0x91, 0xbe, 0x0b, 0x42, 0xe0, 0x9a, 0x7e, 0x30, 0xf3, 0x42, 0x49, 0xcc, 0xf3, 0x3f,
+	0xe0, 0x13, 0x5e, 0xa8, 0x75, 0xf8, 0xfe, 0xef, 0x2a, 0xc0, 0xa6, 0x47, 0xe8, 0x0d, 0xd4, 0xe6,
+	0x24, 0x66, 0xd7, 0xa5, 0x37, 0xd3, 0x44, 0xc9, 0x20, 0xce, 0x3e, 0x48, 0x81, 0xfa, 0x9c, 0xba,
+	0x76, 0x90, 0xdf, 0xc7, 0x8e, 0x2e, 0xa6, 0x89, 0xc2, 0x0c, 0x98, 0x2d, 0xe8, 0x10, 0xda, 0xe4,
+	0xc6, 0xa7, 0xcb, 0xd8, 0x98, 0x3a, 0x9e, 0x35, 0x97, 0xea, 0x59, 0x42, 0xd6, 0xa0, 0xb2, 0x1d,
+	0xb7, 0x18, 0xd2, 0x33, 0x80, 0x3e, 0x40, 0x01, 0x8d, 0x90, 0x2e, 0x48, 0xde, 0x70, 0x5e, 0x7f,
+	0x99, 0x26, 0x4a, 0xd9, 0x8c, 0x81, 0x81, 0x0b, 0xba, 0x20, 0x68, 0x1f, 0x44, 0x6b, 0x66, 0x52,
+	0xd7, 0xa0, 0x76, 0x20, 0x35, 0x7b, 0x35, 0x55, 0xd4, 0x77, 0xd2, 0x44, 0xd9, 0x18, 0xb1, 0x90,
+	0x6f, 0xc7, 0x76, 0x80, 0x8e, 0xe0, 0x05, 0xf1, 0x3d, 0x6b, 0x66, 0x58, 0x91, 0xe1, 0xd0, 0x05,
+	0x0d, 0xf3, 0x06, 0xf3, 0x3a, 0x4a, 0x13, 0xe5, 0xd1, 0x09, 0x6e, 0xe7, 0xf8, 0x38, 0x3a, 0xcd,
+	0x50, 0x7f, 0x1f, 0xf8, 0x8b, 0xd8, 0x27, 0x48, 0x00, 0xfe, 0xec, 0xfc, 0x6c, 0xd4, 0xa9, 0x20,
+	0x11, 0xea, 0x1f, 0x87, 0x5f, 0xc6, 0x67, 0x1d, 0x0e, 0xed, 0x80, 0x38, 0x1c, 0x7d, 0x1d, 0x9d,
+	0x9e, 0x4f, 0x46, 0xb8, 0x53, 0x3d, 0xe1, 0x85, 0x6a, 0xa7, 0x56, 0xf4, 0xf3, 0x08, 0xd0, 0x24,
+	0x93, 0xc1, 0x90, 0x5c, 0x13, 0xc7, 0xf3, 0xc9, 0x72, 0x68, 0x86, 0x26, 0x7a, 0x0b, 0x62, 0x31,
+	0x66, 0xe3, 0x61, 0xa1, 0x85, 0x8d, 0x81, 0xf9, 0xf7, 0x7f, 0x71, 0xd0, 0x2a, 0x6e, 0x22, 0xf7,
+	0x41, 0xc0, 0xbb, 0xe6, 0x82, 0x14, 0xf4, 0x7c, 0x5f, 0x56, 0x45, 0x6d, 0x5b, 0x15, 0x63, 0x28,
+	0x0f, 0xb2, 0xc4, 0xff, 0xaf, 0x28, 0xf8, 0x4c, 0x14, 0xdb, 0x22, 0x38, 0x80, 0x46, 0x31, 0x8d,
+	0xf5, 0xe7, 0xa6, 0x11, 0x17, 0x4c, 0x56, 0x82, 0xfe, 0xe9, 0xc7, 0x4a, 0xe6, 0x6e, 0x57, 0x32,
+	0x77, 0xb7, 0x92, 0xb9, 0xbf, 0x2b, 0x99, 0xfb, 0x7e, 0x2f, 0x57, 0xee, 0xee, 0xe5, 0xca, 0x9f,
+	0x7b, 0xb9, 0x72, 0xa9, 0x5e, 0xd1, 0x70, 0x16, 0x4d, 0x35, 0xcb, 0x5b, 0x0c, 0xb6, 0x1e, 0x91,
+	0x9b, 0xcd, 0xc3, 0x14, 0xc6, 0x3e, 0x09, 0xa6, 0x8d, 0xfc, 0x25, 0x39, 0xfc, 0x37, 0x00, 0x8a,
+	0xcf, 0x96, 0x16, 0xbe, 0x04, 0x00, 0x00,
 }
 
 func (this *Project) Equal(that interface{}) bool {
@@ -442,6 +482,23 @@ func (this *ProjectKey) Equal(that interface{}) bool {
 	if this.Kinds != that1.Kinds {
 		return false
 	}
+	if this.ExpiryBlock != that1.ExpiryBlock {
+		return false
+	}
+	if this.ExpiryTime != that1.ExpiryTime {
+		return false
+	}
+	if len(this.ChainIds) != len(that1.ChainIds) {
+		return false
+	}
+	for i := range this.ChainIds {
+		if this.ChainIds[i] != that1.ChainIds[i] {
+			return false
+		}
+	}
+	if this.EpochCuLimit != that1.EpochCuLimit {
+		return false
+	}
 	return true
 }
 func (this *ProtoDeveloperData) Equal(that interface{}) bool {
@@ -621,6 +678,30 @@ func (m *ProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EpochCuLimit != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.EpochCuLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChainIds) > 0 {
+		for iNdEx := len(m.ChainIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChainIds[iNdEx])
+			copy(dAtA[i:], m.ChainIds[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.ChainIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExpiryBlock != 0 {
+		i = encodeVarintProject(dAtA, i, uint64(m.ExpiryBlock))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Kinds != 0 {
 		i = encodeVarintProject(dAtA, i, uint64(m.Kinds))
 		i--
@@ -796,6 +877,21 @@ func (m *ProjectKey) Size() (n int) {
 	if m.Kinds != 0 {
 		n += 1 + sovProject(uint64(m.Kinds))
 	}
+	if m.ExpiryBlock != 0 {
+		n += 1 + sovProject(uint64(m.ExpiryBlock))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovProject(uint64(m.ExpiryTime))
+	}
+	if len(m.ChainIds) > 0 {
+		for _, s := range m.ChainIds {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
+	if m.EpochCuLimit != 0 {
+		n += 1 + sovProject(uint64(m.EpochCuLimit))
+	}
 	return n
 }
 
@@ -1202,6 +1298,95 @@ func (m *ProjectKey) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlock", wireType)
+			}
+			m.ExpiryBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainIds = append(m.ChainIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCuLimit", wireType)
+			}
+			m.EpochCuLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochCuLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -157,8 +157,9 @@ func (m *QueryInfoRequest) GetProject() string {
 }
 
 type QueryInfoResponse struct {
-	Project        *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	PendingProject *Project `protobuf:"bytes,2,opt,name=pending_project,json=pendingProject,proto3" json:"pending_project,omitempty"`
+	Project        *Project    `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	PendingProject *Project    `protobuf:"bytes,2,opt,name=pending_project,json=pendingProject,proto3" json:"pending_project,omitempty"`
+	DeveloperKey   *ProjectKey `protobuf:"bytes,3,opt,name=developer_key,json=developerKey,proto3" json:"developer_key,omitempty"`
 }
 
 func (m *QueryInfoResponse) Reset()         { *m = QueryInfoResponse{} }
@@ -208,6 +209,13 @@ func (m *QueryInfoResponse) GetPendingProject() *Project {
 	return nil
 }
 
+func (m *QueryInfoResponse) GetDeveloperKey() *ProjectKey {
+	if m != nil {
+		return m.DeveloperKey
+	}
+	return nil
+}
+
 type QueryDeveloperRequest struct {
 	Developer string `protobuf:"bytes,1,opt,name=developer,proto3" json:"developer,omitempty"`
 }
@@ -253,8 +261,9 @@ func (m *QueryDeveloperRequest) GetDeveloper() string {
 }
 
 type QueryDeveloperResponse struct {
-	Project        *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
-	PendingProject *Project `protobuf:"bytes,2,opt,name=pending_project,json=pendingProject,proto3" json:"pending_project,omitempty"`
+	Project        *Project    `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	PendingProject *Project    `protobuf:"bytes,2,opt,name=pending_project,json=pendingProject,proto3" json:"pending_project,omitempty"`
+	DeveloperKey   *ProjectKey `protobuf:"bytes,3,opt,name=developer_key,json=developerKey,proto3" json:"developer_key,omitempty"`
 }
 
 func (m *QueryDeveloperResponse) Reset()         { *m = QueryDeveloperResponse{} }
@@ -304,6 +313,13 @@ func (m *QueryDeveloperResponse) GetPendingProject() *Project {
 	return nil
 }
 
+func (m *QueryDeveloperResponse) GetDeveloperKey() *ProjectKey {
+	if m != nil {
+		return m.DeveloperKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "lavanet.lava.projects.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "lavanet.lava.projects.QueryParamsResponse")
@@ -316,36 +332,38 @@ func init() {
 func init() { proto.RegisterFile("lavanet/lava/projects/query.proto", fileDescriptor_e0c4357eb0c2f6e6) }
 
 var fileDescriptor_e0c4357eb0c2f6e6 = []byte{
-	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x49, 0x2c, 0x4b,
-	0xcc, 0x4b, 0x2d, 0xd1, 0x07, 0xd1, 0xfa, 0x05, 0x45, 0xf9, 0x59, 0xa9, 0xc9, 0x25, 0xc5, 0xfa,
-	0x85, 0xa5, 0xa9, 0x45, 0x95, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xa2, 0x50, 0x25, 0x7a,
-	0x20, 0x5a, 0x0f, 0xa6, 0x44, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xac, 0x42, 0x1f, 0xc4, 0x82,
-	0x28, 0x96, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc,
-	0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0x86, 0xca, 0x2a, 0x61, 0xb7, 0xad, 0x20,
-	0xb1, 0x28, 0x31, 0x17, 0xa6, 0x46, 0x19, 0x87, 0x1a, 0x08, 0x03, 0xa2, 0x48, 0x49, 0x84, 0x4b,
-	0x28, 0x10, 0xe4, 0xc4, 0x00, 0xb0, 0xce, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0xa5, 0x20,
-	0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x6b, 0x2e, 0x36, 0x88, 0x0d,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xb2, 0x7a, 0x58, 0x7d, 0xa4, 0x07, 0xd1, 0xe6, 0xc4,
-	0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8b, 0x92, 0x0e, 0x97, 0x00, 0xd8, 0x4c, 0xcf, 0xbc,
-	0xb4, 0x7c, 0xa8, 0x3d, 0x42, 0x12, 0x5c, 0xec, 0x50, 0x4d, 0x60, 0x13, 0x39, 0x83, 0x60, 0x5c,
-	0xa5, 0x69, 0x8c, 0x5c, 0x82, 0x48, 0xca, 0xa1, 0x0e, 0xb0, 0x40, 0x55, 0xcf, 0x6d, 0x24, 0x87,
-	0xcb, 0x05, 0x10, 0x06, 0xdc, 0x3c, 0x21, 0x77, 0x2e, 0xfe, 0x82, 0xd4, 0xbc, 0x94, 0xcc, 0xbc,
-	0xf4, 0x78, 0x98, 0x09, 0x4c, 0x44, 0x99, 0xc0, 0x07, 0xd5, 0x06, 0xe5, 0x2b, 0x99, 0x72, 0x89,
-	0x82, 0xdd, 0xe5, 0x92, 0x5a, 0x96, 0x9a, 0x93, 0x5f, 0x90, 0x5a, 0x04, 0xf3, 0x8b, 0x0c, 0x17,
-	0x67, 0x0a, 0x4c, 0x0c, 0xea, 0x1b, 0x84, 0x80, 0xd2, 0x6c, 0x46, 0x2e, 0x31, 0x74, 0x7d, 0x83,
-	0xc6, 0x53, 0x46, 0x7b, 0x98, 0xb9, 0x58, 0xc1, 0xae, 0x13, 0x6a, 0x63, 0xe4, 0x62, 0x83, 0x44,
-	0x9f, 0x90, 0x26, 0x0e, 0x43, 0x30, 0xd3, 0x8b, 0x94, 0x16, 0x31, 0x4a, 0x21, 0xde, 0x55, 0x52,
-	0x6d, 0xba, 0xfc, 0x64, 0x32, 0x93, 0xbc, 0x90, 0xac, 0x3e, 0xbe, 0x34, 0x2c, 0xd4, 0xc1, 0xc8,
-	0xc5, 0x02, 0x8a, 0x7b, 0x21, 0x75, 0x7c, 0x66, 0x23, 0x25, 0x26, 0x29, 0x0d, 0xc2, 0x0a, 0xa1,
-	0x4e, 0xd0, 0x05, 0x3b, 0x41, 0x5d, 0x48, 0x15, 0x87, 0x13, 0x32, 0xf3, 0xd2, 0xf2, 0xf5, 0xab,
-	0xa1, 0xdc, 0x5a, 0xa1, 0x79, 0x8c, 0x5c, 0x9c, 0xf0, 0x68, 0x13, 0xd2, 0xc1, 0x67, 0x0d, 0x7a,
-	0xaa, 0x90, 0xd2, 0x25, 0x52, 0x35, 0xd4, 0x65, 0x26, 0x60, 0x97, 0xe9, 0x09, 0xe9, 0xe0, 0x70,
-	0x19, 0x3c, 0x41, 0xe9, 0x57, 0xc3, 0x99, 0xb5, 0x4e, 0x4e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b,
-	0x6a, 0x62, 0x05, 0xc2, 0xcc, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x79, 0x60, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x72, 0x77, 0x86, 0xc8, 0x04, 0x00, 0x00,
+	// 487 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x00, 0xd7, 0xec, 0xc3, 0x9f, 0x23, 0x98, 0x4d, 0x03, 0x45, 0xc6, 0x10, 0x56,
+	0xb3, 0x74, 0x92, 0x55, 0x13, 0x13, 0x6f, 0x1b, 0xa3, 0x31, 0x5c, 0xb4, 0x47, 0x2f, 0xa4, 0xc0,
+	0x50, 0xab, 0xcb, 0xcc, 0xd0, 0xce, 0x12, 0x1b, 0xc2, 0xc5, 0x83, 0xf1, 0x68, 0xe2, 0xdd, 0xbf,
+	0xc4, 0x3f, 0x80, 0x23, 0x89, 0x17, 0x4f, 0xc4, 0x6c, 0xfd, 0x43, 0x4c, 0x67, 0xa6, 0x85, 0x45,
+	0x5a, 0xf7, 0xcc, 0x69, 0xde, 0xcc, 0x7e, 0xde, 0xf7, 0x7d, 0xe7, 0xcd, 0xdb, 0xc2, 0xf2, 0x20,
+	0xd8, 0x0f, 0x38, 0x53, 0x34, 0x5f, 0xa9, 0x8c, 0xc5, 0x7b, 0xb6, 0xa5, 0x12, 0xba, 0x37, 0x64,
+	0x71, 0xea, 0xc9, 0x58, 0x28, 0x81, 0xe7, 0x2d, 0xe2, 0xe5, 0xab, 0x57, 0x20, 0xce, 0x5c, 0x28,
+	0x42, 0xa1, 0x09, 0x9a, 0x47, 0x06, 0x76, 0x16, 0x42, 0x21, 0xc2, 0x01, 0xa3, 0x81, 0x8c, 0x68,
+	0xc0, 0xb9, 0x50, 0x81, 0x8a, 0x04, 0x4f, 0xec, 0xaf, 0xe4, 0xe2, 0x6a, 0x32, 0x88, 0x83, 0xdd,
+	0x82, 0xb9, 0x5f, 0xc1, 0x98, 0xc0, 0x40, 0x64, 0x0e, 0xf0, 0x9b, 0xdc, 0xe2, 0x6b, 0x9d, 0xe9,
+	0xb3, 0xbd, 0x21, 0x4b, 0x14, 0xf1, 0xe1, 0xce, 0xd8, 0x69, 0x22, 0x05, 0x4f, 0x18, 0x7e, 0x06,
+	0x4d, 0x53, 0xa1, 0x8d, 0xee, 0xa1, 0xce, 0x6c, 0x6f, 0xd1, 0xbb, 0xf0, 0x46, 0x9e, 0x49, 0xeb,
+	0xcf, 0x1c, 0x9d, 0x2c, 0x35, 0x7c, 0x9b, 0x42, 0xba, 0x70, 0x4b, 0x6b, 0xbe, 0xe2, 0x3b, 0xc2,
+	0xd6, 0xc1, 0x6d, 0xb8, 0x6a, 0x93, 0xb4, 0x62, 0xcb, 0x2f, 0xb6, 0xe4, 0x04, 0xc1, 0xed, 0x33,
+	0xb8, 0x35, 0xf0, 0x74, 0x9c, 0x9f, 0xed, 0xb9, 0x55, 0x0e, 0x4c, 0x50, 0xea, 0xe1, 0x97, 0x70,
+	0x53, 0x32, 0xbe, 0x1d, 0xf1, 0x70, 0xa3, 0x50, 0x98, 0x9a, 0x48, 0xe1, 0x86, 0x4d, 0xb3, 0x7b,
+	0xfc, 0x02, 0xae, 0x6f, 0xb3, 0x7d, 0x36, 0x10, 0x92, 0xc5, 0x1b, 0x1f, 0x58, 0xda, 0x9e, 0xd6,
+	0x32, 0xcb, 0xf5, 0x32, 0xeb, 0x2c, 0xf5, 0xaf, 0x95, 0x79, 0xeb, 0x2c, 0x25, 0x4f, 0x60, 0x5e,
+	0xdf, 0xef, 0x79, 0x71, 0x58, 0xf4, 0x64, 0x01, 0x5a, 0x25, 0x68, 0xbb, 0x72, 0x7a, 0x40, 0x32,
+	0x04, 0x77, 0xcf, 0xe7, 0x5d, 0xba, 0xe6, 0xf4, 0x7e, 0x4c, 0xc3, 0x15, 0x7d, 0x4b, 0xfc, 0x19,
+	0x41, 0xd3, 0x8c, 0x13, 0x7e, 0x50, 0xa1, 0xf2, 0xef, 0xfc, 0x3a, 0x0f, 0x27, 0x41, 0x4d, 0xdb,
+	0xc8, 0xca, 0xa7, 0x9f, 0x7f, 0xbe, 0x4d, 0x2d, 0xe1, 0x45, 0x5a, 0xf7, 0x9f, 0xc2, 0x5f, 0x10,
+	0xcc, 0xe4, 0xb3, 0x88, 0x57, 0xeb, 0xb4, 0xcf, 0x0c, 0xb7, 0xd3, 0xf9, 0x3f, 0x68, 0x2d, 0xac,
+	0x69, 0x0b, 0xab, 0x78, 0xa5, 0xc2, 0x42, 0xc4, 0x77, 0x04, 0x3d, 0xb0, 0xdb, 0x43, 0xfc, 0x1d,
+	0x41, 0xab, 0x7c, 0x7e, 0xdc, 0xad, 0x2b, 0x73, 0x7e, 0xba, 0x9c, 0xb5, 0x09, 0x69, 0xeb, 0xec,
+	0xb1, 0x76, 0xe6, 0xe1, 0x6e, 0x85, 0xb3, 0xf2, 0xd5, 0xe8, 0x41, 0x19, 0x1e, 0xf6, 0xfb, 0x47,
+	0x23, 0x17, 0x1d, 0x8f, 0x5c, 0xf4, 0x7b, 0xe4, 0xa2, 0xaf, 0x99, 0xdb, 0x38, 0xce, 0xdc, 0xc6,
+	0xaf, 0xcc, 0x6d, 0xbc, 0xed, 0x84, 0x91, 0x7a, 0x37, 0xdc, 0xf4, 0xb6, 0xc4, 0xee, 0xb8, 0xe2,
+	0xc7, 0x53, 0x4d, 0x95, 0x4a, 0x96, 0x6c, 0x36, 0xf5, 0xf7, 0xe9, 0xd1, 0xdf, 0x01, 0x00, 0x43,
+	0xdc, 0x68, 0xf4, 0x58, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -612,6 +630,18 @@ func (m *QueryInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeveloperKey != nil {
+		{
+			size, err := m.DeveloperKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PendingProject != nil {
 		{
 			size, err := m.PendingProject.MarshalToSizedBuffer(dAtA[:i])
@@ -689,6 +719,18 @@ func (m *QueryDeveloperResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.DeveloperKey != nil {
+		{
+			size, err := m.DeveloperKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.PendingProject != nil {
 		{
 			size, err := m.PendingProject.MarshalToSizedBuffer(dAtA[:i])
@@ -774,6 +816,10 @@ func (m *QueryInfoResponse) Size() (n int) {
 		l = m.PendingProject.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.DeveloperKey != nil {
+		l = m.DeveloperKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -804,6 +850,10 @@ func (m *QueryDeveloperResponse) Size() (n int) {
 		l = m.PendingProject.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.DeveloperKey != nil {
+		l = m.DeveloperKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1129,6 +1179,42 @@ func (m *QueryInfoResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeveloperKey == nil {
+				m.DeveloperKey = &ProjectKey{}
+			}
+			if err := m.DeveloperKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1333,6 +1419,42 @@ func (m *QueryDeveloperResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeveloperKey == nil {
+				m.DeveloperKey = &ProjectKey{}
+			}
+			if err := m.DeveloperKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
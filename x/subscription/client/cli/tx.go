@@ -32,6 +32,8 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddProject())
 	cmd.AddCommand(CmdDelProject())
 	cmd.AddCommand(CmdAutoRenewal())
+	cmd.AddCommand(CmdTopUpCu())
+	cmd.AddCommand(CmdTransferSubscription())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+func CmdTopUpCu() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "top-up-cu [cu] [optional: consumer]",
+		Short: "Buy extra CU for the current month of a subscription",
+		Long: `The top-up-cu command buys extra CU for the current month of an active subscription,
+without changing its plan or duration. The CU are priced by the plan's price per CU times
+the top-up price factor param. Top-up CU that are not used expire when the month ends.
+If the consumer is not provided, the subscription of the creator is topped up.`,
+		Example: `Required flags: --from <creator>
+lavad tx subscription top-up-cu 100000 --from <subscription_consumer>
+lavad tx subscription top-up-cu 100000 <subscription_consumer> --from <creator>`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			creator := clientCtx.GetFromAddress().String()
+			cu, err := cast.ToUint64E(args[0])
+			if err != nil {
+				return err
+			}
+			consumer := creator
+			if len(args) == 2 {
+				consumer = args[1]
+			}
+
+			msg := types.NewMsgTopUpCu(
+				creator,
+				consumer,
+				cu,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/lavanet/lava/x/subscription/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdTransferSubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [new-consumer]",
+		Short: "Transfer the subscription of the creator to a new consumer address",
+		Long: `The transfer command moves the subscription of the creator, with its remaining months,
+credit, advance purchase and all of its projects and keys, to a new consumer address.
+The new consumer must not have a subscription. The old consumer keys can still be used
+until the end of the current epoch, the new consumer can use the subscription from the next epoch.`,
+		Example: `Required flags: --from <subscription_consumer>
+lavad tx subscription transfer <new_consumer> --from <subscription_consumer>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferSubscription(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.MarkFlagRequired(flags.FlagFrom)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
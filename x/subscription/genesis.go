@@ -16,6 +16,8 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	k.InitCuTrackers(ctx, genState.CuTrackerFS)
 	k.InitCuTrackerTimers(ctx, genState.CuTrackerTS)
 	k.SetAllAdjustment(ctx, genState.Adjustments)
+	k.SetAllLateOveruseCharge(ctx, genState.LateOveruseCharges)
+	k.SetAllSubscriptionTransfer(ctx, genState.SubscriptionTransfers)
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -27,6 +29,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.CuTrackerFS = k.ExportCuTrackers(ctx)
 	genesis.CuTrackerTS = k.ExportCuTrackerTimers(ctx)
 	genesis.Adjustments = k.GetAllAdjustment(ctx)
+	genesis.LateOveruseCharges = k.GetAllLateOveruseCharge(ctx)
+	genesis.SubscriptionTransfers = k.GetAllSubscriptionTransfer(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -30,6 +30,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgAutoRenewal:
 			res, err := msgServer.AutoRenewal(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTopUpCu:
+			res, err := msgServer.TopUpCu(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgTransferSubscription:
+			res, err := msgServer.TransferSubscription(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
@@ -129,6 +129,12 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 	if !timerData.Validate() {
 		return
 	}
+
+	// overuse charged after the month ended is paid to the providers with the rest of the month's credit
+	if lateCharge, found := k.GetLateOveruseCharge(ctx, sub, timerData.Block); found {
+		timerData.Credit = timerData.Credit.Add(lateCharge.Charged)
+		k.RemoveLateOveruseCharge(ctx, sub, timerData.Block)
+	}
 	trackedCuList, totalCuTracked := k.GetSubTrackedCuInfo(ctx, sub, timerData.Block)
 
 	if len(trackedCuList) == 0 || totalCuTracked == 0 {
@@ -245,6 +251,7 @@ func (k Keeper) RewardAndResetCuTracker(ctx sdk.Context, cuTrackerTimerKeyBytes
 	utils.LogLavaEvent(ctx, k.Logger(ctx), types.RemainingCreditEventName, map[string]string{
 		"sub":              sub,
 		"credit_remaining": updatedCredit.String(),
+		"overuse_cu":       strconv.FormatUint(timerData.OveruseCu, 10),
 		"block":            strconv.FormatInt(ctx.BlockHeight(), 10),
 	}, "CU tracker reward and reset executed")
 }
@@ -265,6 +272,9 @@ func (k Keeper) returnCreditToSub(ctx sdk.Context, sub string, credit math.Int)
 		latestSub.Credit = latestSub.Credit.AddAmount(credit)
 		k.subsFS.ModifyEntry(ctx, latestSub.Consumer, latestEntryBlock, &latestSub)
 		return latestSub.Credit
+	} else if newConsumer, transferred := k.getTransferredTo(ctx, sub); transferred {
+		// the credit of the months before the transfer goes to the new consumer
+		return k.returnCreditToSub(ctx, newConsumer, credit)
 	} else {
 		// sub expired (no need to update credit), send rewards remainder to the validators
 		pool := rewardstypes.ValidatorsRewardsDistributionPoolName
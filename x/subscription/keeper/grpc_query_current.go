@@ -15,11 +15,12 @@ func (k Keeper) Current(goCtx context.Context, req *types.QueryCurrentRequest) (
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	res := types.QueryCurrentResponse{}
+	res := types.QueryCurrentResponse{AccruedOveruse: sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdk.ZeroInt())}
 
 	sub, found := k.GetSubscription(ctx, req.Consumer)
 	if found {
 		res.Sub = &sub
+		res.AccruedOveruse = k.OveruseCost(ctx, sub)
 	}
 
 	return &res, nil
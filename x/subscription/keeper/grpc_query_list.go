@@ -44,6 +44,7 @@ func (k Keeper) List(goCtx context.Context, req *types.QueryListRequest) (*types
 			AutoRenewalNextPlan: sub.AutoRenewalNextPlan,
 			FutureSubscription:  sub.FutureSubscription,
 			Credit:              &sub.Credit,
+			MonthTopUpCu:        sub.MonthTopUpCu,
 		}
 
 		allSubsInfo = append(allSubsInfo, subInfoStruct)
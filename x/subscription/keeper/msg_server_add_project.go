@@ -35,6 +35,12 @@ func (k msgServer) AddProject(goCtx context.Context, msg *types.MsgAddProject) (
 				utils.Attribute{Key: "keyType", Value: projectKey.Kinds},
 			)
 		}
+
+		if err := projectKey.ValidateRestrictions(); err != nil {
+			return nil, utils.LavaFormatWarning("cannot add project with invalid project key restrictions to subscription", err,
+				utils.Attribute{Key: "key", Value: projectKey.Key},
+			)
+		}
 	}
 
 	if !projectstypes.ValidateProjectName(msg.GetProjectData().Name) {
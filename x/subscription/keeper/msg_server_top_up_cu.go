@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) TopUpCu(goCtx context.Context, msg *types.MsgTopUpCu) (*types.MsgTopUpCuResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.TopUpCu(ctx, msg.Creator, msg.Consumer, msg.Cu)
+	return &types.MsgTopUpCuResponse{}, err
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+func (k msgServer) TransferSubscription(goCtx context.Context, msg *types.MsgTransferSubscription) (*types.MsgTransferSubscriptionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err := k.Keeper.TransferSubscription(ctx, msg.Creator, msg.NewConsumer)
+	return &types.MsgTransferSubscriptionResponse{}, err
+}
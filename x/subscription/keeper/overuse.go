@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// AllowsOveruse returns whether the subscription's plan keeps serving CU past the monthly allowance
+func (k Keeper) AllowsOveruse(ctx sdk.Context, sub types.Subscription) bool {
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	return found && plan.AllowOveruse
+}
+
+// OveruseCuLeft returns the overuse CU that can still be paid for at the plan's overuse rate when the month ends:
+// the subscription's credit (without the month's share that pays the plan's price) and the creator's balance,
+// minus the overuse already used this month. plans with a zero overuse rate don't charge for overuse
+func (k Keeper) OveruseCuLeft(ctx sdk.Context, sub types.Subscription) uint64 {
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found || !plan.AllowOveruse {
+		return 0
+	}
+	if plan.OveruseRate == 0 {
+		return math.MaxUint64
+	}
+
+	funds := sub.Credit.Amount
+	if sub.DurationLeft > 0 {
+		funds = funds.Sub(funds.QuoRaw(int64(sub.DurationLeft)))
+	}
+	creatorAcct, err := sdk.AccAddressFromBech32(sub.Creator)
+	if err == nil {
+		funds = funds.Add(k.bankKeeper.GetBalance(ctx, creatorAcct, k.stakingKeeper.BondDenom(ctx)).Amount)
+	}
+
+	cuLeft := funds.QuoRaw(int64(plan.OveruseRate))
+	if !cuLeft.IsUint64() {
+		return math.MaxUint64
+	}
+	if cuLeft.Uint64() < sub.MonthOveruseCu {
+		return 0
+	}
+	return cuLeft.Uint64() - sub.MonthOveruseCu
+}
+
+// OveruseCost returns the charge for the overuse CU of the subscription's current month, at the plan's overuse rate
+func (k Keeper) OveruseCost(ctx sdk.Context, sub types.Subscription) sdk.Coin {
+	return k.overuseCost(ctx, sub, sub.MonthOveruseCu)
+}
+
+func (k Keeper) overuseCost(ctx sdk.Context, sub types.Subscription, overuseCu uint64) sdk.Coin {
+	cost := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), sdkmath.ZeroInt())
+	if overuseCu == 0 {
+		return cost
+	}
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return cost
+	}
+	cost.Amount = sdk.NewIntFromUint64(overuseCu).Mul(sdk.NewIntFromUint64(plan.OveruseRate))
+	return cost
+}
+
+// chargeOveruse charges overuse CU used with the subscription at its plan's overuse rate, from the given credit
+// first (if any) and the rest from the creator's balance. the charged amount is returned so it can be added to
+// the providers' rewards
+func (k Keeper) chargeOveruse(ctx sdk.Context, sub types.Subscription, overuseCu uint64, credit *sdk.Coin) sdk.Coin {
+	cost := k.overuseCost(ctx, sub, overuseCu)
+	if cost.IsZero() {
+		return cost
+	}
+
+	charged := sdk.NewCoin(cost.Denom, sdkmath.ZeroInt())
+	if credit != nil {
+		fromCredit := sdkmath.MinInt(cost.Amount, credit.Amount)
+		*credit = credit.SubAmount(fromCredit)
+		charged = charged.AddAmount(fromCredit)
+	}
+
+	fromCreator := cost.Amount.Sub(charged.Amount)
+	if fromCreator.IsPositive() {
+		creatorAcct, err := sdk.AccAddressFromBech32(sub.Creator)
+		if err == nil {
+			balance := k.bankKeeper.GetBalance(ctx, creatorAcct, cost.Denom)
+			if balance.Amount.LT(fromCreator) {
+				utils.LavaFormatWarning("subscription creator can't pay for the entire overuse", nil,
+					utils.Attribute{Key: "consumer", Value: sub.Consumer},
+					utils.Attribute{Key: "creator", Value: sub.Creator},
+					utils.Attribute{Key: "overuse_charge", Value: fromCreator},
+					utils.Attribute{Key: "balance", Value: balance},
+				)
+				fromCreator = balance.Amount
+			}
+			if fromCreator.IsPositive() {
+				err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAcct, types.ModuleName, sdk.NewCoins(sdk.NewCoin(cost.Denom, fromCreator)))
+			}
+			if err == nil {
+				charged = charged.AddAmount(fromCreator)
+			}
+		}
+		if err != nil {
+			utils.LavaFormatError("failed charging overuse from subscription creator", err,
+				utils.Attribute{Key: "consumer", Value: sub.Consumer},
+				utils.Attribute{Key: "creator", Value: sub.Creator},
+			)
+		}
+	}
+
+	details := map[string]string{
+		"consumer":   sub.Consumer,
+		"creator":    sub.Creator,
+		"overuse_cu": sdk.NewIntFromUint64(overuseCu).String(),
+		"cost":       cost.String(),
+		"charged":    charged.String(),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.OveruseChargedEventName, details, "subscription was charged for CU overuse")
+	return charged
+}
+
+// isMonthOveruseSettled returns whether the month of the subscription version already ended and its overuse was charged:
+// a newer version was appended when the month ended (or the plan was upgraded), or the subscription expired
+func (k Keeper) isMonthOveruseSettled(ctx sdk.Context, sub types.Subscription) bool {
+	// new versions are appended at the next epoch at the latest
+	var latestSub types.Subscription
+	latestBlock, _, _, found := k.subsFS.FindEntryDetailed(ctx, sub.Consumer, k.epochstorageKeeper.GetCurrentNextEpoch(ctx), &latestSub)
+	return !found || latestBlock != sub.Block
+}
+
+// chargeLateOveruse charges overuse CU used with a subscription version whose month's overuse was already settled
+// (relay payments that landed after the month ended). the overuse is charged from the latest subscription's credit
+// and the creator's balance, and kept until the month's CU tracker timer pays the providers
+func (k Keeper) chargeLateOveruse(ctx sdk.Context, sub types.Subscription, overuseCu uint64) {
+	var latestSub types.Subscription
+	var charged sdk.Coin
+	latestBlock, _, _, found := k.subsFS.FindEntryDetailed(ctx, sub.Consumer, k.epochstorageKeeper.GetCurrentNextEpoch(ctx), &latestSub)
+	if found {
+		charged = k.chargeOveruse(ctx, sub, overuseCu, &latestSub.Credit)
+		k.subsFS.ModifyEntry(ctx, latestSub.Consumer, latestBlock, &latestSub)
+	} else {
+		// the subscription expired, only the creator can pay
+		charged = k.chargeOveruse(ctx, sub, overuseCu, nil)
+	}
+	if charged.IsZero() {
+		return
+	}
+
+	lateCharge, found := k.GetLateOveruseCharge(ctx, sub.Consumer, sub.Block)
+	if !found {
+		lateCharge = types.LateOveruseCharge{Consumer: sub.Consumer, Block: sub.Block, Charged: charged}
+	} else {
+		lateCharge.Charged = lateCharge.Charged.Add(charged)
+	}
+	k.SetLateOveruseCharge(ctx, lateCharge)
+}
+
+// SetLateOveruseCharge set a specific LateOveruseCharge in the store from its consumer and block
+func (k Keeper) SetLateOveruseCharge(ctx sdk.Context, lateCharge types.LateOveruseCharge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LateOveruseChargeKeyPrefix))
+	b := k.cdc.MustMarshal(&lateCharge)
+	store.Set(types.LateOveruseChargeKey(lateCharge.Consumer, lateCharge.Block), b)
+}
+
+// GetLateOveruseCharge returns a LateOveruseCharge from its consumer and block
+func (k Keeper) GetLateOveruseCharge(ctx sdk.Context, consumer string, block uint64) (val types.LateOveruseCharge, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LateOveruseChargeKeyPrefix))
+	b := store.Get(types.LateOveruseChargeKey(consumer, block))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveLateOveruseCharge removes a LateOveruseCharge from the store
+func (k Keeper) RemoveLateOveruseCharge(ctx sdk.Context, consumer string, block uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LateOveruseChargeKeyPrefix))
+	store.Delete(types.LateOveruseChargeKey(consumer, block))
+}
+
+// GetAllLateOveruseCharge returns all LateOveruseCharge
+func (k Keeper) GetAllLateOveruseCharge(ctx sdk.Context) (list []types.LateOveruseCharge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LateOveruseChargeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LateOveruseCharge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllLateOveruseCharge sets all late overuse charges to the store
+func (k Keeper) SetAllLateOveruseCharge(ctx sdk.Context, list []types.LateOveruseCharge) {
+	for _, lateCharge := range list {
+		k.SetLateOveruseCharge(ctx, lateCharge)
+	}
+}
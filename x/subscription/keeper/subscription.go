@@ -137,6 +137,8 @@ func (k Keeper) CreateSubscription(
 			return err
 		}
 		k.subsTS.AddTimerByBlockTime(ctx, expiry, []byte(consumer), []byte{})
+		// a subscription that was transferred before was bought again
+		k.RemoveSubscriptionTransfer(ctx, consumer)
 	} else {
 		k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
 	}
@@ -472,11 +474,21 @@ func (k Keeper) addCuTrackerTimerForSubscription(ctx sdk.Context, block uint64,
 	} else {
 		creditReward := sub.Credit.Amount.QuoRaw(int64(sub.DurationLeft))
 		sub.Credit = sub.Credit.SubAmount(creditReward)
+		// the month's overuse is charged from the rest of the credit and the creator, the providers are
+		// paid for the overuse and top-up CU with the rest of the month's CU
+		overuseCharge := k.chargeOveruse(ctx, *sub, sub.MonthOveruseCu, &sub.Credit)
+		monthCredit := creditReward.Add(overuseCharge.Amount)
+		if sub.MonthTopUpCredit != nil {
+			monthCredit = monthCredit.Add(sub.MonthTopUpCredit.Amount)
+		}
 
 		timerData := types.CuTrackerTimerData{
-			Block:  sub.Block,
-			Credit: sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), creditReward),
+			Block:     sub.Block,
+			Credit:    sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), monthCredit),
+			OveruseCu: sub.MonthOveruseCu,
 		}
+		sub.MonthOveruseCu = 0
+		sub.MonthTopUpCredit = nil
 		marshaledTimerData, err := k.cdc.Marshal(&timerData)
 		if err != nil {
 			utils.LavaFormatError("critical: failed assigning CU tracker callback. can't marshal cu tracker timer data, skipping", err,
@@ -507,6 +519,7 @@ func (k Keeper) handleZeroDurationLeftForSubscription(ctx sdk.Context, block uin
 func (k Keeper) resetSubscriptionDetailsAndAppendEntry(ctx sdk.Context, sub *types.Subscription, block uint64, deleteOldTimer bool) error {
 	// reset subscription CU allowance for this coming month
 	sub.MonthCuLeft = sub.MonthCuTotal
+	sub.MonthTopUpCu = 0
 	sub.Block = block
 
 	// restart timer and append new (fixated) version of this subscription
@@ -769,7 +782,22 @@ func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer strin
 		)
 	}
 
+	if sub.TransferredTo != "" {
+		// the subscription was transferred, the usage is charged to the new consumer's subscription
+		return k.ChargeComputeUnitsToSubscription(ctx, sub.TransferredTo, uint64(ctx.BlockHeight()), cuAmount)
+	}
+
 	if sub.MonthCuLeft < cuAmount {
+		if k.AllowsOveruse(ctx, sub) {
+			overuseCu := cuAmount - sub.MonthCuLeft
+			if k.isMonthOveruseSettled(ctx, sub) {
+				// the month ended and its overuse was already charged
+				k.chargeLateOveruse(ctx, sub, overuseCu)
+			} else {
+				// charged when the month ends
+				sub.MonthOveruseCu += overuseCu
+			}
+		}
 		sub.MonthCuLeft = 0
 	} else {
 		sub.MonthCuLeft -= cuAmount
@@ -779,7 +807,8 @@ func (k Keeper) ChargeComputeUnitsToSubscription(ctx sdk.Context, consumer strin
 		utils.LogAttr("sub", consumer),
 		utils.LogAttr("sub_block", sub.Block),
 		utils.LogAttr("charge_cu", cuAmount),
-		utils.LogAttr("month_cu_left", sub.MonthCuLeft))
+		utils.LogAttr("month_cu_left", sub.MonthCuLeft),
+		utils.LogAttr("month_overuse_cu", sub.MonthOveruseCu))
 	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
 	return sub, nil
 }
@@ -2649,3 +2649,145 @@ func TestUpgradedSubscriptionCredit(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, premiumPlanPrice.Amount.MulRaw(2).Equal(res.Sub.Credit.Amount))
 }
+
+func TestTopUpCu(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(2, 0, 0) // 2 sub, 0 adm, 0 dev
+
+	subAcc, sub := ts.Account("sub1")
+	_, other := ts.Account("sub2")
+	plan := ts.Plan("free")
+
+	_, err := ts.TxSubscriptionBuy(sub, sub, plan.Index, 2, false, false)
+	require.NoError(t, err)
+
+	// no subscription to top up
+	err = ts.TxSubscriptionTopUpCu(other, other, 1000)
+	require.Error(t, err)
+	err = ts.TxSubscriptionTopUpCu(sub, sub, 0)
+	require.Error(t, err)
+
+	// half the plan's CU for half the plan's price times the top-up price factor
+	topUpCu := plan.PlanPolicy.TotalCuLimit / 2
+	price, err := ts.Keepers.Subscription.GetTopUpPrice(ts.Ctx, plan, topUpCu)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), price.Amount.Int64())
+
+	balance := ts.GetBalance(subAcc.Addr)
+	err = ts.TxSubscriptionTopUpCu(sub, sub, topUpCu)
+	require.NoError(t, err)
+	require.Equal(t, balance-price.Amount.Int64(), ts.GetBalance(subAcc.Addr))
+
+	subscription := getSubscriptionAndFailTestIfNotFound(t, ts, sub)
+	require.Equal(t, plan.PlanPolicy.TotalCuLimit+topUpCu, subscription.MonthCuLeft)
+	require.Equal(t, plan.PlanPolicy.TotalCuLimit, subscription.MonthCuTotal)
+	require.Equal(t, topUpCu, subscription.MonthTopUpCu)
+	require.Equal(t, price, *subscription.MonthTopUpCredit)
+
+	list, err := ts.Keepers.Subscription.List(ts.GoCtx, &types.QueryListRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.SubsInfo, 1)
+	require.Equal(t, topUpCu, list.SubsInfo[0].MonthTopUpCu)
+
+	// the top-up expires with the month
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+
+	subscription = getSubscriptionAndFailTestIfNotFound(t, ts, sub)
+	require.Equal(t, plan.PlanPolicy.TotalCuLimit, subscription.MonthCuLeft)
+	require.Zero(t, subscription.MonthTopUpCu)
+	require.Nil(t, subscription.MonthTopUpCredit)
+}
+
+func TestTransferSubscription(t *testing.T) {
+	ts := newTester(t)
+	ts.SetupAccounts(3, 0, 1) // 3 sub, 0 adm, 1 dev
+
+	_, sub := ts.Account("sub1")
+	_, other := ts.Account("sub2")
+	_, newConsumer := ts.Account("sub3")
+	_, dev := ts.Account("dev1")
+	plan := ts.Plan("free")
+
+	ts.AdvanceEpoch()
+	ts.AdvanceBlock()
+	_, err := ts.TxSubscriptionBuy(sub, sub, plan.Index, 3, false, false)
+	require.NoError(t, err)
+	_, err = ts.TxSubscriptionBuy(other, other, plan.Index, 1, false, false)
+	require.NoError(t, err)
+	projectData := projectstypes.ProjectData{
+		Name:        "proj",
+		Enabled:     true,
+		ProjectKeys: []projectstypes.ProjectKey{projectstypes.ProjectDeveloperKey(dev), projectstypes.ProjectAdminKey(sub)},
+	}
+	require.NoError(t, ts.TxSubscriptionAddProject(sub, projectData))
+
+	// can't transfer in the epoch the subscription was bought
+	require.Error(t, ts.TxSubscriptionTransfer(sub, newConsumer))
+	ts.AdvanceEpoch()
+
+	// the new consumer must not have a subscription
+	require.Error(t, ts.TxSubscriptionTransfer(sub, other))
+	require.Error(t, ts.TxSubscriptionTransfer(newConsumer, other))
+
+	oldSub := getSubscriptionAndFailTestIfNotFound(t, ts, sub)
+	provider := other
+	chainID := "LAV1"
+	require.NoError(t, ts.Keepers.Subscription.AddTrackedCu(ts.Ctx, sub, provider, chainID, 100, oldSub.Block))
+
+	ts.AdvanceBlock()
+	epoch := ts.EpochStart()
+	require.NoError(t, ts.TxSubscriptionTransfer(sub, newConsumer))
+
+	_, found := ts.getSubscription(sub)
+	require.False(t, found)
+	newSub := getSubscriptionAndFailTestIfNotFound(t, ts, newConsumer)
+	require.Equal(t, newConsumer, newSub.Creator)
+	require.Equal(t, oldSub.Credit, newSub.Credit)
+	require.Equal(t, oldSub.DurationLeft, newSub.DurationLeft)
+	require.Equal(t, oldSub.MonthExpiryTime, newSub.MonthExpiryTime)
+	require.Equal(t, oldSub.MonthCuLeft, newSub.MonthCuLeft)
+
+	// the current month's tracked CU moved with the subscription
+	cu, found, _ := ts.Keepers.Subscription.GetTrackedCu(ts.Ctx, newConsumer, provider, chainID, newSub.Block)
+	require.True(t, found)
+	require.Equal(t, uint64(100), cu)
+
+	// the old keys are valid until the epoch ends, and their usage is charged to the new consumer
+	project := getProjectAndFailTestIfNotFound(t, ts, dev, epoch)
+	require.Equal(t, projectstypes.ProjectIndex(sub, "proj"), project.Index)
+	chargedSub, err := ts.Keepers.Subscription.ChargeComputeUnitsToSubscription(ts.Ctx, sub, epoch, 10)
+	require.NoError(t, err)
+	require.Equal(t, newConsumer, chargedSub.Consumer)
+	require.Equal(t, oldSub.MonthCuLeft-10, chargedSub.MonthCuLeft)
+
+	ts.AdvanceEpoch()
+	project = getProjectAndFailTestIfNotFound(t, ts, dev, ts.BlockHeight())
+	require.Equal(t, projectstypes.ProjectIndex(newConsumer, "proj"), project.Index)
+	require.True(t, project.IsAdminKey(newConsumer))
+	require.False(t, project.IsAdminKey(sub))
+	project = getProjectAndFailTestIfNotFound(t, ts, newConsumer, ts.BlockHeight())
+	require.Equal(t, projectstypes.ProjectIndex(newConsumer, projectstypes.ADMIN_PROJECT_NAME), project.Index)
+	_, err = ts.GetProjectForDeveloper(sub, ts.BlockHeight())
+	require.Error(t, err)
+
+	// the month expiry timer moved with the subscription
+	ts.AdvanceMonths(1)
+	ts.AdvanceEpoch()
+	newSub = getSubscriptionAndFailTestIfNotFound(t, ts, newConsumer)
+	require.Equal(t, oldSub.DurationLeft-1, newSub.DurationLeft)
+	_, found = ts.getSubscription(sub)
+	require.False(t, found)
+
+	// the transfer is kept after the old subscription entry is gone, so the credit of the old
+	// consumer's past months goes to the new consumer
+	transfer, found := ts.Keepers.Subscription.GetSubscriptionTransfer(ts.Ctx, sub)
+	require.True(t, found)
+	require.Equal(t, newConsumer, transfer.NewConsumer)
+
+	// until the old consumer buys a subscription again
+	_, err = ts.TxSubscriptionBuy(sub, sub, plan.Index, 1, false, false)
+	require.NoError(t, err)
+	_, found = ts.Keepers.Subscription.GetSubscriptionTransfer(ts.Ctx, sub)
+	require.False(t, found)
+}
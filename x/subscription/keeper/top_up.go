@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/utils/lavaslices"
+	planstypes "github.com/lavanet/lava/x/plans/types"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// GetTopUpPrice returns the price of top-up CU: the plan's monthly price per CU times the top-up price factor, rounded up
+func (k Keeper) GetTopUpPrice(ctx sdk.Context, plan planstypes.Plan, cu uint64) (sdk.Coin, error) {
+	totalCu := plan.PlanPolicy.GetTotalCuLimit()
+	if totalCu == 0 {
+		return sdk.Coin{}, fmt.Errorf("plan %s has no CU limit to price top-ups by", plan.Index)
+	}
+	price := sdk.NewDecFromInt(plan.Price.Amount).
+		MulInt(sdk.NewIntFromUint64(cu)).
+		Mul(k.GetParams(ctx).TopUpPriceFactor).
+		QuoInt(sdk.NewIntFromUint64(totalCu)).
+		Ceil().TruncateInt()
+	return sdk.NewCoin(plan.Price.Denom, price), nil
+}
+
+// TopUpCu buys extra CU for the current month of the consumer's subscription. the creator pays, and the
+// payment is given to the providers with the rest of the month's credit when the month ends
+func (k Keeper) TopUpCu(ctx sdk.Context, creator, consumer string, cu uint64) error {
+	creatorAcct, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return utils.LavaFormatWarning("invalid creator address", err,
+			utils.Attribute{Key: "creator", Value: creator},
+		)
+	}
+
+	sub, found := k.GetSubscription(ctx, consumer)
+	if !found {
+		return utils.LavaFormatWarning("top-up CU failed", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+		)
+	}
+
+	plan, found := k.plansKeeper.FindPlan(ctx, sub.PlanIndex, sub.PlanBlock)
+	if !found {
+		return utils.LavaFormatError("top-up CU failed", fmt.Errorf("subscription plan not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "plan", Value: sub.PlanIndex},
+		)
+	}
+
+	if len(plan.AllowedBuyers) != 0 && !lavaslices.Contains(plan.AllowedBuyers, creator) {
+		return utils.LavaFormatWarning("top-up CU failed", fmt.Errorf("creator is not part of the allowed buyers list"),
+			utils.Attribute{Key: "creator", Value: creator},
+			utils.Attribute{Key: "plan", Value: plan.Index},
+			utils.Attribute{Key: "allowed_buyers", Value: strings.Join(plan.AllowedBuyers, ",")},
+		)
+	}
+
+	price, err := k.GetTopUpPrice(ctx, plan, cu)
+	if err != nil {
+		return utils.LavaFormatWarning("top-up CU failed", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "plan", Value: plan.Index},
+		)
+	}
+
+	err = k.chargeFromCreatorAccountToModule(ctx, creatorAcct, price)
+	if err != nil {
+		return err
+	}
+
+	sub.MonthCuLeft += cu
+	sub.MonthTopUpCu += cu
+	if sub.MonthTopUpCredit == nil {
+		sub.MonthTopUpCredit = &price
+	} else {
+		credit := sub.MonthTopUpCredit.Add(price)
+		sub.MonthTopUpCredit = &credit
+	}
+	k.subsFS.ModifyEntry(ctx, consumer, sub.Block, &sub)
+
+	details := map[string]string{
+		"creator":         creator,
+		"consumer":        consumer,
+		"cu":              strconv.FormatUint(cu, 10),
+		"price":           price.String(),
+		"month_top_up_cu": strconv.FormatUint(sub.MonthTopUpCu, 10),
+		"month_cu_left":   strconv.FormatUint(sub.MonthCuLeft, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TopUpCuEventName, details, "subscription CU topped up")
+	return nil
+}
@@ -0,0 +1,217 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/lavanet/lava/utils"
+	"github.com/lavanet/lava/x/subscription/types"
+)
+
+// TransferSubscription moves the consumer's subscription, with its remaining months, credit, future
+// subscription, projects and keys, to a new consumer address. The transfer takes effect at the current
+// block: the old consumer's keys remain valid until the epoch ends and their relays are charged to the
+// new consumer's subscription, the new consumer can use the subscription from the next epoch
+func (k Keeper) TransferSubscription(ctx sdk.Context, consumer, newConsumer string) error {
+	if _, err := sdk.AccAddressFromBech32(newConsumer); err != nil {
+		return utils.LavaFormatWarning("invalid new consumer address", err,
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	block := uint64(ctx.BlockHeight())
+	nextEpoch, err := k.epochstorageKeeper.GetNextEpoch(ctx, block)
+	if err != nil {
+		return utils.LavaFormatError("critical: failed to get next epoch on TransferSubscription", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	// the next epoch version includes pending upgrades and expiries
+	sub, entryBlock, found := k.GetSubscriptionForBlock(ctx, consumer, nextEpoch)
+	if !found {
+		return utils.LavaFormatWarning("transfer subscription failed", fmt.Errorf("subscription not found"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	// the subscription must be unchanged during the epoch, so all the relays of the epoch
+	// are charged to the same subscription version
+	if entryBlock > k.epochstorageKeeper.GetEpochStart(ctx) {
+		return utils.LavaFormatWarning("transfer subscription failed",
+			fmt.Errorf("subscription was bought, upgraded, renewed or transferred in this epoch, try again in the next epoch"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "sub_block", Value: entryBlock},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	var newConsumerSub types.Subscription
+	if k.subsFS.FindEntry(ctx, newConsumer, block, &newConsumerSub) || k.subsFS.FindEntry(ctx, newConsumer, nextEpoch, &newConsumerSub) {
+		return utils.LavaFormatWarning("transfer subscription failed", fmt.Errorf("new consumer already has a subscription"),
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	projectIDs, err := k.projectsKeeper.TransferSubscriptionProjects(ctx, consumer, newConsumer)
+	if err != nil {
+		return utils.LavaFormatWarning("transfer subscription failed", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+		)
+	}
+
+	newSub := sub
+	newSub.Consumer = newConsumer
+	newSub.Block = block
+	if newSub.Creator == consumer {
+		newSub.Creator = newConsumer
+	}
+	if sub.FutureSubscription != nil {
+		futureSub := *sub.FutureSubscription
+		if futureSub.Creator == consumer {
+			futureSub.Creator = newConsumer
+		}
+		newSub.FutureSubscription = &futureSub
+	}
+
+	k.transferTrackedCu(ctx, consumer, newConsumer, sub.Block, newSub.Block)
+
+	// relays of the epoch that are still paid with the old consumer's keys are charged to
+	// the new consumer's subscription (see ChargeComputeUnitsToSubscription)
+	sub.TransferredTo = newConsumer
+	// the credit of the old consumer's past months goes to the new consumer (see returnCreditToSub).
+	// the new consumer has a subscription again, so credit of its own past months stays with it
+	k.SetSubscriptionTransfer(ctx, types.SubscriptionTransfer{Consumer: consumer, NewConsumer: newConsumer})
+	k.RemoveSubscriptionTransfer(ctx, newConsumer)
+	k.subsFS.ModifyEntry(ctx, consumer, entryBlock, &sub)
+	err = k.subsFS.DelEntry(ctx, consumer, block)
+	if err != nil {
+		return utils.LavaFormatError("critical: transfer subscription failed to delete old subscription", err,
+			utils.Attribute{Key: "consumer", Value: consumer},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	err = k.subsFS.AppendEntry(ctx, newConsumer, block, &newSub)
+	if err != nil {
+		return utils.LavaFormatError("critical: transfer subscription failed to append new subscription", err,
+			utils.Attribute{Key: "new_consumer", Value: newConsumer},
+			utils.Attribute{Key: "block", Value: block},
+		)
+	}
+
+	// the month expiry timer moves to the new consumer as is
+	if k.subsTS.HasTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer)) {
+		k.subsTS.DelTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(consumer))
+	} else {
+		utils.LavaFormatError("Delete timer failed: timer key was not found", nil,
+			utils.LogAttr("expiryTime", sub.MonthExpiryTime),
+			utils.LogAttr("consumer", consumer),
+			utils.LogAttr("block", block),
+		)
+	}
+	k.subsTS.AddTimerByBlockTime(ctx, sub.MonthExpiryTime, []byte(newConsumer), []byte{})
+
+	details := map[string]string{
+		"consumer":     consumer,
+		"new_consumer": newConsumer,
+		"plan":         sub.PlanIndex,
+		"projects":     strings.Join(projectIDs, ","),
+		"credit":       sub.Credit.String(),
+		"block":        strconv.FormatUint(block, 10),
+	}
+	utils.LogLavaEvent(ctx, k.Logger(ctx), types.TransferSubscriptionEventName, details, "subscription transferred")
+	return nil
+}
+
+// transferTrackedCu moves the CU tracked in the current month of a subscription to the new consumer.
+// tracked CU of previous months stay with the old consumer and are paid by its CU tracker timers
+func (k Keeper) transferTrackedCu(ctx sdk.Context, consumer, newConsumer string, subBlock, newSubBlock uint64) {
+	for _, key := range k.GetAllSubTrackedCuIndices(ctx, consumer) {
+		_, provider, chainID := types.DecodeCuTrackerKey(key)
+		cu, found, _ := k.GetTrackedCu(ctx, consumer, provider, chainID, subBlock)
+		if !found {
+			continue
+		}
+
+		err := k.cuTrackerFS.DelEntry(ctx, key, uint64(ctx.BlockHeight()))
+		if err != nil {
+			utils.LavaFormatError("critical: failed deleting transferred tracked CU entry", err,
+				utils.Attribute{Key: "tracked_cu_key", Value: key},
+				utils.Attribute{Key: "sub_block", Value: subBlock},
+			)
+			continue
+		}
+
+		newKey := types.CuTrackerKey(newConsumer, provider, chainID)
+		err = k.cuTrackerFS.AppendEntry(ctx, newKey, newSubBlock, &types.TrackedCu{Cu: cu})
+		if err != nil {
+			utils.LavaFormatError("critical: failed creating transferred tracked CU entry", err,
+				utils.Attribute{Key: "tracked_cu_key", Value: newKey},
+				utils.Attribute{Key: "sub_block", Value: newSubBlock},
+				utils.Attribute{Key: "cu", Value: cu},
+			)
+		}
+	}
+}
+
+// getTransferredTo returns the consumer a subscription was transferred to, if it was transferred
+// and not bought again since
+func (k Keeper) getTransferredTo(ctx sdk.Context, consumer string) (string, bool) {
+	transfer, found := k.GetSubscriptionTransfer(ctx, consumer)
+	return transfer.NewConsumer, found
+}
+
+// SetSubscriptionTransfer set a specific SubscriptionTransfer in the store from its consumer
+func (k Keeper) SetSubscriptionTransfer(ctx sdk.Context, transfer types.SubscriptionTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	b := k.cdc.MustMarshal(&transfer)
+	store.Set(types.SubscriptionTransferKey(transfer.Consumer), b)
+}
+
+// GetSubscriptionTransfer returns a SubscriptionTransfer from its consumer
+func (k Keeper) GetSubscriptionTransfer(ctx sdk.Context, consumer string) (val types.SubscriptionTransfer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	b := store.Get(types.SubscriptionTransferKey(consumer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSubscriptionTransfer removes a SubscriptionTransfer from the store
+func (k Keeper) RemoveSubscriptionTransfer(ctx sdk.Context, consumer string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	store.Delete(types.SubscriptionTransferKey(consumer))
+}
+
+// GetAllSubscriptionTransfer returns all SubscriptionTransfer
+func (k Keeper) GetAllSubscriptionTransfer(ctx sdk.Context) (list []types.SubscriptionTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.SubscriptionTransferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SubscriptionTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetAllSubscriptionTransfer sets all subscription transfers to the store
+func (k Keeper) SetAllSubscriptionTransfer(ctx sdk.Context, list []types.SubscriptionTransfer) {
+	for _, transfer := range list {
+		k.SetSubscriptionTransfer(ctx, transfer)
+	}
+}
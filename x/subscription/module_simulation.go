@@ -41,6 +41,14 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgAutoRenewal int = 100
 
+	opWeightMsgTopUpCu = "op_weight_msg_top_up_cu"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTopUpCu int = 100
+
+	opWeightMsgTransferSubscription = "op_weight_msg_transfer_subscription"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferSubscription int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -113,6 +121,28 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		subscriptionsimulation.SimulateMsgAutoRenewal(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgTopUpCu int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTopUpCu, &weightMsgTopUpCu, nil,
+		func(_ *rand.Rand) {
+			weightMsgTopUpCu = defaultWeightMsgTopUpCu
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTopUpCu,
+		subscriptionsimulation.SimulateMsgTopUpCu(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransferSubscription int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgTransferSubscription, &weightMsgTransferSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferSubscription = defaultWeightMsgTransferSubscription
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferSubscription,
+		subscriptionsimulation.SimulateMsgTransferSubscription(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -14,6 +14,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddProject{}, "subscription/AddProject", nil)
 	cdc.RegisterConcrete(&MsgDelProject{}, "subscription/DelProject", nil)
 	cdc.RegisterConcrete(&MsgAutoRenewal{}, "subscription/AutoRenewal", nil)
+	cdc.RegisterConcrete(&MsgTopUpCu{}, "subscription/TopUpCu", nil)
+	cdc.RegisterConcrete(&MsgTransferSubscription{}, "subscription/TransferSubscription", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -30,6 +32,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAutoRenewal{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTopUpCu{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferSubscription{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
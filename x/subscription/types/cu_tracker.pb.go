@@ -69,8 +69,9 @@ func (m *TrackedCu) GetCu() uint64 {
 }
 
 type CuTrackerTimerData struct {
-	Block  uint64     `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
-	Credit types.Coin `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit"`
+	Block     uint64     `protobuf:"varint,1,opt,name=block,proto3" json:"block,omitempty"`
+	Credit    types.Coin `protobuf:"bytes,2,opt,name=credit,proto3" json:"credit"`
+	OveruseCu uint64     `protobuf:"varint,3,opt,name=overuse_cu,json=overuseCu,proto3" json:"overuse_cu,omitempty"`
 }
 
 func (m *CuTrackerTimerData) Reset()         { *m = CuTrackerTimerData{} }
@@ -120,9 +121,77 @@ func (m *CuTrackerTimerData) GetCredit() types.Coin {
 	return types.Coin{}
 }
 
+func (m *CuTrackerTimerData) GetOveruseCu() uint64 {
+	if m != nil {
+		return m.OveruseCu
+	}
+	return 0
+}
+
+type LateOveruseCharge struct {
+	Consumer string     `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Block    uint64     `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Charged  types.Coin `protobuf:"bytes,3,opt,name=charged,proto3" json:"charged"`
+}
+
+func (m *LateOveruseCharge) Reset()         { *m = LateOveruseCharge{} }
+func (m *LateOveruseCharge) String() string { return proto.CompactTextString(m) }
+func (*LateOveruseCharge) ProtoMessage()    {}
+func (*LateOveruseCharge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5974e118ddf7c543, []int{2}
+}
+func (m *LateOveruseCharge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LateOveruseCharge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LateOveruseCharge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LateOveruseCharge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LateOveruseCharge.Merge(m, src)
+}
+func (m *LateOveruseCharge) XXX_Size() int {
+	return m.Size()
+}
+func (m *LateOveruseCharge) XXX_DiscardUnknown() {
+	xxx_messageInfo_LateOveruseCharge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LateOveruseCharge proto.InternalMessageInfo
+
+func (m *LateOveruseCharge) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *LateOveruseCharge) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *LateOveruseCharge) GetCharged() types.Coin {
+	if m != nil {
+		return m.Charged
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*TrackedCu)(nil), "lavanet.lava.subscription.TrackedCu")
 	proto.RegisterType((*CuTrackerTimerData)(nil), "lavanet.lava.subscription.CuTrackerTimerData")
+	proto.RegisterType((*LateOveruseCharge)(nil), "lavanet.lava.subscription.LateOveruseCharge")
 }
 
 func init() {
@@ -130,24 +199,28 @@ func init() {
 }
 
 var fileDescriptor_5974e118ddf7c543 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x27, 0x43, 0x2d, 0x18, 0xc1, 0xc5, 0xd0, 0x45, 0x5b, 0x21, 0x96, 0xae, 0x8a, 0x48,
-	0x42, 0x75, 0xe1, 0xbe, 0x23, 0x3e, 0x40, 0xe9, 0xca, 0x8d, 0x24, 0xb7, 0x61, 0x0c, 0xed, 0xcc,
-	0x1d, 0xf2, 0x53, 0xf4, 0x2d, 0x7c, 0xac, 0x2e, 0xbb, 0x74, 0x25, 0x32, 0xf3, 0x22, 0x32, 0x3f,
-	0x0b, 0xbb, 0x3a, 0x27, 0xc9, 0x17, 0xee, 0xb9, 0x87, 0xde, 0xed, 0xe5, 0x41, 0x16, 0xda, 0x8b,
-	0x46, 0x85, 0x0b, 0xca, 0x81, 0x35, 0xa5, 0x37, 0x58, 0x08, 0x08, 0x6f, 0xde, 0x4a, 0xd8, 0x69,
-	0xcb, 0x4b, 0x8b, 0x1e, 0x93, 0x49, 0xcf, 0xf2, 0x46, 0xf9, 0x7f, 0x76, 0xca, 0x00, 0x5d, 0x8e,
-	0x4e, 0x28, 0xe9, 0xb4, 0x38, 0x2c, 0x95, 0xf6, 0x72, 0x29, 0x00, 0x4d, 0xd1, 0x7d, 0x9d, 0x8e,
-	0x32, 0xcc, 0xb0, 0xb5, 0xa2, 0x71, 0xdd, 0xed, 0xfc, 0x86, 0x5e, 0x6e, 0xda, 0x09, 0xdb, 0x34,
-	0x24, 0xd7, 0x34, 0x86, 0x30, 0x26, 0x33, 0xb2, 0x18, 0xac, 0x63, 0x08, 0x73, 0xa0, 0x49, 0x1a,
-	0xba, 0x67, 0xbb, 0x31, 0xb9, 0xb6, 0xcf, 0xd2, 0xcb, 0x64, 0x44, 0x2f, 0xd4, 0x1e, 0x61, 0xd7,
-	0x83, 0xdd, 0x21, 0x79, 0xa2, 0x43, 0xb0, 0x7a, 0x6b, 0xfc, 0x38, 0x9e, 0x91, 0xc5, 0xd5, 0xc3,
-	0x84, 0x77, 0x79, 0x78, 0x93, 0x87, 0xf7, 0x79, 0x78, 0x8a, 0xa6, 0x58, 0x0d, 0x8e, 0x3f, 0xb7,
-	0xd1, 0xba, 0xc7, 0x57, 0x2f, 0xc7, 0x8a, 0x91, 0x53, 0xc5, 0xc8, 0x6f, 0xc5, 0xc8, 0x57, 0xcd,
-	0xa2, 0x53, 0xcd, 0xa2, 0xef, 0x9a, 0x45, 0xaf, 0xf7, 0x99, 0xf1, 0xef, 0x41, 0x71, 0xc0, 0x5c,
-	0x9c, 0x75, 0xf4, 0x71, 0xde, 0x92, 0xff, 0x2c, 0xb5, 0x53, 0xc3, 0x76, 0xa1, 0xc7, 0xbf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x69, 0xac, 0xb1, 0xfe, 0x4f, 0x01, 0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x3d, 0x4b, 0x3b, 0x31,
+	0x18, 0xc0, 0x2f, 0xd7, 0xfe, 0xfb, 0xb7, 0x11, 0x04, 0x43, 0x87, 0x6b, 0xc5, 0x58, 0x3a, 0x15,
+	0x91, 0x84, 0xea, 0x20, 0xae, 0x3d, 0x71, 0x12, 0x84, 0xd2, 0xc9, 0xa5, 0xe4, 0xd2, 0x70, 0x0d,
+	0xed, 0x5d, 0x4a, 0x5e, 0x8a, 0x6e, 0xe2, 0x27, 0xf0, 0x63, 0x75, 0xec, 0xe8, 0x24, 0xd2, 0xfb,
+	0x22, 0x72, 0x2f, 0x6a, 0xbb, 0x39, 0x3d, 0x2f, 0xf9, 0x3d, 0xc9, 0x8f, 0x3c, 0xf0, 0x7c, 0xc1,
+	0x56, 0x2c, 0x15, 0x96, 0xe6, 0x91, 0x1a, 0x17, 0x19, 0xae, 0xe5, 0xd2, 0x4a, 0x95, 0x52, 0xee,
+	0x26, 0x56, 0x33, 0x3e, 0x17, 0x9a, 0x2c, 0xb5, 0xb2, 0x0a, 0xb5, 0x2b, 0x96, 0xe4, 0x91, 0xec,
+	0xb2, 0x1d, 0xcc, 0x95, 0x49, 0x94, 0xa1, 0x11, 0x33, 0x82, 0xae, 0x06, 0x91, 0xb0, 0x6c, 0x40,
+	0xb9, 0x92, 0x69, 0x39, 0xda, 0x69, 0xc5, 0x2a, 0x56, 0x45, 0x4a, 0xf3, 0xac, 0xec, 0xf6, 0x4e,
+	0x60, 0x73, 0x5c, 0xbc, 0x30, 0x0d, 0x1d, 0x3a, 0x82, 0x3e, 0x77, 0x01, 0xe8, 0x82, 0x7e, 0x7d,
+	0xe4, 0x73, 0xd7, 0x7b, 0x05, 0x10, 0x85, 0xae, 0x3c, 0xd7, 0x63, 0x99, 0x08, 0x7d, 0xcb, 0x2c,
+	0x43, 0x2d, 0xf8, 0x2f, 0x5a, 0x28, 0x3e, 0xaf, 0xc8, 0xb2, 0x40, 0xd7, 0xb0, 0xc1, 0xb5, 0x98,
+	0x4a, 0x1b, 0xf8, 0x5d, 0xd0, 0x3f, 0xbc, 0x6c, 0x93, 0x52, 0x88, 0xe4, 0x42, 0xa4, 0x12, 0x22,
+	0xa1, 0x92, 0xe9, 0xb0, 0xbe, 0xfe, 0x38, 0xf3, 0x46, 0x15, 0x8e, 0x4e, 0x21, 0x54, 0x2b, 0xa1,
+	0x9d, 0x11, 0x13, 0xee, 0x82, 0x5a, 0x71, 0x67, 0xb3, 0xea, 0x84, 0xae, 0xf7, 0x02, 0xe0, 0xf1,
+	0x3d, 0xb3, 0xe2, 0xa1, 0xea, 0xcc, 0x98, 0x8e, 0x05, 0xea, 0xc0, 0x03, 0xae, 0x52, 0xe3, 0x12,
+	0xa1, 0x0b, 0x8d, 0xe6, 0xe8, 0xa7, 0xfe, 0xf5, 0xf3, 0x77, 0xfd, 0x6e, 0xe0, 0x7f, 0x5e, 0xcc,
+	0x4e, 0x83, 0xda, 0xdf, 0x04, 0xbf, 0xf9, 0xe1, 0xdd, 0x7a, 0x8b, 0xc1, 0x66, 0x8b, 0xc1, 0xe7,
+	0x16, 0x83, 0xb7, 0x0c, 0x7b, 0x9b, 0x0c, 0x7b, 0xef, 0x19, 0xf6, 0x1e, 0x2f, 0x62, 0x69, 0x67,
+	0x2e, 0x22, 0x5c, 0x25, 0x74, 0x6f, 0x8d, 0x4f, 0xfb, 0x8b, 0xb4, 0xcf, 0x4b, 0x61, 0xa2, 0x46,
+	0xf1, 0xe7, 0x57, 0x5f, 0x03, 0x00, 0x42, 0xf3, 0x89, 0x84, 0xf2, 0x01, 0x00, 0x00,
 }
 
 func (m *TrackedCu) Marshal() (dAtA []byte, err error) {
@@ -198,6 +271,11 @@ func (m *CuTrackerTimerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OveruseCu != 0 {
+		i = encodeVarintCuTracker(dAtA, i, uint64(m.OveruseCu))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -216,6 +294,51 @@ func (m *CuTrackerTimerData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LateOveruseCharge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LateOveruseCharge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LateOveruseCharge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Charged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCuTracker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Block != 0 {
+		i = encodeVarintCuTracker(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintCuTracker(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCuTracker(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCuTracker(v)
 	base := offset
@@ -250,6 +373,27 @@ func (m *CuTrackerTimerData) Size() (n int) {
 	}
 	l = m.Credit.Size()
 	n += 1 + l + sovCuTracker(uint64(l))
+	if m.OveruseCu != 0 {
+		n += 1 + sovCuTracker(uint64(m.OveruseCu))
+	}
+	return n
+}
+
+func (m *LateOveruseCharge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovCuTracker(uint64(l))
+	}
+	if m.Block != 0 {
+		n += 1 + sovCuTracker(uint64(m.Block))
+	}
+	l = m.Charged.Size()
+	n += 1 + l + sovCuTracker(uint64(l))
 	return n
 }
 
@@ -409,6 +553,159 @@ func (m *CuTrackerTimerData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OveruseCu", wireType)
+			}
+			m.OveruseCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OveruseCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCuTracker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LateOveruseCharge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCuTracker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LateOveruseCharge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LateOveruseCharge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCuTracker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCuTracker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Charged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCuTracker(dAtA[iNdEx:])
@@ -40,6 +40,7 @@ type ProjectsKeeper interface {
 	DeleteProject(ctx sdk.Context, creator, index string) error
 	SnapshotSubscriptionProjects(ctx sdk.Context, subscriptionAddr string, block uint64)
 	GetAllProjectsForSubscription(ctx sdk.Context, subscription string) []string
+	TransferSubscriptionProjects(ctx sdk.Context, oldSubAddr, newSubAddr string) ([]string, error)
 	// Methods imported from projectskeeper should be defined here
 }
 
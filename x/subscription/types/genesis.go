@@ -14,12 +14,14 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:      DefaultParams(),
-		SubsFS:      *fixationstoretypes.DefaultGenesis(),
-		SubsTS:      *timerstoretypes.DefaultGenesis(),
-		CuTrackerFS: *fixationstoretypes.DefaultGenesis(),
-		CuTrackerTS: *timerstoretypes.DefaultGenesis(),
-		Adjustments: []Adjustment{},
+		Params:                DefaultParams(),
+		SubsFS:                *fixationstoretypes.DefaultGenesis(),
+		SubsTS:                *timerstoretypes.DefaultGenesis(),
+		CuTrackerFS:           *fixationstoretypes.DefaultGenesis(),
+		CuTrackerTS:           *timerstoretypes.DefaultGenesis(),
+		Adjustments:           []Adjustment{},
+		LateOveruseCharges:    []LateOveruseCharge{},
+		SubscriptionTransfers: []SubscriptionTransfer{},
 	}
 }
 
@@ -27,12 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the subscription module's genesis state.
 type GenesisState struct {
-	Params      Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	SubsFS      types.GenesisState  `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
-	SubsTS      types1.GenesisState `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
-	CuTrackerFS types.GenesisState  `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
-	CuTrackerTS types1.GenesisState `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
-	Adjustments []Adjustment        `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	Params                Params                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	SubsFS                types.GenesisState     `protobuf:"bytes,2,opt,name=subsFS,proto3" json:"subsFS"`
+	SubsTS                types1.GenesisState    `protobuf:"bytes,3,opt,name=subsTS,proto3" json:"subsTS"`
+	CuTrackerFS           types.GenesisState     `protobuf:"bytes,4,opt,name=cuTrackerFS,proto3" json:"cuTrackerFS"`
+	CuTrackerTS           types1.GenesisState    `protobuf:"bytes,5,opt,name=cuTrackerTS,proto3" json:"cuTrackerTS"`
+	Adjustments           []Adjustment           `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	LateOveruseCharges    []LateOveruseCharge    `protobuf:"bytes,7,rep,name=late_overuse_charges,json=lateOveruseCharges,proto3" json:"late_overuse_charges"`
+	SubscriptionTransfers []SubscriptionTransfer `protobuf:"bytes,8,rep,name=subscription_transfers,json=subscriptionTransfers,proto3" json:"subscription_transfers"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +112,20 @@ func (m *GenesisState) GetAdjustments() []Adjustment {
 	return nil
 }
 
+func (m *GenesisState) GetLateOveruseCharges() []LateOveruseCharge {
+	if m != nil {
+		return m.LateOveruseCharges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSubscriptionTransfers() []SubscriptionTransfer {
+	if m != nil {
+		return m.SubscriptionTransfers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "lavanet.lava.subscription.GenesisState")
 }
@@ -119,29 +135,34 @@ func init() {
 }
 
 var fileDescriptor_dc6c60f9c112fe52 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x31, 0x4e, 0xf3, 0x30,
-	0x18, 0x86, 0x93, 0xbf, 0xfd, 0x33, 0xa4, 0x4c, 0x11, 0x43, 0xe8, 0x10, 0x0a, 0x08, 0x28, 0x08,
-	0x39, 0x12, 0x1c, 0x00, 0x51, 0xa4, 0x30, 0x21, 0x55, 0x24, 0x13, 0x9b, 0x1b, 0x4c, 0x30, 0x90,
-	0x38, 0xb2, 0x1d, 0x54, 0x6e, 0xc1, 0xb1, 0x3a, 0x30, 0x74, 0x64, 0x42, 0xa8, 0xb9, 0x08, 0xb2,
-	0x9d, 0xb6, 0xb6, 0x50, 0x06, 0x98, 0xec, 0x48, 0xcf, 0xfb, 0xe4, 0xfd, 0x92, 0xcf, 0x3d, 0x7c,
-	0x86, 0x2f, 0xb0, 0x40, 0x3c, 0x14, 0x67, 0xc8, 0xaa, 0x09, 0x4b, 0x29, 0x2e, 0x39, 0x26, 0x45,
-	0x98, 0xa1, 0x02, 0x31, 0xcc, 0x40, 0x49, 0x09, 0x27, 0xde, 0x56, 0x03, 0x02, 0x71, 0x02, 0x1d,
-	0xec, 0x6f, 0x66, 0x24, 0x23, 0x92, 0x0a, 0xc5, 0x4d, 0x05, 0xfa, 0x07, 0xed, 0xe6, 0x12, 0x52,
-	0x98, 0x37, 0xe2, 0xfe, 0x71, 0x3b, 0x07, 0xef, 0x1e, 0x2b, 0xc6, 0x73, 0x54, 0xf0, 0x86, 0x3d,
-	0x32, 0xd8, 0x7b, 0x3c, 0x85, 0x82, 0x63, 0x9c, 0x50, 0xb4, 0x7a, 0x6a, 0xd0, 0x3d, 0x03, 0xe5,
-	0x38, 0x47, 0x54, 0x71, 0xf2, 0xaa, 0xa0, 0xdd, 0xf7, 0x8e, 0xbb, 0x71, 0xa5, 0xc6, 0x8c, 0x39,
-	0xe4, 0xc8, 0x3b, 0x77, 0x1d, 0x55, 0xce, 0xb7, 0x07, 0xf6, 0xb0, 0x77, 0xba, 0x03, 0x5a, 0xc7,
-	0x06, 0x63, 0x09, 0x8e, 0xba, 0xb3, 0xcf, 0x6d, 0xeb, 0xa6, 0x89, 0x79, 0x91, 0xeb, 0x08, 0x28,
-	0x8a, 0xfd, 0x7f, 0x52, 0x30, 0x34, 0x05, 0x46, 0x65, 0xa0, 0xbf, 0x7a, 0xe9, 0x51, 0x69, 0xef,
-	0x52, 0x79, 0x92, 0xd8, 0xef, 0x48, 0xcf, 0xbe, 0xe9, 0x59, 0xcf, 0xd3, 0x2a, 0x49, 0x62, 0x6f,
-	0xec, 0xf6, 0xd2, 0x2a, 0xa1, 0x30, 0x7d, 0x42, 0x34, 0x8a, 0xfd, 0xee, 0x9f, 0x1a, 0xe9, 0x0a,
-	0xef, 0x5a, 0x33, 0x26, 0xb1, 0xff, 0xff, 0xf7, 0xdd, 0xf4, 0xbc, 0xd0, 0xad, 0xff, 0x31, 0xf3,
-	0x9d, 0x41, 0xe7, 0xa7, 0xce, 0xf8, 0xe6, 0x17, 0x2b, 0x7a, 0xa9, 0xd3, 0xf2, 0xa3, 0x68, 0xb6,
-	0x08, 0xec, 0xf9, 0x22, 0xb0, 0xbf, 0x16, 0x81, 0xfd, 0x56, 0x07, 0xd6, 0xbc, 0x0e, 0xac, 0x8f,
-	0x3a, 0xb0, 0x6e, 0x4f, 0x32, 0xcc, 0x1f, 0xaa, 0x09, 0x48, 0x49, 0x1e, 0x1a, 0x8b, 0x31, 0x35,
-	0x37, 0x8e, 0xbf, 0x96, 0x88, 0x4d, 0x1c, 0xb9, 0x1d, 0x67, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xeb, 0x1a, 0x89, 0x93, 0x1d, 0x03, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0xcf, 0xd2, 0x30,
+	0x18, 0xc7, 0x37, 0xdf, 0xf7, 0x9d, 0x66, 0x78, 0x6a, 0xd0, 0x54, 0x0e, 0x13, 0x35, 0x2a, 0x1a,
+	0xb2, 0x25, 0xfa, 0x01, 0x8c, 0x90, 0xcc, 0x8b, 0x46, 0xe2, 0x76, 0xf2, 0x42, 0xca, 0x28, 0x63,
+	0xba, 0xad, 0x4b, 0xdb, 0x11, 0xfc, 0x16, 0x7e, 0x04, 0x3f, 0x0e, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
+	0x2f, 0x62, 0xd6, 0x16, 0x68, 0xd5, 0x61, 0x7c, 0x4f, 0x6b, 0xd3, 0xdf, 0xff, 0xf7, 0xb4, 0x4f,
+	0x9e, 0xb9, 0x4f, 0x73, 0xb4, 0x42, 0x25, 0xe6, 0x41, 0xf3, 0x0d, 0x58, 0x3d, 0x63, 0x09, 0xcd,
+	0x2a, 0x9e, 0x91, 0x32, 0x48, 0x71, 0x89, 0x59, 0xc6, 0xfc, 0x8a, 0x12, 0x4e, 0xc0, 0x3d, 0x05,
+	0xfa, 0xcd, 0xd7, 0xd7, 0xc1, 0x5e, 0x37, 0x25, 0x29, 0x11, 0x54, 0xd0, 0xac, 0x64, 0xa0, 0xf7,
+	0xa4, 0xdd, 0x5c, 0x21, 0x8a, 0x0a, 0x25, 0xee, 0x3d, 0x6f, 0xe7, 0xd0, 0xfc, 0x53, 0xcd, 0x78,
+	0x81, 0x4b, 0xfe, 0x6f, 0x36, 0xa9, 0xa7, 0x9c, 0xa2, 0xe4, 0x33, 0xa6, 0x8a, 0x1d, 0xb6, 0xb3,
+	0xfa, 0x46, 0xd1, 0xcf, 0x0c, 0x7a, 0x91, 0xad, 0x51, 0x73, 0xc8, 0x38, 0xa1, 0xf8, 0xb8, 0x53,
+	0xe8, 0x23, 0x03, 0xe5, 0x59, 0x81, 0xa9, 0xe4, 0xc4, 0x52, 0x42, 0x0f, 0xbf, 0x5d, 0xb9, 0xb7,
+	0xdf, 0xc8, 0x06, 0x46, 0x1c, 0x71, 0x0c, 0x5e, 0xb9, 0x8e, 0x7c, 0x36, 0xb4, 0xfb, 0xf6, 0xa0,
+	0xf3, 0xe2, 0x81, 0xdf, 0xda, 0x50, 0x7f, 0x22, 0xc0, 0xd1, 0xe5, 0xe6, 0xc7, 0x7d, 0xeb, 0x83,
+	0x8a, 0x81, 0xd0, 0x75, 0x1a, 0x28, 0x8c, 0xe0, 0x0d, 0x21, 0x18, 0x98, 0x02, 0xe3, 0xca, 0xbe,
+	0x5e, 0xfa, 0xe0, 0x91, 0x69, 0x30, 0x96, 0x9e, 0x38, 0x82, 0x17, 0xc2, 0xf3, 0xd8, 0xf4, 0x9c,
+	0xde, 0xd3, 0x2a, 0x89, 0x23, 0x30, 0x71, 0x3b, 0x49, 0x1d, 0xcb, 0x7e, 0x87, 0x11, 0xbc, 0xbc,
+	0xd6, 0x8d, 0x74, 0x05, 0x78, 0xa7, 0x19, 0xe3, 0x08, 0x5e, 0xfd, 0xff, 0xdd, 0xf4, 0x7c, 0xa3,
+	0x3b, 0x4d, 0x0f, 0x83, 0x4e, 0xff, 0xe2, 0x4f, 0x9d, 0xd1, 0xf3, 0xd7, 0x47, 0xfa, 0xa0, 0xd3,
+	0xf2, 0x60, 0xee, 0x76, 0x73, 0xc4, 0xf1, 0x94, 0xac, 0x30, 0xad, 0x19, 0x9e, 0x26, 0x4b, 0x44,
+	0x53, 0xcc, 0xe0, 0x4d, 0xe1, 0x1d, 0x9e, 0xf1, 0xbe, 0x45, 0x1c, 0xbf, 0x97, 0xa9, 0xb1, 0x08,
+	0x29, 0x3d, 0xc8, 0x7f, 0x3f, 0x60, 0x20, 0x77, 0xef, 0xea, 0xd9, 0x66, 0xa0, 0x4b, 0xb6, 0xc0,
+	0x94, 0xc1, 0x5b, 0xa2, 0x4e, 0x70, 0xa6, 0x4e, 0xa4, 0x6d, 0x62, 0x95, 0x53, 0xa5, 0xee, 0xb0,
+	0xbf, 0x9c, 0xb1, 0x51, 0xb8, 0xd9, 0x79, 0xf6, 0x76, 0xe7, 0xd9, 0x3f, 0x77, 0x9e, 0xfd, 0x75,
+	0xef, 0x59, 0xdb, 0xbd, 0x67, 0x7d, 0xdf, 0x7b, 0xd6, 0xc7, 0x61, 0x9a, 0xf1, 0x65, 0x3d, 0xf3,
+	0x13, 0x52, 0x04, 0xc6, 0xb0, 0xaf, 0xcd, 0xff, 0x88, 0x7f, 0xa9, 0x30, 0x9b, 0x39, 0x62, 0xe2,
+	0x5f, 0xfe, 0x1a, 0x00, 0x7c, 0x4d, 0x41, 0x4c, 0x4b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +185,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SubscriptionTransfers) > 0 {
+		for iNdEx := len(m.SubscriptionTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SubscriptionTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LateOveruseCharges) > 0 {
+		for iNdEx := len(m.LateOveruseCharges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LateOveruseCharges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Adjustments) > 0 {
 		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -264,6 +313,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LateOveruseCharges) > 0 {
+		for _, e := range m.LateOveruseCharges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SubscriptionTransfers) > 0 {
+		for _, e := range m.SubscriptionTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +562,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateOveruseCharges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LateOveruseCharges = append(m.LateOveruseCharges, LateOveruseCharge{})
+			if err := m.LateOveruseCharges[len(m.LateOveruseCharges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionTransfers = append(m.SubscriptionTransfers, SubscriptionTransfer{})
+			if err := m.SubscriptionTransfers[len(m.SubscriptionTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,13 @@
+package types
+
+import "strconv"
+
+const (
+	// LateOveruseChargeKeyPrefix is the prefix to retrieve all LateOveruseCharge
+	LateOveruseChargeKeyPrefix = "LateOveruseCharge/value/"
+)
+
+// LateOveruseChargeKey encodes a key using the subscription's consumer address and the block of the subscription
+func LateOveruseChargeKey(consumer string, block uint64) []byte {
+	return []byte(consumer + " " + strconv.FormatUint(block, 10))
+}
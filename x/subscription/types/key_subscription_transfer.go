@@ -0,0 +1,11 @@
+package types
+
+const (
+	// SubscriptionTransferKeyPrefix is the prefix to retrieve all SubscriptionTransfer
+	SubscriptionTransferKeyPrefix = "SubscriptionTransfer/value/"
+)
+
+// SubscriptionTransferKey encodes a key using the old consumer address of a transferred subscription
+func SubscriptionTransferKey(consumer string) []byte {
+	return []byte(consumer)
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgTopUpCu = "top_up_cu"
+
+var _ sdk.Msg = &MsgTopUpCu{}
+
+func NewMsgTopUpCu(creator, consumer string, cu uint64) *MsgTopUpCu {
+	return &MsgTopUpCu{
+		Creator:  creator,
+		Consumer: consumer,
+		Cu:       cu,
+	}
+}
+
+func (msg *MsgTopUpCu) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgTopUpCu) Type() string {
+	return TypeMsgTopUpCu
+}
+
+func (msg *MsgTopUpCu) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgTopUpCu) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgTopUpCu) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Consumer)
+	if err != nil {
+		return sdkerrors.Wrapf(legacyerrors.ErrInvalidAddress, "invalid consumer address (%s)", err)
+	}
+
+	if msg.Cu == 0 {
+		return sdkerrors.Wrapf(ErrInvalidParameter, "top-up CU must be positive")
+	}
+
+	return nil
+}
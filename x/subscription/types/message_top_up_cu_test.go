@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTopUpCu_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTopUpCu
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgTopUpCu{
+				Creator:  "invalid_address",
+				Consumer: sample.AccAddress(),
+				Cu:       100,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "consumer invalid address",
+			msg: MsgTopUpCu{
+				Creator:  sample.AccAddress(),
+				Consumer: "invalid_address",
+				Cu:       100,
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "zero cu",
+			msg: MsgTopUpCu{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+			},
+			err: ErrInvalidParameter,
+		},
+		{
+			name: "valid",
+			msg: MsgTopUpCu{
+				Creator:  sample.AccAddress(),
+				Consumer: sample.AccAddress(),
+				Cu:       100,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	legacyerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/lavanet/lava/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferSubscription_ValidateBasic(t *testing.T) {
+	addr := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferSubscription
+		err  error
+	}{
+		{
+			name: "creator invalid address",
+			msg: MsgTransferSubscription{
+				Creator:     "invalid_address",
+				NewConsumer: sample.AccAddress(),
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "new consumer invalid address",
+			msg: MsgTransferSubscription{
+				Creator:     sample.AccAddress(),
+				NewConsumer: "invalid_address",
+			},
+			err: legacyerrors.ErrInvalidAddress,
+		},
+		{
+			name: "transfer to self",
+			msg: MsgTransferSubscription{
+				Creator:     addr,
+				NewConsumer: addr,
+			},
+			err: ErrInvalidParameter,
+		},
+		{
+			name: "valid",
+			msg: MsgTransferSubscription{
+				Creator:     sample.AccAddress(),
+				NewConsumer: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,34 +1,50 @@
 package types
 
 import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyTopUpPriceFactor             = []byte("TopUpPriceFactor")
+	DefaultTopUpPriceFactor sdk.Dec = sdk.NewDecWithPrec(12, 1) // 1.2
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(topUpPriceFactor sdk.Dec) Params {
+	return Params{
+		TopUpPriceFactor: topUpPriceFactor,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(DefaultTopUpPriceFactor)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTopUpPriceFactor, &p.TopUpPriceFactor, validatePositiveDec),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validatePositiveDec(p.TopUpPriceFactor); err != nil {
+		return fmt.Errorf("invalid TopUpPriceFactor. Error: %s", err.Error())
+	}
+
 	return nil
 }
 
@@ -37,3 +53,16 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validatePositiveDec(v interface{}) error {
+	param, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if param.IsNil() || !param.IsPositive() {
+		return fmt.Errorf("dec parameter must be positive")
+	}
+
+	return nil
+}
@@ -159,7 +159,8 @@ func (m *QueryCurrentRequest) GetConsumer() string {
 }
 
 type QueryCurrentResponse struct {
-	Sub *Subscription `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
+	Sub            *Subscription `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
+	AccruedOveruse types.Coin    `protobuf:"bytes,2,opt,name=accrued_overuse,json=accruedOveruse,proto3" json:"accrued_overuse"`
 }
 
 func (m *QueryCurrentResponse) Reset()         { *m = QueryCurrentResponse{} }
@@ -202,6 +203,13 @@ func (m *QueryCurrentResponse) GetSub() *Subscription {
 	return nil
 }
 
+func (m *QueryCurrentResponse) GetAccruedOveruse() types.Coin {
+	if m != nil {
+		return m.AccruedOveruse
+	}
+	return types.Coin{}
+}
+
 type QueryListProjectsRequest struct {
 	Subscription string `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
 }
@@ -383,6 +391,7 @@ type ListInfoStruct struct {
 	AutoRenewalNextPlan string              `protobuf:"bytes,11,opt,name=auto_renewal_next_plan,json=autoRenewalNextPlan,proto3" json:"auto_renewal_next_plan,omitempty"`
 	FutureSubscription  *FutureSubscription `protobuf:"bytes,12,opt,name=future_subscription,json=futureSubscription,proto3" json:"future_subscription,omitempty"`
 	Credit              *types.Coin         `protobuf:"bytes,13,opt,name=credit,proto3" json:"credit,omitempty"`
+	MonthTopUpCu        uint64              `protobuf:"varint,14,opt,name=month_top_up_cu,json=monthTopUpCu,proto3" json:"month_top_up_cu,omitempty"`
 }
 
 func (m *ListInfoStruct) Reset()         { *m = ListInfoStruct{} }
@@ -502,6 +511,13 @@ func (m *ListInfoStruct) GetCredit() *types.Coin {
 	return nil
 }
 
+func (m *ListInfoStruct) GetMonthTopUpCu() uint64 {
+	if m != nil {
+		return m.MonthTopUpCu
+	}
+	return 0
+}
+
 type QueryNextToMonthExpiryRequest struct {
 }
 
@@ -654,64 +670,67 @@ func init() {
 }
 
 var fileDescriptor_e870698c9d8ccc09 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x26, 0xae, 0xe3, 0x3c, 0x3b, 0x69, 0x3b, 0x89, 0xd0, 0xc6, 0x02, 0x27, 0xd9, 0x52,
-	0xd2, 0x96, 0x74, 0x57, 0x4e, 0x40, 0xa1, 0x17, 0x2a, 0x25, 0xa2, 0x12, 0x28, 0xa0, 0xe0, 0x46,
-	0x45, 0xe2, 0xc0, 0x6a, 0xbd, 0x1d, 0x3b, 0x8b, 0xec, 0x9d, 0xed, 0xfc, 0x28, 0x8e, 0xaa, 0x5e,
-	0x38, 0xf6, 0x84, 0xe0, 0x2f, 0xe0, 0xdf, 0xe0, 0xc6, 0xad, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84,
-	0x12, 0xfe, 0x10, 0x34, 0x6f, 0x67, 0xcd, 0x6e, 0x53, 0xaf, 0x0d, 0x27, 0xef, 0x7c, 0xf3, 0xbd,
-	0xef, 0x7d, 0xf3, 0xe6, 0xcd, 0x93, 0xe1, 0xe6, 0x20, 0x78, 0x1a, 0xc4, 0x54, 0x7a, 0xfa, 0xd7,
-	0x13, 0xaa, 0x2b, 0x42, 0x1e, 0x25, 0x32, 0x62, 0xb1, 0xf7, 0x44, 0x51, 0x7e, 0xe6, 0x26, 0x9c,
-	0x49, 0x46, 0xd6, 0x0d, 0xcd, 0xd5, 0xbf, 0x6e, 0x9e, 0xd6, 0x5c, 0xeb, 0xb3, 0x3e, 0x43, 0x96,
-	0xa7, 0xbf, 0xd2, 0x80, 0xe6, 0xdb, 0x7d, 0xc6, 0xfa, 0x03, 0xea, 0x05, 0x49, 0xe4, 0x05, 0x71,
-	0xcc, 0x64, 0xa0, 0xc9, 0xc2, 0xec, 0xde, 0x09, 0x99, 0x18, 0x32, 0xe1, 0x75, 0x03, 0x41, 0xd3,
-	0x3c, 0xde, 0xd3, 0x76, 0x97, 0xca, 0xa0, 0xed, 0x25, 0x41, 0x3f, 0x8a, 0x91, 0x6c, 0xb8, 0xef,
-	0x4d, 0x76, 0x98, 0x04, 0x3c, 0x18, 0x66, 0x9a, 0xad, 0xbc, 0x66, 0xa6, 0x16, 0xb2, 0x28, 0xd3,
-	0xd9, 0x99, 0xac, 0x93, 0x5f, 0xa4, 0x6c, 0x67, 0x0d, 0xc8, 0x97, 0xda, 0xd7, 0x31, 0xa6, 0xe8,
-	0xd0, 0x27, 0x8a, 0x0a, 0xe9, 0x3c, 0x82, 0xd5, 0x02, 0x2a, 0x12, 0x16, 0x0b, 0x4a, 0xee, 0x43,
-	0x35, 0xb5, 0x62, 0x5b, 0x9b, 0xd6, 0xad, 0xfa, 0xee, 0x96, 0x3b, 0xb1, 0x5c, 0x6e, 0x1a, 0x7a,
-	0x50, 0x79, 0xf9, 0xe7, 0xc6, 0x5c, 0xc7, 0x84, 0x39, 0x6d, 0xa3, 0x7b, 0xa8, 0x38, 0xa7, 0xb1,
-	0x34, 0xe9, 0x48, 0x13, 0x6a, 0x21, 0x8b, 0x85, 0x1a, 0x52, 0x8e, 0xca, 0x4b, 0x9d, 0xf1, 0xda,
-	0xf9, 0x0a, 0xd6, 0x8a, 0x21, 0x63, 0x2f, 0x0b, 0x42, 0x75, 0x8d, 0x91, 0xed, 0x12, 0x23, 0x0f,
-	0x73, 0x0b, 0xb4, 0x63, 0x75, 0x74, 0xa4, 0xf3, 0x31, 0xd8, 0x28, 0x7c, 0x14, 0x09, 0x79, 0xcc,
-	0xd9, 0xb7, 0x34, 0x94, 0xd9, 0xf9, 0x89, 0x03, 0x8d, 0xbc, 0x86, 0x31, 0x55, 0xc0, 0x9c, 0x7d,
-	0x58, 0x7f, 0x43, 0xbc, 0x71, 0xd7, 0x84, 0x5a, 0x62, 0x30, 0xdb, 0xda, 0x5c, 0xd0, 0x27, 0xca,
-	0xd6, 0x0e, 0x81, 0x6b, 0xe3, 0xc0, 0xac, 0xe0, 0x01, 0x5c, 0xcf, 0x61, 0x46, 0xe4, 0x08, 0x96,
-	0x74, 0x46, 0x3f, 0x8a, 0x7b, 0x0c, 0x55, 0xea, 0xbb, 0xb7, 0x4b, 0x0e, 0xaa, 0x63, 0x3f, 0x8d,
-	0x7b, 0xec, 0xa1, 0xe4, 0x2a, 0x94, 0xa6, 0xf2, 0x35, 0x4d, 0xd1, 0xa8, 0xf3, 0xa2, 0x02, 0x2b,
-	0x45, 0x4a, 0x59, 0xdd, 0x09, 0x81, 0x4a, 0x32, 0x08, 0x62, 0x7b, 0x1e, 0x71, 0xfc, 0x26, 0xdb,
-	0x70, 0xf5, 0xb1, 0xe2, 0xd8, 0xb4, 0x7e, 0x97, 0xa9, 0xfe, 0xa9, 0xb4, 0x17, 0x36, 0xad, 0x5b,
-	0x95, 0xce, 0x4a, 0x06, 0x1f, 0x20, 0x4a, 0x6e, 0xc0, 0xf2, 0x98, 0x38, 0xa0, 0x3d, 0x69, 0x57,
-	0x90, 0xd6, 0xc8, 0xc0, 0x23, 0xda, 0x93, 0x64, 0x0b, 0x1a, 0x43, 0x16, 0xcb, 0x53, 0x9f, 0x8e,
-	0x92, 0x88, 0x9f, 0xd9, 0x57, 0x90, 0x53, 0x47, 0xec, 0x13, 0x84, 0xc8, 0xbb, 0xb0, 0x92, 0x52,
-	0x42, 0xe5, 0x4b, 0x26, 0x83, 0x81, 0x5d, 0x4d, 0x85, 0x10, 0x3d, 0x54, 0x27, 0x1a, 0x23, 0x0e,
-	0x2c, 0x8f, 0x59, 0x98, 0x6d, 0x31, 0xa7, 0x74, 0xa8, 0x30, 0x99, 0x0d, 0x8b, 0xe1, 0x40, 0x09,
-	0x49, 0xb9, 0x5d, 0xc3, 0x13, 0x65, 0x4b, 0x72, 0x13, 0xc6, 0xee, 0x4d, 0x8e, 0x25, 0x0c, 0x1f,
-	0x9f, 0x20, 0x4d, 0xb2, 0x07, 0x6f, 0x05, 0x4a, 0x32, 0x9f, 0xd3, 0x98, 0x7e, 0x17, 0x0c, 0xfc,
-	0x98, 0x8e, 0xa4, 0x8f, 0x15, 0xaa, 0xa3, 0xde, 0xaa, 0xde, 0xed, 0xa4, 0x9b, 0x5f, 0xd0, 0x91,
-	0x3c, 0xd6, 0x05, 0xfb, 0x06, 0x56, 0x7b, 0x4a, 0x2a, 0x4e, 0xfd, 0x42, 0x3b, 0x35, 0xb0, 0x69,
-	0xef, 0x96, 0xdc, 0xe5, 0x03, 0x8c, 0xca, 0xb7, 0x6e, 0x87, 0xf4, 0x2e, 0x61, 0xa4, 0x0d, 0xd5,
-	0x90, 0xd3, 0xc7, 0x91, 0xb4, 0x97, 0x51, 0x72, 0xdd, 0x4d, 0x87, 0x83, 0xab, 0x87, 0x83, 0x6b,
-	0x86, 0x83, 0x7b, 0xc8, 0xa2, 0xb8, 0x63, 0x88, 0x9f, 0x55, 0x6a, 0x70, 0xad, 0xee, 0x6c, 0xc0,
-	0x3b, 0xd8, 0x6f, 0xda, 0xe9, 0x09, 0xfb, 0xfc, 0xdf, 0x92, 0x67, 0x0d, 0x79, 0x0c, 0x57, 0x4f,
-	0xa2, 0x21, 0xe5, 0x29, 0xaa, 0x7b, 0xa6, 0xb4, 0x5b, 0x5e, 0xbf, 0xcb, 0xf9, 0x4b, 0x77, 0xe9,
-	0x8c, 0xa0, 0x35, 0x29, 0xa5, 0xe9, 0xf7, 0x47, 0xb0, 0x9c, 0x2f, 0x82, 0x30, 0x3d, 0x7f, 0xa7,
-	0xa4, 0x4e, 0xaf, 0x79, 0x34, 0x4d, 0x5f, 0x94, 0xd9, 0xfd, 0xad, 0x0a, 0x57, 0x30, 0x35, 0xf9,
-	0xd1, 0x82, 0x6a, 0x3a, 0x98, 0x48, 0x59, 0xf5, 0x2f, 0x4f, 0xc4, 0xa6, 0x3b, 0x2b, 0x3d, 0x3d,
-	0x8b, 0x73, 0xfb, 0xfb, 0xdf, 0xff, 0xfe, 0x69, 0xfe, 0x06, 0xd9, 0xf2, 0xa6, 0x8d, 0x75, 0xf2,
-	0xb3, 0x05, 0x8b, 0x66, 0xba, 0x91, 0xa9, 0x69, 0x8a, 0x93, 0xb3, 0xe9, 0xcd, 0xcc, 0x37, 0xbe,
-	0x3e, 0x44, 0x5f, 0x1e, 0xb9, 0x5b, 0xe2, 0x2b, 0x4c, 0x63, 0xbc, 0x67, 0xd9, 0xf5, 0x3e, 0x27,
-	0xbf, 0x58, 0xd0, 0xc8, 0x0f, 0x3a, 0xb2, 0x37, 0x2d, 0xf1, 0x1b, 0xc6, 0x6a, 0xf3, 0x83, 0xff,
-	0x16, 0x64, 0x2c, 0xdf, 0x47, 0xcb, 0xf7, 0xc8, 0x7e, 0x89, 0xe5, 0x41, 0x24, 0xa4, 0x9f, 0x4d,
-	0x58, 0xef, 0x59, 0x7e, 0xef, 0x39, 0x79, 0x61, 0x41, 0x45, 0x2b, 0x93, 0xf7, 0x67, 0xc9, 0x9f,
-	0x99, 0xdd, 0x99, 0x8d, 0x6c, 0x4c, 0x6e, 0xa3, 0xc9, 0x2d, 0xb2, 0x31, 0xc5, 0x24, 0xf9, 0xd5,
-	0x82, 0xeb, 0x97, 0x9e, 0x00, 0xf9, 0x68, 0x5a, 0xb2, 0x49, 0x0f, 0xb5, 0x79, 0xef, 0x7f, 0x44,
-	0x1a, 0xcf, 0xfb, 0xe8, 0xb9, 0x4d, 0xbc, 0x12, 0xcf, 0x38, 0xe6, 0x24, 0xf3, 0xf3, 0xaf, 0xfb,
-	0xe0, 0xc1, 0xcb, 0xf3, 0x96, 0xf5, 0xea, 0xbc, 0x65, 0xfd, 0x75, 0xde, 0xb2, 0x7e, 0xb8, 0x68,
-	0xcd, 0xbd, 0xba, 0x68, 0xcd, 0xfd, 0x71, 0xd1, 0x9a, 0xfb, 0x7a, 0xa7, 0x1f, 0xc9, 0x53, 0xd5,
-	0x75, 0x43, 0x36, 0x2c, 0x8a, 0x8e, 0x8a, 0xb2, 0xf2, 0x2c, 0xa1, 0xa2, 0x5b, 0xc5, 0xff, 0x20,
-	0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x07, 0x82, 0xce, 0xbb, 0x9d, 0x09, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0xae, 0xe3, 0x3c, 0x3b, 0x4e, 0x3b, 0x89, 0xd0, 0xc6, 0x02, 0x27, 0xd9, 0x12,
+	0xd2, 0x96, 0x74, 0x57, 0x4e, 0x40, 0xa1, 0x17, 0x2a, 0xc5, 0xa2, 0x02, 0x14, 0x20, 0x6c, 0x43,
+	0x0f, 0x1c, 0x58, 0xad, 0x37, 0x63, 0x67, 0xd1, 0x7a, 0x67, 0x3b, 0x7f, 0x82, 0xa3, 0xaa, 0x17,
+	0x8e, 0x9c, 0x10, 0x7c, 0x82, 0x7e, 0x04, 0xae, 0xdc, 0xb8, 0xf5, 0x84, 0x2a, 0x71, 0xe1, 0x84,
+	0x50, 0xc2, 0x07, 0x41, 0x33, 0x3b, 0x6b, 0x76, 0x9b, 0x7a, 0x1d, 0x7a, 0xf2, 0xce, 0x9b, 0xdf,
+	0xfb, 0xbd, 0xdf, 0x7b, 0x6f, 0xde, 0x4b, 0x60, 0x33, 0xf2, 0x4f, 0xfd, 0x18, 0x73, 0x47, 0xfe,
+	0x3a, 0x4c, 0xf4, 0x58, 0x40, 0xc3, 0x84, 0x87, 0x24, 0x76, 0x1e, 0x0b, 0x4c, 0xcf, 0xec, 0x84,
+	0x12, 0x4e, 0xd0, 0xaa, 0x86, 0xd9, 0xf2, 0xd7, 0xce, 0xc3, 0x5a, 0x2b, 0x03, 0x32, 0x20, 0x0a,
+	0xe5, 0xc8, 0xaf, 0xd4, 0xa1, 0xf5, 0xe6, 0x80, 0x90, 0x41, 0x84, 0x1d, 0x3f, 0x09, 0x1d, 0x3f,
+	0x8e, 0x09, 0xf7, 0x25, 0x98, 0xe9, 0xdb, 0x3b, 0x01, 0x61, 0x43, 0xc2, 0x9c, 0x9e, 0xcf, 0x70,
+	0x1a, 0xc7, 0x39, 0xed, 0xf4, 0x30, 0xf7, 0x3b, 0x4e, 0xe2, 0x0f, 0xc2, 0x58, 0x81, 0x35, 0xf6,
+	0x9d, 0xc9, 0x0a, 0x13, 0x9f, 0xfa, 0xc3, 0x8c, 0xb3, 0x9d, 0xe7, 0xcc, 0xd8, 0x02, 0x12, 0x66,
+	0x3c, 0xdb, 0x93, 0x79, 0xf2, 0x87, 0x14, 0x6d, 0xad, 0x00, 0xfa, 0x52, 0xea, 0x3a, 0x54, 0x21,
+	0x5c, 0xfc, 0x58, 0x60, 0xc6, 0xad, 0x47, 0xb0, 0x5c, 0xb0, 0xb2, 0x84, 0xc4, 0x0c, 0xa3, 0xfb,
+	0x50, 0x4d, 0xa5, 0x98, 0xc6, 0xba, 0x71, 0xab, 0xbe, 0xb3, 0x61, 0x4f, 0x2c, 0x97, 0x9d, 0xba,
+	0xee, 0x57, 0x9e, 0xff, 0xb5, 0x36, 0xe3, 0x6a, 0x37, 0xab, 0xa3, 0x79, 0xbb, 0x82, 0x52, 0x1c,
+	0x73, 0x1d, 0x0e, 0xb5, 0xa0, 0x16, 0x90, 0x98, 0x89, 0x21, 0xa6, 0x8a, 0x79, 0xc1, 0x1d, 0x9f,
+	0xad, 0x67, 0x06, 0xac, 0x14, 0x7d, 0xc6, 0x62, 0xe6, 0x98, 0xe8, 0x69, 0x25, 0x5b, 0x25, 0x4a,
+	0x1e, 0xe6, 0x0e, 0x4a, 0x8f, 0xe1, 0x4a, 0x4f, 0xf4, 0x31, 0x2c, 0xf9, 0x41, 0x40, 0x05, 0x3e,
+	0xf6, 0xc8, 0x29, 0xa6, 0x82, 0x61, 0x73, 0x56, 0x91, 0xad, 0xda, 0x69, 0x89, 0x6d, 0x59, 0x62,
+	0x5b, 0x97, 0xd8, 0xee, 0x92, 0x30, 0xd6, 0xe9, 0x34, 0xb5, 0xdf, 0x17, 0xa9, 0x9b, 0xf5, 0x21,
+	0x98, 0x4a, 0xe2, 0x41, 0xc8, 0xf8, 0x21, 0x25, 0xdf, 0xe2, 0x80, 0x67, 0xa5, 0x44, 0x16, 0x34,
+	0xf2, 0x6a, 0x74, 0x7e, 0x05, 0x9b, 0xb5, 0x07, 0xab, 0xaf, 0xf0, 0xd7, 0x79, 0xb6, 0xa0, 0x96,
+	0x68, 0x9b, 0x69, 0xac, 0xcf, 0xc9, 0xe2, 0x64, 0x67, 0x0b, 0xc1, 0xf5, 0xb1, 0x63, 0xd6, 0x3b,
+	0x1f, 0x6e, 0xe4, 0x6c, 0x9a, 0xe4, 0x00, 0x16, 0x64, 0x44, 0x2f, 0x8c, 0xfb, 0x44, 0xb1, 0xd4,
+	0x77, 0x6e, 0x97, 0x94, 0x4c, 0xfa, 0x7e, 0x12, 0xf7, 0xc9, 0x43, 0x4e, 0x45, 0xc0, 0x75, 0xd6,
+	0x35, 0x09, 0x91, 0x56, 0xeb, 0x97, 0x0a, 0x34, 0x8b, 0x90, 0xb2, 0x16, 0x22, 0x04, 0x95, 0x24,
+	0xf2, 0x63, 0x55, 0xdd, 0x05, 0x57, 0x7d, 0xa3, 0x2d, 0x58, 0x3a, 0x16, 0x54, 0xbd, 0x7f, 0xaf,
+	0x47, 0xc4, 0xe0, 0x84, 0x9b, 0x73, 0xeb, 0xc6, 0xad, 0x8a, 0xdb, 0xcc, 0xcc, 0xfb, 0xca, 0x8a,
+	0x6e, 0xc2, 0xe2, 0x18, 0x18, 0xe1, 0x3e, 0x37, 0x2b, 0x0a, 0xd6, 0xc8, 0x8c, 0x07, 0xb8, 0xcf,
+	0xd1, 0x06, 0x34, 0x86, 0x24, 0xe6, 0x27, 0x1e, 0x1e, 0x25, 0x21, 0x3d, 0x33, 0xaf, 0x29, 0x4c,
+	0x5d, 0xd9, 0x3e, 0x52, 0x26, 0xf4, 0x36, 0x34, 0x53, 0x48, 0x20, 0x3c, 0x4e, 0xb8, 0x1f, 0x99,
+	0xd5, 0x94, 0x48, 0x59, 0xbb, 0xe2, 0x48, 0xda, 0x90, 0x05, 0x8b, 0x63, 0x94, 0x8a, 0x36, 0x9f,
+	0x63, 0xea, 0x0a, 0x15, 0xcc, 0x84, 0xf9, 0x20, 0x12, 0x8c, 0x63, 0x6a, 0xd6, 0x54, 0x46, 0xd9,
+	0x11, 0x6d, 0xc2, 0x58, 0xbd, 0x8e, 0xb1, 0xa0, 0xdc, 0xc7, 0x19, 0xa4, 0x41, 0x76, 0xe1, 0x0d,
+	0x5f, 0x70, 0xe2, 0x51, 0x1c, 0xe3, 0xef, 0xfc, 0xc8, 0x8b, 0xf1, 0x88, 0x7b, 0xaa, 0x42, 0x75,
+	0xc5, 0xb7, 0x2c, 0x6f, 0xdd, 0xf4, 0xf2, 0x73, 0x3c, 0xe2, 0x87, 0xb2, 0x60, 0xdf, 0xc0, 0x72,
+	0x5f, 0x70, 0x41, 0xb1, 0x57, 0x78, 0x4e, 0x0d, 0xf5, 0x62, 0xef, 0x96, 0xf4, 0xf2, 0x81, 0xf2,
+	0xca, 0x0f, 0x81, 0x8b, 0xfa, 0x97, 0x6c, 0xa8, 0x03, 0xd5, 0x80, 0xe2, 0xe3, 0x90, 0x9b, 0x8b,
+	0x53, 0x86, 0xc0, 0xd5, 0x40, 0xb4, 0x09, 0x4b, 0x69, 0xb1, 0x38, 0x49, 0x3c, 0x91, 0x78, 0x81,
+	0x30, 0x9b, 0xb9, 0x9a, 0x1e, 0x91, 0xe4, 0xab, 0xa4, 0x2b, 0x3e, 0xad, 0xd4, 0xe0, 0x7a, 0xdd,
+	0x5a, 0x83, 0xb7, 0xd4, 0xb3, 0x94, 0x09, 0x1d, 0x91, 0xcf, 0xfe, 0xeb, 0x4c, 0xf6, 0x6e, 0x0f,
+	0x61, 0xe9, 0x28, 0x1c, 0x62, 0x9a, 0x5a, 0xe5, 0xd3, 0x2a, 0x7d, 0x54, 0x2f, 0xb7, 0x7c, 0xf6,
+	0x52, 0xcb, 0xad, 0x11, 0xb4, 0x27, 0x85, 0xd4, 0x63, 0xf1, 0x08, 0x16, 0xf3, 0xb5, 0x62, 0x7a,
+	0x34, 0xee, 0x94, 0x94, 0xf3, 0x25, 0x8d, 0x7a, 0x36, 0x8a, 0x34, 0x3b, 0xbf, 0x57, 0xe1, 0x9a,
+	0x0a, 0x8d, 0x7e, 0x32, 0xa0, 0x9a, 0xae, 0x42, 0x54, 0xd6, 0xa4, 0xcb, 0x3b, 0xb8, 0x65, 0x5f,
+	0x15, 0x9e, 0xe6, 0x62, 0xdd, 0xfe, 0xfe, 0x8f, 0x7f, 0x7e, 0x9e, 0xbd, 0x89, 0x36, 0x9c, 0x69,
+	0x7f, 0x48, 0xd0, 0x33, 0x03, 0xe6, 0xf5, 0x3a, 0x45, 0x53, 0xc3, 0x14, 0x77, 0x75, 0xcb, 0xb9,
+	0x32, 0x5e, 0xeb, 0x7a, 0x5f, 0xe9, 0x72, 0xd0, 0xdd, 0x12, 0x5d, 0x41, 0xea, 0xe3, 0x3c, 0xc9,
+	0xda, 0xfb, 0x14, 0xfd, 0x6a, 0x40, 0x23, 0xbf, 0x0f, 0xd1, 0xee, 0xb4, 0xc0, 0xaf, 0xd8, 0xbe,
+	0xad, 0xf7, 0xfe, 0x9f, 0x93, 0x96, 0x7c, 0x5f, 0x49, 0xbe, 0x87, 0xf6, 0x4a, 0x24, 0x47, 0x21,
+	0xe3, 0x5e, 0xb6, 0x88, 0x9d, 0x27, 0xf9, 0xbb, 0xa7, 0xe8, 0x07, 0x03, 0x2a, 0x92, 0x19, 0xbd,
+	0x7b, 0x95, 0xf8, 0x99, 0xd8, 0xed, 0xab, 0x81, 0xb5, 0xc8, 0x2d, 0x25, 0x72, 0x03, 0xad, 0x4d,
+	0x11, 0x89, 0x7e, 0x33, 0xe0, 0xc6, 0xa5, 0x11, 0x40, 0x1f, 0x4c, 0x0b, 0x36, 0x69, 0x50, 0x5b,
+	0xf7, 0x5e, 0xc3, 0x53, 0x6b, 0xde, 0x53, 0x9a, 0x3b, 0xc8, 0x29, 0xd1, 0xac, 0xb6, 0x21, 0x27,
+	0x5e, 0x7e, 0xba, 0xf7, 0x1f, 0x3c, 0x3f, 0x6f, 0x1b, 0x2f, 0xce, 0xdb, 0xc6, 0xdf, 0xe7, 0x6d,
+	0xe3, 0xc7, 0x8b, 0xf6, 0xcc, 0x8b, 0x8b, 0xf6, 0xcc, 0x9f, 0x17, 0xed, 0x99, 0xaf, 0xb7, 0x07,
+	0x21, 0x3f, 0x11, 0x3d, 0x3b, 0x20, 0xc3, 0x22, 0xe9, 0xa8, 0x48, 0xcb, 0xcf, 0x12, 0xcc, 0x7a,
+	0x55, 0xf5, 0x5f, 0xcf, 0xee, 0xbf, 0x03, 0x00, 0x6b, 0x8a, 0x0d, 0x2c, 0x0f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1054,6 +1073,16 @@ func (m *QueryCurrentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.AccruedOveruse.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if m.Sub != nil {
 		{
 			size, err := m.Sub.MarshalToSizedBuffer(dAtA[:i])
@@ -1211,6 +1240,11 @@ func (m *ListInfoStruct) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MonthTopUpCu != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MonthTopUpCu))
+		i--
+		dAtA[i] = 0x70
+	}
 	if m.Credit != nil {
 		{
 			size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
@@ -1445,6 +1479,8 @@ func (m *QueryCurrentResponse) Size() (n int) {
 		l = m.Sub.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = m.AccruedOveruse.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1548,6 +1584,9 @@ func (m *ListInfoStruct) Size() (n int) {
 		l = m.Credit.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.MonthTopUpCu != 0 {
+		n += 1 + sovQuery(uint64(m.MonthTopUpCu))
+	}
 	return n
 }
 
@@ -1877,6 +1916,39 @@ func (m *QueryCurrentResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedOveruse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedOveruse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2539,6 +2611,25 @@ func (m *ListInfoStruct) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthTopUpCu", wireType)
+			}
+			m.MonthTopUpCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthTopUpCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
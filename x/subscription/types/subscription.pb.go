@@ -40,6 +40,10 @@ type Subscription struct {
 	FutureSubscription  *FutureSubscription `protobuf:"bytes,16,opt,name=future_subscription,json=futureSubscription,proto3" json:"future_subscription,omitempty"`
 	AutoRenewalNextPlan string              `protobuf:"bytes,17,opt,name=auto_renewal_next_plan,json=autoRenewalNextPlan,proto3" json:"auto_renewal_next_plan,omitempty"`
 	Credit              types.Coin          `protobuf:"bytes,18,opt,name=credit,proto3" json:"credit"`
+	MonthOveruseCu      uint64              `protobuf:"varint,19,opt,name=month_overuse_cu,json=monthOveruseCu,proto3" json:"month_overuse_cu,omitempty"`
+	MonthTopUpCu        uint64              `protobuf:"varint,20,opt,name=month_top_up_cu,json=monthTopUpCu,proto3" json:"month_top_up_cu,omitempty"`
+	MonthTopUpCredit    *types.Coin         `protobuf:"bytes,21,opt,name=month_top_up_credit,json=monthTopUpCredit,proto3" json:"month_top_up_credit,omitempty"`
+	TransferredTo       string              `protobuf:"bytes,22,opt,name=transferred_to,json=transferredTo,proto3" json:"transferred_to,omitempty"`
 }
 
 func (m *Subscription) Reset()         { *m = Subscription{} }
@@ -180,6 +184,87 @@ func (m *Subscription) GetCredit() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Subscription) GetMonthOveruseCu() uint64 {
+	if m != nil {
+		return m.MonthOveruseCu
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthTopUpCu() uint64 {
+	if m != nil {
+		return m.MonthTopUpCu
+	}
+	return 0
+}
+
+func (m *Subscription) GetMonthTopUpCredit() *types.Coin {
+	if m != nil {
+		return m.MonthTopUpCredit
+	}
+	return nil
+}
+
+func (m *Subscription) GetTransferredTo() string {
+	if m != nil {
+		return m.TransferredTo
+	}
+	return ""
+}
+
+// the consumer a subscription was transferred to, kept until the old consumer buys a new subscription
+type SubscriptionTransfer struct {
+	Consumer    string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	NewConsumer string `protobuf:"bytes,2,opt,name=new_consumer,json=newConsumer,proto3" json:"new_consumer,omitempty"`
+}
+
+func (m *SubscriptionTransfer) Reset()         { *m = SubscriptionTransfer{} }
+func (m *SubscriptionTransfer) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionTransfer) ProtoMessage()    {}
+func (*SubscriptionTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3bc5507ca237d79, []int{1}
+}
+func (m *SubscriptionTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscriptionTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscriptionTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscriptionTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionTransfer.Merge(m, src)
+}
+func (m *SubscriptionTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscriptionTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionTransfer proto.InternalMessageInfo
+
+func (m *SubscriptionTransfer) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *SubscriptionTransfer) GetNewConsumer() string {
+	if m != nil {
+		return m.NewConsumer
+	}
+	return ""
+}
+
 type FutureSubscription struct {
 	Creator        string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	PlanIndex      string     `protobuf:"bytes,2,opt,name=plan_index,json=planIndex,proto3" json:"plan_index,omitempty"`
@@ -192,7 +277,7 @@ func (m *FutureSubscription) Reset()         { *m = FutureSubscription{} }
 func (m *FutureSubscription) String() string { return proto.CompactTextString(m) }
 func (*FutureSubscription) ProtoMessage()    {}
 func (*FutureSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3bc5507ca237d79, []int{1}
+	return fileDescriptor_c3bc5507ca237d79, []int{2}
 }
 func (m *FutureSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -258,6 +343,7 @@ func (m *FutureSubscription) GetCredit() types.Coin {
 
 func init() {
 	proto.RegisterType((*Subscription)(nil), "lavanet.lava.subscription.Subscription")
+	proto.RegisterType((*SubscriptionTransfer)(nil), "lavanet.lava.subscription.SubscriptionTransfer")
 	proto.RegisterType((*FutureSubscription)(nil), "lavanet.lava.subscription.FutureSubscription")
 }
 
@@ -266,41 +352,48 @@ func init() {
 }
 
 var fileDescriptor_c3bc5507ca237d79 = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xb6, 0xb4, 0x6b, 0xdd, 0x9f, 0xf3, 0x26, 0xe4, 0x55, 0x22, 0x54, 0x05, 0x44, 0x85,
-	0x46, 0xa2, 0xb1, 0x03, 0xf7, 0x4e, 0x4c, 0xa2, 0x42, 0x08, 0x95, 0x9d, 0x38, 0x10, 0x39, 0xa9,
-	0xdb, 0x46, 0x24, 0x71, 0xe5, 0xd8, 0x23, 0xfb, 0x2f, 0xf8, 0xb3, 0x76, 0x42, 0x3b, 0x72, 0x42,
-	0xa8, 0xfd, 0x3b, 0x90, 0x90, 0x5f, 0xd2, 0xd0, 0x6a, 0x30, 0xb1, 0x93, 0xfd, 0xbe, 0xf7, 0x7d,
-	0x7e, 0xef, 0xd9, 0x9f, 0xd1, 0x71, 0x48, 0x2f, 0x69, 0xcc, 0xa4, 0xa3, 0x57, 0x27, 0x51, 0x5e,
-	0xe2, 0x8b, 0x60, 0x21, 0x03, 0x1e, 0x6f, 0x05, 0xf6, 0x42, 0x70, 0xc9, 0xf1, 0x51, 0xce, 0xb6,
-	0xf5, 0x6a, 0x6f, 0x12, 0xba, 0x96, 0xcf, 0x93, 0x88, 0x27, 0x8e, 0x47, 0x13, 0xe6, 0x5c, 0x9e,
-	0x78, 0x4c, 0xd2, 0x13, 0xc7, 0xe7, 0x41, 0x2e, 0xed, 0x1e, 0xce, 0xf8, 0x8c, 0xc3, 0xd6, 0xd1,
-	0xbb, 0x0c, 0xed, 0xff, 0x32, 0x51, 0xe3, 0xc3, 0xc6, 0x31, 0x98, 0xa0, 0x3d, 0x5f, 0x30, 0x2a,
-	0xb9, 0x20, 0x46, 0xcf, 0x18, 0xd4, 0xc6, 0xeb, 0x10, 0x77, 0x51, 0xd5, 0xe7, 0x71, 0xa2, 0x22,
-	0x26, 0xc8, 0x0e, 0xa4, 0x8a, 0x18, 0x1f, 0xa2, 0xb2, 0x17, 0x72, 0xff, 0x33, 0xd9, 0xed, 0x19,
-	0x03, 0x73, 0x9c, 0x05, 0xf8, 0x21, 0x42, 0x8b, 0x90, 0xc6, 0x6e, 0x10, 0x4f, 0x58, 0x4a, 0x4c,
-	0xd0, 0xd4, 0x34, 0xf2, 0x46, 0x03, 0x45, 0x3a, 0x53, 0x96, 0x41, 0x09, 0xe9, 0x21, 0xa8, 0x9f,
-	0xa1, 0xf6, 0x44, 0x09, 0xaa, 0xbb, 0x72, 0x3d, 0xae, 0x66, 0x73, 0x49, 0x2a, 0xc0, 0x69, 0xad,
-	0xe1, 0x21, 0xa0, 0xf8, 0x31, 0x6a, 0x16, 0xc4, 0x90, 0x4d, 0x25, 0xd9, 0x03, 0x5a, 0x63, 0x0d,
-	0xbe, 0x65, 0x53, 0x89, 0x9f, 0xa3, 0xfd, 0x88, 0xc7, 0x72, 0xee, 0xb2, 0x74, 0x11, 0x88, 0x2b,
-	0x57, 0x06, 0x11, 0x23, 0x55, 0x20, 0xb6, 0x21, 0xf1, 0x1a, 0xf0, 0x8b, 0x20, 0x62, 0xf8, 0x09,
-	0x6a, 0x65, 0x5c, 0x5f, 0xb9, 0x92, 0x4b, 0x1a, 0x12, 0x94, 0x9d, 0x08, 0xe8, 0x99, 0xba, 0xd0,
-	0x18, 0xee, 0xa3, 0x66, 0xc1, 0x82, 0xb2, 0x75, 0x20, 0xd5, 0x73, 0x12, 0x54, 0xd5, 0xb7, 0x19,
-	0xaa, 0x44, 0x32, 0x41, 0x9a, 0xf9, 0x6d, 0x66, 0x21, 0x7e, 0x8a, 0x8a, 0x31, 0xf2, 0x1a, 0x2d,
-	0x90, 0x17, 0xa3, 0x64, 0x45, 0x3e, 0xa1, 0x83, 0xa9, 0x92, 0x4a, 0x30, 0x77, 0xf3, 0xb1, 0x49,
-	0xa7, 0x67, 0x0c, 0xea, 0x2f, 0x5f, 0xd8, 0xff, 0xb4, 0x83, 0x7d, 0x0e, 0xaa, 0xcd, 0xa7, 0x1d,
-	0xe3, 0xe9, 0x2d, 0x0c, 0x9f, 0xa2, 0x07, 0x54, 0x49, 0xee, 0x0a, 0x16, 0xb3, 0x2f, 0x34, 0x74,
-	0x63, 0x96, 0x4a, 0x57, 0xbf, 0x01, 0xd9, 0x87, 0x7e, 0x0f, 0x74, 0x76, 0x9c, 0x25, 0xdf, 0xb1,
-	0x54, 0xbe, 0x0f, 0x69, 0x8c, 0x5f, 0xa1, 0x8a, 0x2f, 0xd8, 0x24, 0x90, 0x04, 0x43, 0x1f, 0x47,
-	0x76, 0xe6, 0x3d, 0x5b, 0x7b, 0xcf, 0xce, 0xbd, 0x67, 0x9f, 0xf1, 0x20, 0x1e, 0x9a, 0xd7, 0x3f,
-	0x1e, 0x95, 0xc6, 0x39, 0x7d, 0x64, 0x56, 0x6b, 0x1d, 0x34, 0x32, 0xab, 0x8d, 0x4e, 0x73, 0x64,
-	0x56, 0xdb, 0x9d, 0x4e, 0xff, 0x9b, 0x81, 0xf0, 0xed, 0x56, 0xef, 0x70, 0xe1, 0xb6, 0xa7, 0x76,
-	0xee, 0xf6, 0xd4, 0xee, 0x7f, 0x78, 0xca, 0xfc, 0xab, 0xa7, 0xfe, 0x8c, 0x58, 0xbe, 0xd7, 0x88,
-	0xc3, 0xf3, 0xeb, 0xa5, 0x65, 0xdc, 0x2c, 0x2d, 0xe3, 0xe7, 0xd2, 0x32, 0xbe, 0xae, 0xac, 0xd2,
-	0xcd, 0xca, 0x2a, 0x7d, 0x5f, 0x59, 0xa5, 0x8f, 0xc7, 0xb3, 0x40, 0xce, 0x95, 0x67, 0xfb, 0x3c,
-	0x72, 0xb6, 0x3e, 0x7d, 0xba, 0xfd, 0xed, 0xe5, 0xd5, 0x82, 0x25, 0x5e, 0x05, 0xfe, 0xe7, 0xe9,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x53, 0x38, 0x5f, 0x20, 0x04, 0x00, 0x00,
+	// 650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6d, 0xb6, 0xac, 0xeb, 0xdc, 0x3f, 0xcb, 0xdc, 0xfe, 0x26, 0x6f, 0xd2, 0x2f, 0x8c, 0xc2,
+	0x44, 0x85, 0x46, 0xa2, 0xb1, 0x07, 0xde, 0x5b, 0x31, 0xc1, 0x84, 0x00, 0x95, 0xee, 0x85, 0x07,
+	0xac, 0x24, 0xbd, 0xed, 0x22, 0xd2, 0x38, 0x72, 0xec, 0xad, 0xfb, 0x16, 0x7c, 0x23, 0x5e, 0xf7,
+	0x84, 0xf6, 0xc8, 0x13, 0x42, 0xdb, 0x17, 0x41, 0xb1, 0xd3, 0x92, 0x6e, 0x30, 0xe0, 0x29, 0xb9,
+	0xe7, 0x9e, 0x6b, 0x5f, 0xdf, 0xe3, 0x63, 0xb4, 0x17, 0x79, 0xa7, 0x5e, 0x0c, 0xc2, 0xcd, 0xbe,
+	0x6e, 0x2a, 0xfd, 0x34, 0xe0, 0x61, 0x22, 0x42, 0x16, 0x2f, 0x04, 0x4e, 0xc2, 0x99, 0x60, 0x78,
+	0x2b, 0x67, 0x3b, 0xd9, 0xd7, 0x29, 0x12, 0xb6, 0xed, 0x80, 0xa5, 0x13, 0x96, 0xba, 0xbe, 0x97,
+	0x82, 0x7b, 0xba, 0xef, 0x83, 0xf0, 0xf6, 0xdd, 0x80, 0x85, 0x79, 0xe9, 0x76, 0x6b, 0xcc, 0xc6,
+	0x4c, 0xfd, 0xba, 0xd9, 0x9f, 0x46, 0xdb, 0x9f, 0xcb, 0xa8, 0xf6, 0xae, 0xb0, 0x0c, 0x26, 0x68,
+	0x35, 0xe0, 0xe0, 0x09, 0xc6, 0x89, 0xb1, 0x63, 0x74, 0xd6, 0xfa, 0xb3, 0x10, 0x6f, 0xa3, 0x4a,
+	0xc0, 0xe2, 0x54, 0x4e, 0x80, 0x93, 0x25, 0x95, 0x9a, 0xc7, 0xb8, 0x85, 0x56, 0xfc, 0x88, 0x05,
+	0x1f, 0xc9, 0xf2, 0x8e, 0xd1, 0x31, 0xfb, 0x3a, 0xc0, 0xff, 0x23, 0x94, 0x44, 0x5e, 0x4c, 0xc3,
+	0x78, 0x08, 0x53, 0x62, 0xaa, 0x9a, 0xb5, 0x0c, 0x79, 0x99, 0x01, 0xf3, 0xb4, 0xae, 0x5c, 0x51,
+	0x95, 0x2a, 0xdd, 0x55, 0xd5, 0x8f, 0xd0, 0xfa, 0x50, 0x72, 0x2f, 0xeb, 0x8a, 0xfa, 0x4c, 0x8e,
+	0x4f, 0x04, 0x29, 0x2b, 0x4e, 0x63, 0x06, 0x77, 0x15, 0x8a, 0x1f, 0xa0, 0xfa, 0x9c, 0x18, 0xc1,
+	0x48, 0x90, 0x55, 0x45, 0xab, 0xcd, 0xc0, 0x57, 0x30, 0x12, 0xf8, 0x31, 0xda, 0x98, 0xb0, 0x58,
+	0x9c, 0x50, 0x98, 0x26, 0x21, 0x3f, 0xa7, 0x22, 0x9c, 0x00, 0xa9, 0x28, 0xe2, 0xba, 0x4a, 0x3c,
+	0x57, 0xf8, 0x20, 0x9c, 0x00, 0x7e, 0x88, 0x1a, 0x9a, 0x1b, 0x48, 0x2a, 0x98, 0xf0, 0x22, 0x82,
+	0xf4, 0x8a, 0x0a, 0xed, 0xc9, 0x41, 0x86, 0xe1, 0x36, 0xaa, 0xcf, 0x59, 0x6a, 0xdb, 0xaa, 0x22,
+	0x55, 0x73, 0x92, 0xda, 0x35, 0x9b, 0x66, 0x24, 0x53, 0x01, 0x9c, 0xd4, 0xf3, 0x69, 0xea, 0x10,
+	0xef, 0xa2, 0xf9, 0x31, 0xf2, 0x3d, 0x1a, 0xaa, 0x7c, 0x7e, 0x14, 0xbd, 0xc9, 0x07, 0xd4, 0x1c,
+	0x49, 0x21, 0x39, 0xd0, 0xa2, 0xd8, 0xc4, 0xda, 0x31, 0x3a, 0xd5, 0xa7, 0x4f, 0x9c, 0xdf, 0x5e,
+	0x07, 0xe7, 0x50, 0x55, 0x15, 0xa5, 0xed, 0xe3, 0xd1, 0x2d, 0x0c, 0x1f, 0xa0, 0x4d, 0x4f, 0x0a,
+	0x46, 0x39, 0xc4, 0x70, 0xe6, 0x45, 0x34, 0x86, 0xa9, 0xa0, 0x99, 0x06, 0x64, 0x43, 0xf5, 0xdb,
+	0xcc, 0xb2, 0x7d, 0x9d, 0x7c, 0x0d, 0x53, 0xf1, 0x36, 0xf2, 0x62, 0xfc, 0x0c, 0x95, 0x03, 0x0e,
+	0xc3, 0x50, 0x10, 0xac, 0xfa, 0xd8, 0x72, 0xf4, 0xdd, 0x73, 0xb2, 0xbb, 0xe7, 0xe4, 0x77, 0xcf,
+	0xe9, 0xb1, 0x30, 0xee, 0x9a, 0x17, 0xdf, 0xee, 0x95, 0xfa, 0x39, 0x1d, 0x77, 0x90, 0xa5, 0x47,
+	0xc6, 0x4e, 0x81, 0xcb, 0x14, 0x68, 0x20, 0x49, 0x53, 0x6b, 0xaa, 0xf0, 0x37, 0x1a, 0xee, 0x49,
+	0xbc, 0x8b, 0xb4, 0x2a, 0x54, 0xb0, 0x84, 0xca, 0x24, 0x23, 0xb6, 0x0a, 0x1a, 0x0c, 0x58, 0x72,
+	0x9c, 0xf4, 0x24, 0x7e, 0x81, 0x9a, 0x8b, 0x34, 0xdd, 0xd6, 0x7f, 0x7f, 0x68, 0xab, 0x6f, 0x15,
+	0x56, 0xd1, 0xad, 0xed, 0xa2, 0x86, 0xe0, 0x5e, 0x9c, 0x8e, 0x80, 0x73, 0x18, 0x52, 0xc1, 0xc8,
+	0xa6, 0x1a, 0x40, 0xbd, 0x80, 0x0e, 0xd8, 0x91, 0x59, 0x59, 0xb3, 0xd0, 0x91, 0x59, 0xa9, 0x59,
+	0xf5, 0x23, 0xb3, 0xb2, 0x6e, 0x59, 0xed, 0x63, 0xd4, 0x2a, 0x4e, 0x74, 0x90, 0xd3, 0x17, 0xec,
+	0x62, 0xdc, 0xb0, 0xcb, 0x7d, 0x54, 0x8b, 0xe1, 0x8c, 0xde, 0xb0, 0x53, 0x35, 0x86, 0xb3, 0x5e,
+	0x0e, 0xb5, 0xbf, 0x18, 0x08, 0xdf, 0xd6, 0xf0, 0x0e, 0x7b, 0x2e, 0x9a, 0x6d, 0xe9, 0x6e, 0xb3,
+	0x2d, 0xff, 0x85, 0xd9, 0xcc, 0x5f, 0x9a, 0xed, 0xa7, 0xf6, 0x2b, 0xff, 0xa4, 0x7d, 0xf7, 0xf0,
+	0xe2, 0xca, 0x36, 0x2e, 0xaf, 0x6c, 0xe3, 0xfb, 0x95, 0x6d, 0x7c, 0xba, 0xb6, 0x4b, 0x97, 0xd7,
+	0x76, 0xe9, 0xeb, 0xb5, 0x5d, 0x7a, 0xbf, 0x37, 0x0e, 0xc5, 0x89, 0xf4, 0x9d, 0x80, 0x4d, 0xdc,
+	0x85, 0xd7, 0x70, 0xba, 0xf8, 0x1e, 0x8a, 0xf3, 0x04, 0x52, 0xbf, 0xac, 0x1e, 0xae, 0x83, 0x1f,
+	0x03, 0x00, 0x91, 0x04, 0xe4, 0x3d, 0x39, 0x05, 0x00, 0x00,
 }
 
 func (m *Subscription) Marshal() (dAtA []byte, err error) {
@@ -323,6 +416,43 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferredTo) > 0 {
+		i -= len(m.TransferredTo)
+		copy(dAtA[i:], m.TransferredTo)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.TransferredTo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MonthTopUpCredit != nil {
+		{
+			size, err := m.MonthTopUpCredit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSubscription(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.MonthTopUpCu != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthTopUpCu))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MonthOveruseCu != 0 {
+		i = encodeVarintSubscription(dAtA, i, uint64(m.MonthOveruseCu))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
 	{
 		size, err := m.Credit.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -429,6 +559,43 @@ func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SubscriptionTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscriptionTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscriptionTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConsumer) > 0 {
+		i -= len(m.NewConsumer)
+		copy(dAtA[i:], m.NewConsumer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.NewConsumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintSubscription(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FutureSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -553,6 +720,37 @@ func (m *Subscription) Size() (n int) {
 	}
 	l = m.Credit.Size()
 	n += 2 + l + sovSubscription(uint64(l))
+	if m.MonthOveruseCu != 0 {
+		n += 2 + sovSubscription(uint64(m.MonthOveruseCu))
+	}
+	if m.MonthTopUpCu != 0 {
+		n += 2 + sovSubscription(uint64(m.MonthTopUpCu))
+	}
+	if m.MonthTopUpCredit != nil {
+		l = m.MonthTopUpCredit.Size()
+		n += 2 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.TransferredTo)
+	if l > 0 {
+		n += 2 + l + sovSubscription(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscriptionTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
+	l = len(m.NewConsumer)
+	if l > 0 {
+		n += 1 + l + sovSubscription(uint64(l))
+	}
 	return n
 }
 
@@ -997,6 +1195,226 @@ func (m *Subscription) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthOveruseCu", wireType)
+			}
+			m.MonthOveruseCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthOveruseCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthTopUpCu", wireType)
+			}
+			m.MonthTopUpCu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthTopUpCu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthTopUpCredit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MonthTopUpCredit == nil {
+				m.MonthTopUpCredit = &types.Coin{}
+			}
+			if err := m.MonthTopUpCredit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSubscription(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscriptionTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSubscription
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscriptionTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscriptionTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSubscription
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSubscription
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConsumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSubscription(dAtA[iNdEx:])
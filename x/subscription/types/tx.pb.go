@@ -429,6 +429,190 @@ func (m *MsgAutoRenewalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAutoRenewalResponse proto.InternalMessageInfo
 
+type MsgTopUpCu struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer string `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Cu       uint64 `protobuf:"varint,3,opt,name=cu,proto3" json:"cu,omitempty"`
+}
+
+func (m *MsgTopUpCu) Reset()         { *m = MsgTopUpCu{} }
+func (m *MsgTopUpCu) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpCu) ProtoMessage()    {}
+func (*MsgTopUpCu) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{8}
+}
+func (m *MsgTopUpCu) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpCu) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpCu.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpCu) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpCu.Merge(m, src)
+}
+func (m *MsgTopUpCu) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpCu) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpCu.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpCu proto.InternalMessageInfo
+
+func (m *MsgTopUpCu) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTopUpCu) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *MsgTopUpCu) GetCu() uint64 {
+	if m != nil {
+		return m.Cu
+	}
+	return 0
+}
+
+type MsgTopUpCuResponse struct {
+}
+
+func (m *MsgTopUpCuResponse) Reset()         { *m = MsgTopUpCuResponse{} }
+func (m *MsgTopUpCuResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpCuResponse) ProtoMessage()    {}
+func (*MsgTopUpCuResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{9}
+}
+func (m *MsgTopUpCuResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpCuResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpCuResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTopUpCuResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpCuResponse.Merge(m, src)
+}
+func (m *MsgTopUpCuResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpCuResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpCuResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTopUpCuResponse proto.InternalMessageInfo
+
+type MsgTransferSubscription struct {
+	Creator     string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	NewConsumer string `protobuf:"bytes,2,opt,name=new_consumer,json=newConsumer,proto3" json:"new_consumer,omitempty"`
+}
+
+func (m *MsgTransferSubscription) Reset()         { *m = MsgTransferSubscription{} }
+func (m *MsgTransferSubscription) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSubscription) ProtoMessage()    {}
+func (*MsgTransferSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{10}
+}
+func (m *MsgTransferSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferSubscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSubscription.Merge(m, src)
+}
+func (m *MsgTransferSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferSubscription proto.InternalMessageInfo
+
+func (m *MsgTransferSubscription) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferSubscription) GetNewConsumer() string {
+	if m != nil {
+		return m.NewConsumer
+	}
+	return ""
+}
+
+type MsgTransferSubscriptionResponse struct {
+}
+
+func (m *MsgTransferSubscriptionResponse) Reset()         { *m = MsgTransferSubscriptionResponse{} }
+func (m *MsgTransferSubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferSubscriptionResponse) ProtoMessage()    {}
+func (*MsgTransferSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1bb075a6865b817, []int{11}
+}
+func (m *MsgTransferSubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferSubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferSubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferSubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferSubscriptionResponse.Merge(m, src)
+}
+func (m *MsgTransferSubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferSubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferSubscriptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferSubscriptionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgBuy)(nil), "lavanet.lava.subscription.MsgBuy")
 	proto.RegisterType((*MsgBuyResponse)(nil), "lavanet.lava.subscription.MsgBuyResponse")
@@ -438,6 +622,10 @@ func init() {
 	proto.RegisterType((*MsgDelProjectResponse)(nil), "lavanet.lava.subscription.MsgDelProjectResponse")
 	proto.RegisterType((*MsgAutoRenewal)(nil), "lavanet.lava.subscription.MsgAutoRenewal")
 	proto.RegisterType((*MsgAutoRenewalResponse)(nil), "lavanet.lava.subscription.MsgAutoRenewalResponse")
+	proto.RegisterType((*MsgTopUpCu)(nil), "lavanet.lava.subscription.MsgTopUpCu")
+	proto.RegisterType((*MsgTopUpCuResponse)(nil), "lavanet.lava.subscription.MsgTopUpCuResponse")
+	proto.RegisterType((*MsgTransferSubscription)(nil), "lavanet.lava.subscription.MsgTransferSubscription")
+	proto.RegisterType((*MsgTransferSubscriptionResponse)(nil), "lavanet.lava.subscription.MsgTransferSubscriptionResponse")
 }
 
 func init() {
@@ -445,39 +633,45 @@ func init() {
 }
 
 var fileDescriptor_b1bb075a6865b817 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0x8f, 0xb1, 0xeb, 0x86, 0x97, 0x02, 0xd1, 0xa9, 0x14, 0xe3, 0xc1, 0xa4, 0x66, 0x49, 0x25,
-	0x64, 0x43, 0x99, 0x19, 0x1a, 0x55, 0x0c, 0xa0, 0x48, 0x95, 0xd9, 0x58, 0xa2, 0x8b, 0x7d, 0x72,
-	0x02, 0xc9, 0x9d, 0x75, 0x77, 0x0e, 0xe9, 0xb7, 0x60, 0xe7, 0xeb, 0x30, 0x74, 0xec, 0xc8, 0x84,
-	0x50, 0xf2, 0x45, 0x90, 0xcf, 0x76, 0x6c, 0x83, 0x52, 0x77, 0xba, 0xf7, 0xde, 0xfd, 0xde, 0xbf,
-	0xdf, 0xef, 0x74, 0xe0, 0x2e, 0xf0, 0x0a, 0x53, 0x22, 0xfd, 0xec, 0xf4, 0x45, 0x3a, 0x15, 0x21,
-	0x9f, 0x27, 0x72, 0xce, 0xa8, 0x2f, 0xd7, 0x5e, 0xc2, 0x99, 0x64, 0xe8, 0x79, 0x81, 0xf1, 0xb2,
-	0xd3, 0xab, 0x63, 0xec, 0x97, 0x8d, 0xf4, 0x84, 0xb3, 0x2f, 0x24, 0x94, 0xa2, 0x34, 0xf2, 0x7c,
-	0xfb, 0x38, 0x66, 0x31, 0x53, 0xa6, 0x9f, 0x59, 0x79, 0xd4, 0xfd, 0xa9, 0x81, 0x39, 0x16, 0xf1,
-	0x28, 0xbd, 0x46, 0x16, 0x1c, 0x86, 0x9c, 0x60, 0xc9, 0xb8, 0xa5, 0x0d, 0xb4, 0xe1, 0xc3, 0xa0,
-	0x74, 0x91, 0x0d, 0xdd, 0x90, 0x51, 0x91, 0x2e, 0x09, 0xb7, 0x1e, 0xa8, 0xab, 0x9d, 0x8f, 0x8e,
-	0xe1, 0x60, 0x4e, 0x23, 0xb2, 0xb6, 0x74, 0x75, 0x91, 0x3b, 0x59, 0x46, 0x94, 0x72, 0x9c, 0x4d,
-	0x67, 0x19, 0x03, 0x6d, 0x68, 0x04, 0x3b, 0x1f, 0x9d, 0xc2, 0x11, 0x4e, 0x25, 0x9b, 0x70, 0x42,
-	0xc9, 0x37, 0xbc, 0xb0, 0xcc, 0x81, 0x36, 0xec, 0x06, 0xbd, 0x2c, 0x16, 0xe4, 0x21, 0x74, 0x06,
-	0x7d, 0x1c, 0xad, 0x30, 0x0d, 0xc9, 0x24, 0x49, 0x79, 0x38, 0xc3, 0x82, 0x58, 0x87, 0x0a, 0xf6,
-	0xa4, 0x88, 0x5f, 0x15, 0xe1, 0x0f, 0x46, 0xf7, 0xa0, 0x6f, 0xba, 0x7d, 0x78, 0x9c, 0x6f, 0x11,
-	0x10, 0x91, 0x30, 0x2a, 0x88, 0xbb, 0x82, 0x47, 0x63, 0x11, 0x5f, 0x44, 0xd1, 0x55, 0xce, 0xc2,
-	0x1d, 0xeb, 0x7d, 0x84, 0xa3, 0x82, 0xaa, 0x49, 0x84, 0x25, 0x56, 0x2b, 0xf6, 0xce, 0x5d, 0xaf,
-	0x41, 0x78, 0xc9, 0xaa, 0x57, 0xd4, 0xbb, 0xc4, 0x12, 0x8f, 0x8c, 0x9b, 0xdf, 0x2f, 0x3a, 0x41,
-	0x2f, 0xa9, 0x42, 0xee, 0x33, 0x78, 0xda, 0xe8, 0xbb, 0x1b, 0xe8, 0x9d, 0x1a, 0xe8, 0x92, 0x2c,
-	0xda, 0x07, 0x42, 0x60, 0x50, 0xbc, 0x24, 0x05, 0xd7, 0xca, 0x2e, 0xea, 0x56, 0xe9, 0xbb, 0xba,
-	0x52, 0xad, 0x7e, 0x51, 0x63, 0x6f, 0x7f, 0xe1, 0x13, 0x30, 0x09, 0xc5, 0xd3, 0x45, 0x5e, 0xba,
-	0x1b, 0x14, 0x5e, 0x43, 0x60, 0x7d, 0x9f, 0xc0, 0x46, 0x4d, 0x60, 0xd7, 0x82, 0x93, 0x66, 0xd7,
-	0x72, 0x9e, 0xf3, 0x1f, 0x3a, 0xe8, 0x63, 0x11, 0xa3, 0x4f, 0xa0, 0x67, 0xaf, 0xea, 0xd4, 0xdb,
-	0xfb, 0x6e, 0xbd, 0x5c, 0x32, 0xfb, 0xac, 0x15, 0x52, 0x16, 0x47, 0x33, 0x80, 0x9a, 0xa4, 0xc3,
-	0xbb, 0x13, 0x2b, 0xa4, 0xfd, 0xfa, 0xbe, 0xc8, 0x7a, 0xa7, 0x9a, 0x56, 0x2d, 0x9d, 0x2a, 0x64,
-	0x5b, 0xa7, 0xff, 0x05, 0x44, 0x5f, 0xa1, 0x57, 0x57, 0xaf, 0x85, 0x8d, 0x1a, 0xd4, 0x7e, 0x73,
-	0x6f, 0x68, 0xd9, 0x6c, 0xf4, 0xfe, 0x66, 0xe3, 0x68, 0xb7, 0x1b, 0x47, 0xfb, 0xb3, 0x71, 0xb4,
-	0xef, 0x5b, 0xa7, 0x73, 0xbb, 0x75, 0x3a, 0xbf, 0xb6, 0x4e, 0xe7, 0xf3, 0xab, 0x78, 0x2e, 0x67,
-	0xe9, 0xd4, 0x0b, 0xd9, 0xd2, 0x6f, 0xfc, 0x27, 0xeb, 0x7f, 0x3e, 0xa4, 0xeb, 0x84, 0x88, 0xa9,
-	0xa9, 0xbe, 0x8f, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x95, 0xe6, 0xe6, 0xba, 0x04,
-	0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4b, 0x6f, 0xd3, 0x4e,
+	0x10, 0x8f, 0x13, 0xe7, 0xf1, 0x9f, 0xe4, 0x5f, 0x22, 0x2b, 0xb4, 0xc6, 0x07, 0x37, 0x31, 0x42,
+	0x4a, 0x25, 0x70, 0x4a, 0xb8, 0x21, 0x71, 0x68, 0x5a, 0x71, 0x00, 0x45, 0xaa, 0x5c, 0xe0, 0xc0,
+	0x25, 0xda, 0xd8, 0x8b, 0x13, 0x48, 0x76, 0x2d, 0xef, 0x3a, 0x49, 0x3f, 0x00, 0x9c, 0xf9, 0x50,
+	0x1c, 0x7a, 0xec, 0x91, 0x13, 0x42, 0xc9, 0x17, 0x41, 0x7e, 0xc6, 0xae, 0x9a, 0x07, 0x9c, 0xbc,
+	0x33, 0xfe, 0x3d, 0x66, 0x67, 0x67, 0xb5, 0xa0, 0x4d, 0xd0, 0x0c, 0x11, 0xcc, 0x3b, 0xfe, 0xb7,
+	0xc3, 0xbc, 0x21, 0x33, 0xdd, 0xb1, 0xc3, 0xc7, 0x94, 0x74, 0xf8, 0x42, 0x77, 0x5c, 0xca, 0xa9,
+	0xf4, 0x28, 0xc2, 0xe8, 0xfe, 0x57, 0x4f, 0x63, 0x94, 0xc7, 0x19, 0xba, 0xe3, 0xd2, 0xcf, 0xd8,
+	0xe4, 0x2c, 0x5e, 0x84, 0x7c, 0xa5, 0x61, 0x53, 0x9b, 0x06, 0xcb, 0x8e, 0xbf, 0x0a, 0xb3, 0xda,
+	0x0f, 0x01, 0x4a, 0x7d, 0x66, 0xf7, 0xbc, 0x6b, 0x49, 0x86, 0xb2, 0xe9, 0x62, 0xc4, 0xa9, 0x2b,
+	0x0b, 0x4d, 0xa1, 0xfd, 0x9f, 0x11, 0x87, 0x92, 0x02, 0x15, 0x93, 0x12, 0xe6, 0x4d, 0xb1, 0x2b,
+	0xe7, 0x83, 0x5f, 0x49, 0x2c, 0x35, 0xa0, 0x38, 0x26, 0x16, 0x5e, 0xc8, 0x85, 0xe0, 0x47, 0x18,
+	0xf8, 0x0c, 0xcb, 0x73, 0x91, 0x5f, 0x9d, 0x2c, 0x36, 0x85, 0xb6, 0x68, 0x24, 0xb1, 0xd4, 0x82,
+	0x1a, 0xf2, 0x38, 0x1d, 0xb8, 0x98, 0xe0, 0x39, 0x9a, 0xc8, 0xa5, 0xa6, 0xd0, 0xae, 0x18, 0x55,
+	0x3f, 0x67, 0x84, 0x29, 0xe9, 0x04, 0xea, 0xc8, 0x9a, 0x21, 0x62, 0xe2, 0x81, 0xe3, 0xb9, 0xe6,
+	0x08, 0x31, 0x2c, 0x97, 0x03, 0xd8, 0x83, 0x28, 0x7f, 0x19, 0xa5, 0xdf, 0x88, 0x95, 0x62, 0xbd,
+	0xa4, 0xd5, 0xe1, 0x20, 0xdc, 0x85, 0x81, 0x99, 0x43, 0x09, 0xc3, 0xda, 0x0c, 0xfe, 0xef, 0x33,
+	0xfb, 0xcc, 0xb2, 0x2e, 0xc3, 0x2e, 0x6c, 0xd9, 0xde, 0x5b, 0xa8, 0x45, 0xad, 0x1a, 0x58, 0x88,
+	0xa3, 0x60, 0x8b, 0xd5, 0xae, 0xa6, 0x67, 0x1a, 0x1e, 0x77, 0x55, 0x8f, 0xf4, 0x2e, 0x10, 0x47,
+	0x3d, 0xf1, 0xe6, 0xd7, 0x71, 0xce, 0xa8, 0x3a, 0xeb, 0x94, 0x76, 0x04, 0x0f, 0x33, 0xbe, 0x49,
+	0x41, 0xaf, 0x82, 0x82, 0x2e, 0xf0, 0x64, 0x77, 0x41, 0x12, 0x88, 0x04, 0x4d, 0x71, 0xd4, 0xeb,
+	0x60, 0x1d, 0xe9, 0xae, 0xe9, 0x89, 0x2e, 0x0f, 0xb6, 0x7e, 0x96, 0xea, 0xde, 0x66, 0xe1, 0x43,
+	0x28, 0x61, 0x82, 0x86, 0x93, 0x50, 0xba, 0x62, 0x44, 0x51, 0xe6, 0x80, 0x0b, 0x9b, 0x0e, 0x58,
+	0x4c, 0x1d, 0xb0, 0x26, 0xc3, 0x61, 0xd6, 0x35, 0xa9, 0xc7, 0x00, 0xe8, 0x33, 0xfb, 0x1d, 0x75,
+	0xde, 0x3b, 0xe7, 0xde, 0x3f, 0x0e, 0xd5, 0x01, 0xe4, 0x4d, 0x2f, 0xa8, 0x44, 0x34, 0xf2, 0xa6,
+	0xa7, 0x35, 0x40, 0x5a, 0x6b, 0x26, 0x4e, 0x1f, 0xe0, 0xc8, 0xcf, 0xba, 0x88, 0xb0, 0x4f, 0xd8,
+	0xbd, 0x4a, 0xdd, 0x88, 0x2d, 0xb6, 0x2d, 0xa8, 0x11, 0x3c, 0x1f, 0xdc, 0xb1, 0xae, 0x12, 0x3c,
+	0x3f, 0x8f, 0x52, 0x5a, 0x0b, 0x8e, 0x37, 0xe8, 0xc6, 0xd6, 0xdd, 0xaf, 0x45, 0x28, 0xf4, 0x99,
+	0x2d, 0x5d, 0x41, 0xc1, 0xbf, 0x3a, 0x2d, 0x7d, 0xe3, 0xe5, 0xd4, 0xc3, 0xb9, 0x54, 0x4e, 0x76,
+	0x42, 0x62, 0x71, 0x69, 0x04, 0x90, 0x9a, 0xdb, 0xf6, 0x76, 0xe2, 0x1a, 0xa9, 0x9c, 0xee, 0x8b,
+	0x4c, 0x3b, 0xa5, 0x06, 0x72, 0x87, 0xd3, 0x1a, 0xa9, 0x9c, 0xee, 0x8b, 0x4c, 0x9c, 0xbe, 0x40,
+	0x35, 0x3d, 0xa2, 0x3b, 0xba, 0x91, 0x82, 0x2a, 0xcf, 0xf7, 0x86, 0x26, 0x66, 0x03, 0x28, 0xc7,
+	0xf3, 0xf7, 0x64, 0x3b, 0x3b, 0x82, 0x29, 0xcf, 0xf6, 0x82, 0x25, 0x06, 0xdf, 0x04, 0x68, 0xdc,
+	0x3b, 0x77, 0xdd, 0x1d, 0x3a, 0xf7, 0x70, 0x94, 0x97, 0x7f, 0xcf, 0x89, 0x0b, 0xe9, 0xbd, 0xbe,
+	0x59, 0xaa, 0xc2, 0xed, 0x52, 0x15, 0x7e, 0x2f, 0x55, 0xe1, 0xfb, 0x4a, 0xcd, 0xdd, 0xae, 0xd4,
+	0xdc, 0xcf, 0x95, 0x9a, 0xfb, 0xf8, 0xd4, 0x1e, 0xf3, 0x91, 0x37, 0xd4, 0x4d, 0x3a, 0xed, 0x64,
+	0x9e, 0x87, 0xc5, 0x9d, 0xf7, 0xe5, 0xda, 0xc1, 0x6c, 0x58, 0x0a, 0x5e, 0x83, 0x17, 0x7f, 0x06,
+	0x00, 0xdc, 0xe7, 0x76, 0x8a, 0x89, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -496,6 +690,8 @@ type MsgClient interface {
 	AddProject(ctx context.Context, in *MsgAddProject, opts ...grpc.CallOption) (*MsgAddProjectResponse, error)
 	DelProject(ctx context.Context, in *MsgDelProject, opts ...grpc.CallOption) (*MsgDelProjectResponse, error)
 	AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ...grpc.CallOption) (*MsgAutoRenewalResponse, error)
+	TopUpCu(ctx context.Context, in *MsgTopUpCu, opts ...grpc.CallOption) (*MsgTopUpCuResponse, error)
+	TransferSubscription(ctx context.Context, in *MsgTransferSubscription, opts ...grpc.CallOption) (*MsgTransferSubscriptionResponse, error)
 }
 
 type msgClient struct {
@@ -542,12 +738,32 @@ func (c *msgClient) AutoRenewal(ctx context.Context, in *MsgAutoRenewal, opts ..
 	return out, nil
 }
 
+func (c *msgClient) TopUpCu(ctx context.Context, in *MsgTopUpCu, opts ...grpc.CallOption) (*MsgTopUpCuResponse, error) {
+	out := new(MsgTopUpCuResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/TopUpCu", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferSubscription(ctx context.Context, in *MsgTransferSubscription, opts ...grpc.CallOption) (*MsgTransferSubscriptionResponse, error) {
+	out := new(MsgTransferSubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/lavanet.lava.subscription.Msg/TransferSubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
 	AddProject(context.Context, *MsgAddProject) (*MsgAddProjectResponse, error)
 	DelProject(context.Context, *MsgDelProject) (*MsgDelProjectResponse, error)
 	AutoRenewal(context.Context, *MsgAutoRenewal) (*MsgAutoRenewalResponse, error)
+	TopUpCu(context.Context, *MsgTopUpCu) (*MsgTopUpCuResponse, error)
+	TransferSubscription(context.Context, *MsgTransferSubscription) (*MsgTransferSubscriptionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -566,6 +782,12 @@ func (*UnimplementedMsgServer) DelProject(ctx context.Context, req *MsgDelProjec
 func (*UnimplementedMsgServer) AutoRenewal(ctx context.Context, req *MsgAutoRenewal) (*MsgAutoRenewalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoRenewal not implemented")
 }
+func (*UnimplementedMsgServer) TopUpCu(ctx context.Context, req *MsgTopUpCu) (*MsgTopUpCuResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpCu not implemented")
+}
+func (*UnimplementedMsgServer) TransferSubscription(ctx context.Context, req *MsgTransferSubscription) (*MsgTransferSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferSubscription not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -643,6 +865,42 @@ func _Msg_AutoRenewal_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TopUpCu_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpCu)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpCu(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/TopUpCu",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpCu(ctx, req.(*MsgTopUpCu))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferSubscription)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lavanet.lava.subscription.Msg/TransferSubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferSubscription(ctx, req.(*MsgTransferSubscription))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lavanet.lava.subscription.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -663,6 +921,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoRenewal",
 			Handler:    _Msg_AutoRenewal_Handler,
 		},
+		{
+			MethodName: "TopUpCu",
+			Handler:    _Msg_TopUpCu_Handler,
+		},
+		{
+			MethodName: "TransferSubscription",
+			Handler:    _Msg_TransferSubscription_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lavanet/lava/subscription/tx.proto",
@@ -960,85 +1226,210 @@ func (m *MsgAutoRenewalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTopUpCu) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgBuy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgTopUpCu) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpCu) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Consumer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovTx(uint64(m.Duration))
+	if m.Cu != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Cu))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.AutoRenewal {
-		n += 2
+	if len(m.Consumer) > 0 {
+		i -= len(m.Consumer)
+		copy(dAtA[i:], m.Consumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Consumer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AdvancePurchase {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBuyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTopUpCuResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddProject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.ProjectData.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgTopUpCuResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddProjectResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgTopUpCuResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelProject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgTransferSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewConsumer) > 0 {
+		i -= len(m.NewConsumer)
+		copy(dAtA[i:], m.NewConsumer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewConsumer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferSubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferSubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferSubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgBuy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
+	if m.AutoRenewal {
+		n += 2
+	}
+	if m.AdvancePurchase {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgBuyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddProject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProjectData.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddProjectResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelProject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Creator)
 	if l > 0 {
@@ -1093,6 +1484,61 @@ func (m *MsgAutoRenewalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgTopUpCu) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Consumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Cu != 0 {
+		n += 1 + sovTx(uint64(m.Cu))
+	}
+	return n
+}
+
+func (m *MsgTopUpCuResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewConsumer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferSubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1158,11 +1604,399 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRenewal = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdvancePurchase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AdvancePurchase = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelProject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelProject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1190,11 +2024,11 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,67 +2056,8 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			m.Duration = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenewal", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AutoRenewal = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdvancePurchase", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AdvancePurchase = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1304,7 +2079,7 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1327,10 +2102,10 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelProjectResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1354,7 +2129,7 @@ func (m *MsgBuyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
+func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1377,10 +2152,10 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAutoRenewal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAutoRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1416,10 +2191,30 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enable = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1429,24 +2224,55 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProjectData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Consumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1469,7 +2295,7 @@ func (m *MsgAddProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1492,10 +2318,10 @@ func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAutoRenewalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAutoRenewalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1519,7 +2345,7 @@ func (m *MsgAddProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpCu) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1542,10 +2368,10 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpCu: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpCu: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1582,7 +2408,7 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1610,8 +2436,27 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Consumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cu", wireType)
+			}
+			m.Cu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cu |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1633,7 +2478,7 @@ func (m *MsgDelProject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTopUpCuResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1656,10 +2501,10 @@ func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelProjectResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTopUpCuResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelProjectResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTopUpCuResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1683,7 +2528,7 @@ func (m *MsgDelProjectResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1706,10 +2551,10 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAutoRenewal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAutoRenewal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1745,60 +2590,8 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enable = bool(v != 0)
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Consumer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsumer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1826,7 +2619,7 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.NewConsumer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1849,7 +2642,7 @@ func (m *MsgAutoRenewal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferSubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1872,10 +2665,10 @@ func (m *MsgAutoRenewalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAutoRenewalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferSubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAutoRenewalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferSubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
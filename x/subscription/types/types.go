@@ -12,4 +12,7 @@ const (
 	AddTrackedCuEventName                   = "add_tracked_cu_event"
 	MonthlyCuTrackerProviderRewardEventName = "monthly_cu_tracker_provider_reward"
 	RemainingCreditEventName                = "subscription_remaining_credit"
+	OveruseChargedEventName                 = "subscription_overuse_charged"
+	TopUpCuEventName                        = "subscription_top_up_cu"
+	TransferSubscriptionEventName           = "transfer_subscription_event"
 )